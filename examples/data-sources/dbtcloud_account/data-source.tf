@@ -0,0 +1,12 @@
+data "dbtcloud_account" "account" {
+}
+
+output "sso_login_url" {
+  value = data.dbtcloud_account.account.enterprise_login_url
+}
+
+// adapt the configuration to the plan of the account
+resource "dbtcloud_job" "daily_job" {
+  // ...
+  num_threads = data.dbtcloud_account.account.plan == "enterprise" ? 16 : 4
+}
@@ -0,0 +1,6 @@
+data "dbtcloud_account_features" "features" {
+}
+
+output "enabled_features" {
+  value = [for feature, enabled in data.dbtcloud_account_features.features.features : feature if enabled]
+}
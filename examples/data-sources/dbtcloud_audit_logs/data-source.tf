@@ -0,0 +1,17 @@
+// all the events of the first week of January 2024
+data "dbtcloud_audit_logs" "first_week" {
+  start_time = "2024-01-01T00:00:00Z"
+  end_time   = "2024-01-08T00:00:00Z"
+}
+
+// the changes made to jobs and environments by a given user since the start of the year
+data "dbtcloud_audit_logs" "user_changes" {
+  start_time   = "2024-01-01T00:00:00Z"
+  actors       = ["user@example.com"]
+  object_types = ["job", "environment"]
+}
+
+// the details of the events are JSON encoded
+output "first_event_details" {
+  value = jsondecode(data.dbtcloud_audit_logs.user_changes.events[0].metadata)
+}
@@ -0,0 +1,20 @@
+// test the connection with the credential used by the production environment
+data "dbtcloud_connection_test" "prod" {
+  connection_id = dbtcloud_global_connection.snowflake.id
+  project_id    = dbtcloud_project.my_project.id
+  credential_id = dbtcloud_snowflake_credential.prod.credential_id
+}
+
+// or run the test in a check block, to get a warning at every plan when it fails
+check "prod_connection" {
+  data "dbtcloud_connection_test" "prod_check" {
+    connection_id = dbtcloud_global_connection.snowflake.id
+    project_id    = dbtcloud_project.my_project.id
+    credential_id = dbtcloud_snowflake_credential.prod.credential_id
+  }
+
+  assert {
+    condition     = data.dbtcloud_connection_test.prod_check.success
+    error_message = "dbt Cloud can't connect to Snowflake: ${data.dbtcloud_connection_test.prod_check.message}"
+  }
+}
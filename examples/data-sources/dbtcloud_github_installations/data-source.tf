@@ -0,0 +1,14 @@
+// NOTE: the following requires connecting via a user token and can't be retrieved with a service token
+data "dbtcloud_github_installations" "all" {}
+
+// only the installations with access to the repository
+data "dbtcloud_github_installations" "my_repo" {
+  remote_url = "git@github.com:my-org/my-repo.git"
+}
+
+resource "dbtcloud_repository" "github_repo" {
+  project_id             = dbtcloud_project.dbt_project.id
+  remote_url             = "git@github.com:my-org/my-repo.git"
+  github_installation_id = data.dbtcloud_github_installations.my_repo.installations[0].id
+  git_clone_strategy     = "github_app"
+}
@@ -0,0 +1,16 @@
+// NOTE: the following requires connecting via a user token and can't be retrieved with a service token
+data "dbtcloud_gitlab_project" "my_project" {
+  path = "my-group/my-subgroup/my-project"
+}
+
+// the SSH or HTTPS URL of the project can also be used
+data "dbtcloud_gitlab_project" "my_project_from_url" {
+  path = "git@gitlab.com:my-group/my-subgroup/my-project.git"
+}
+
+resource "dbtcloud_repository" "gitlab_repo" {
+  project_id         = dbtcloud_project.dbt_project.id
+  remote_url         = data.dbtcloud_gitlab_project.my_project.path_with_namespace
+  gitlab_project_id  = data.dbtcloud_gitlab_project.my_project.id
+  git_clone_strategy = "deploy_token"
+}
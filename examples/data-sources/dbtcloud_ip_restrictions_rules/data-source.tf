@@ -0,0 +1,11 @@
+data "dbtcloud_ip_restrictions_rules" "all" {
+}
+
+// list all the CIDR ranges currently allowed
+output "allowed_cidrs" {
+  value = flatten([
+    for rule in data.dbtcloud_ip_restrictions_rules.all.rules : [
+      for cidr in rule.cidrs : cidr.cidr
+    ] if rule.type == "allow"
+  ])
+}
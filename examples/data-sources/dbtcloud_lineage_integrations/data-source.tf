@@ -0,0 +1,7 @@
+data "dbtcloud_lineage_integrations" "my_project_lineage" {
+  project_id = dbtcloud_project.my_project.id
+}
+
+output "lineage_tools" {
+  value = [for integration in data.dbtcloud_lineage_integrations.my_project_lineage.lineage_integrations : integration.name]
+}
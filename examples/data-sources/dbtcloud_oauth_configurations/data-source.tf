@@ -0,0 +1,19 @@
+// find the OAuth configuration to use in a connection from its name
+data "dbtcloud_oauth_configurations" "okta" {
+  name = "My Okta Oauth integration"
+}
+
+resource "dbtcloud_global_connection" "snowflake" {
+  name                   = "My Snowflake connection"
+  oauth_configuration_id = data.dbtcloud_oauth_configurations.okta.oauth_configurations[0].id
+  snowflake = {
+    account   = "my-snowflake-account"
+    database  = "MY_DATABASE"
+    warehouse = "MY_WAREHOUSE"
+  }
+}
+
+// all the Snowflake native OAuth configurations
+data "dbtcloud_oauth_configurations" "snowflake" {
+  type = "snowflake"
+}
@@ -0,0 +1,24 @@
+// all the PrivateLink endpoints of the account
+data "dbtcloud_privatelink_endpoints" "all" {}
+
+// only the Snowflake endpoints
+data "dbtcloud_privatelink_endpoints" "snowflake" {
+  type = "snowflake"
+}
+
+// the Databricks endpoints with a CIDR range overlapping 10.0.0.0/16
+data "dbtcloud_privatelink_endpoints" "databricks_vpc" {
+  type       = "databricks"
+  cidr_range = "10.0.0.0/16"
+}
+
+resource "dbtcloud_global_connection" "snowflake" {
+  name                     = "Snowflake with PrivateLink"
+  private_link_endpoint_id = data.dbtcloud_privatelink_endpoints.snowflake.endpoints[0].id
+
+  snowflake = {
+    account   = "my-snowflake-account"
+    database  = "ANALYTICS"
+    warehouse = "TRANSFORMING"
+  }
+}
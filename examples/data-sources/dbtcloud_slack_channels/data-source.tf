@@ -0,0 +1,8 @@
+// return all the Slack channels available to the Slack integration of the dbt Cloud account
+data "dbtcloud_slack_channels" "all" {
+}
+
+// we can use it to get the ID of a channel from its name
+locals {
+  alerts_channel_id = one([for channel in data.dbtcloud_slack_channels.all.channels : channel.id if channel.name == "dbt-alerts"])
+}
@@ -0,0 +1,7 @@
+data "dbtcloud_webhooks" "all" {
+}
+
+// the list can be used to find webhooks with a failing endpoint
+output "failing_webhooks" {
+  value = [for webhook in data.dbtcloud_webhooks.all.webhooks : webhook.name if webhook.http_status_code != "200"]
+}
@@ -0,0 +1,10 @@
+resource "dbtcloud_account_features" "features" {
+  advanced_ci     = true
+  partial_parsing = true
+
+  // features without a dedicated attribute, using their name in dbt Cloud
+  // the list of features can be retrieved with the dbtcloud_account_features data source
+  features = {
+    "my-new-feature" = true
+  }
+}
@@ -0,0 +1,8 @@
+resource "dbtcloud_account_settings" "settings" {
+  name                       = "My Company"
+  run_duration_limit_seconds = 7200
+
+  // the jobs used by default for the docs and the source freshness
+  docs_job_id      = dbtcloud_job.docs.job_id
+  freshness_job_id = dbtcloud_job.freshness.job_id
+}
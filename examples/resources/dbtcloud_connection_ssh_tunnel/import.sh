@@ -0,0 +1,16 @@
+# the SSH tunnel is imported with the ID of its connection
+
+# using  import blocks (requires Terraform >= 1.5)
+import {
+  to = dbtcloud_connection_ssh_tunnel.my_ssh_tunnel
+  id = "connection_id"
+}
+
+import {
+  to = dbtcloud_connection_ssh_tunnel.my_ssh_tunnel
+  id = "12345"
+}
+
+# using the older import command
+terraform import dbtcloud_connection_ssh_tunnel.my_ssh_tunnel "connection_id"
+terraform import dbtcloud_connection_ssh_tunnel.my_ssh_tunnel 12345
@@ -0,0 +1,23 @@
+resource "dbtcloud_global_connection" "postgres" {
+  name = "My PostgreSQL connection"
+  postgres = {
+    hostname = "my-postgresql-server.com"
+    port     = 5432
+    dbname   = "my_database"
+  }
+}
+
+resource "dbtcloud_connection_ssh_tunnel" "postgres" {
+  connection_id = dbtcloud_global_connection.postgres.id
+  hostname      = "my-bastion-host.com"
+  port          = 22
+  username      = "dbt"
+
+  // changing the value regenerates the key pair of the SSH tunnel
+  rotate_key_trigger = "2024-09-01"
+}
+
+// the public key needs to be added to the authorized keys of the bastion host
+output "ssh_tunnel_public_key" {
+  value = dbtcloud_connection_ssh_tunnel.postgres.public_key
+}
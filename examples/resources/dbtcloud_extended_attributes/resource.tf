@@ -1,5 +1,5 @@
-# extended_attributes can be set as a raw JSON string or encoded with Terraform's `jsonencode()` function
-# we recommend using `jsonencode()` to avoid Terraform reporting changes due to whitespaces or keys ordering
+# extended_attributes can be set as an object, as a raw JSON string or encoded with Terraform's `jsonencode()` function
+# the key order and the whitespaces of the JSON don't create diffs
 resource "dbtcloud_extended_attributes" "my_attributes" {
   extended_attributes = jsonencode(
     {
@@ -14,6 +14,16 @@ resource "dbtcloud_extended_attributes" "my_attributes" {
   project_id = var.dbt_project.id
 }
 
+# when connection_id is set, the keys are checked against the adapter of the connection when planning
+resource "dbtcloud_extended_attributes" "my_checked_attributes" {
+  extended_attributes = {
+    catalog = "dbt_catalog"
+    threads = 8
+  }
+  project_id    = var.dbt_project.id
+  connection_id = dbtcloud_global_connection.databricks.id
+}
+
 resource "dbtcloud_environment" "issue_depl" {
   dbt_version            = "versionless"
   name                   = "My environment"
@@ -21,6 +31,7 @@ resource "dbtcloud_environment" "issue_depl" {
   type                   = "deployment"
   use_custom_branch      = false
   credential_id          = var.dbt_credential_id
+  connection_id          = dbtcloud_global_connection.databricks.id
   deployment_type        = "production"
-  extended_attributes_id = dbtcloud_extended_attributes.my_attributes.extended_attributes_id
-}
\ No newline at end of file
+  extended_attributes_id = dbtcloud_extended_attributes.my_checked_attributes.extended_attributes_id
+}
@@ -47,6 +47,8 @@ resource "dbtcloud_global_connection" "databricks" {
     catalog       = "dbt_catalog"
     client_id     = "yourclientid"
     client_secret = "yourclientsecret"
+    // the adapter version can be upgraded in place, downgrading it recreates the connection
+    adapter_version = "databricks_v1"
   }
 }
 
@@ -89,6 +91,8 @@ resource "dbtcloud_global_connection" "snowflake" {
   name = "My Snowflake connection"
   // we can set Privatelink if needed
   private_link_endpoint_id = data.dbtcloud_privatelink_endpoint.my_private_link.id
+  // the apply fails if dbt Cloud can't connect to Snowflake
+  validate_on_apply = true
   snowflake = {
     account                   = "my-snowflake-account"
     database                  = "MY_DATABASE"
@@ -119,4 +123,16 @@ resource "dbtcloud_global_connection" "synapse" {
     login_timeout = 60
     query_timeout = 3600
   }
-}
\ No newline at end of file
+}
+
+resource "dbtcloud_global_connection" "teradata" {
+  name = "My Teradata connection"
+  teradata = {
+    host = "my-teradata-server.com"
+    // optional fields
+    port            = 1025
+    tmode           = "ANSI"
+    retries         = 3
+    request_timeout = 60
+  }
+}
@@ -9,6 +9,5 @@ resource "dbtcloud_ip_restrictions_rule" "test" {
       cidr = "1.6.7.10/24" # /24 for adding a range of addresses via netmask
     }
   ]
-  type             = "deny"
-  rule_set_enabled = false
+  type = "deny"
 }
\ No newline at end of file
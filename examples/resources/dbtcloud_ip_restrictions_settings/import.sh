@@ -0,0 +1,14 @@
+# using  import blocks (requires Terraform >= 1.5)
+import {
+  to = dbtcloud_ip_restrictions_settings.settings
+  id = "account_id"
+}
+
+import {
+  to = dbtcloud_ip_restrictions_settings.settings
+  id = "12345"
+}
+
+# using the older import command
+terraform import dbtcloud_ip_restrictions_settings.settings "account_id"
+terraform import dbtcloud_ip_restrictions_settings.settings 12345
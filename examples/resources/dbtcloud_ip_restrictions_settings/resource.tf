@@ -0,0 +1,24 @@
+resource "dbtcloud_ip_restrictions_rule" "office" {
+  name        = "Office and CI runners"
+  description = "Allow access from the office and the CI runners"
+  cidrs = [
+    {
+      cidr = "203.0.113.0/24"
+    }
+  ]
+  type = "allow"
+}
+
+// the rules are only enforced once the settings are enabled
+// when planning to enforce them, the provider checks that the runner IP address is allowed by the existing rules
+// so new rules need to be applied before setting `enabled` to `true`
+resource "dbtcloud_ip_restrictions_settings" "settings" {
+  enabled                    = true
+  enabled_for_service_tokens = true
+
+  // the IP addresses of the Terraform runners can be set explicitly
+  // otherwise the public IP address of the current runner is detected by calling `runner_ip_lookup_url`
+  runner_cidrs = ["203.0.113.10"]
+
+  depends_on = [dbtcloud_ip_restrictions_rule.office]
+}
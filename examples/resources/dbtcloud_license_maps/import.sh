@@ -0,0 +1,14 @@
+# using  import blocks (requires Terraform >= 1.5)
+import {
+  to = dbtcloud_license_maps.all
+  id = "account_id"
+}
+
+import {
+  to = dbtcloud_license_maps.all
+  id = "12345"
+}
+
+# using the older import command
+terraform import dbtcloud_license_maps.all "account_id"
+terraform import dbtcloud_license_maps.all 12345
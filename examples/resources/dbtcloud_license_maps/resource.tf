@@ -0,0 +1,12 @@
+// manages all the license maps of the account
+// an SSO group can only be mapped to one license type
+resource "dbtcloud_license_maps" "all" {
+  license_maps = {
+    developer = ["data-engineers", "analytics-engineers"]
+    read_only = ["data-consumers"]
+    it        = ["it-admins"]
+  }
+
+  // adopt the license maps already created in the account, they are updated to match the config
+  import_existing = true
+}
@@ -1,12 +1,29 @@
 // the resource can only be configured when a Prod environment has been set
 // so, you might want to explicitly set the dependency on your Prod environment resource
 
-resource "dbtcloud_lineage_integration" "my_lineage" {
+resource "dbtcloud_lineage_integration" "my_tableau_lineage" {
   project_id = dbtcloud_project.my_project.id
-  host       = "my.host.com"
-  site_id    = "mysiteid"
-  token_name = "my-token-name"
-  token      = "my-sensitive-token"
+
+  tableau = {
+    host       = "my.host.com"
+    site_id    = "mysiteid"
+    token_name = "my-token-name"
+    token      = "my-sensitive-token"
+  }
 
   depends_on = [dbtcloud_environment.my_prod_env]
 }
+
+resource "dbtcloud_lineage_integration" "my_power_bi_lineage" {
+  project_id = dbtcloud_project.my_other_project.id
+
+  power_bi = {
+    tenant_id     = "00000000-0000-0000-0000-000000000000"
+    client_id     = "11111111-1111-1111-1111-111111111111"
+    client_secret = var.power_bi_client_secret
+    // when not set, the lineage is fetched from all the workspaces the service principal can access
+    workspace_ids = ["22222222-2222-2222-2222-222222222222"]
+  }
+
+  depends_on = [dbtcloud_environment.my_other_prod_env]
+}
@@ -29,7 +29,7 @@ resource "dbtcloud_notification" "prod_job_external_notification" {
   external_email = "my_email@mail.com"
 }
 
-// and finally, we can set up Slack notifications
+// we can set up Slack notifications
 resource "dbtcloud_notification" "prod_job_slack_notifications" {
   // we still need the ID of a user in dbt Cloud even though it is not used for sending notifications
   user_id    = 100
@@ -39,4 +39,46 @@ resource "dbtcloud_notification" "prod_job_slack_notifications" {
   notification_type  = 2
   slack_channel_id   = "C12345ABCDE"
   slack_channel_name = "#my-awesome-channel"
-}
\ No newline at end of file
+}
+
+// the Slack channel ID can be retrieved with the dbtcloud_slack_channels data source
+data "dbtcloud_slack_channels" "all" {
+}
+
+locals {
+  alerts_channel = one([for channel in data.dbtcloud_slack_channels.all.channels : channel if channel.name == "dbt-alerts"])
+}
+
+resource "dbtcloud_notification" "prod_job_slack_alerts" {
+  user_id            = 100
+  on_failure         = [dbtcloud_job.prod_job.id]
+  notification_type  = 2
+  slack_channel_id   = local.alerts_channel.id
+  slack_channel_name = "#${local.alerts_channel.name}"
+}
+
+// and finally, we can set up Microsoft Teams notifications
+resource "dbtcloud_notification" "prod_job_teams_notifications" {
+  // we still need the ID of a user in dbt Cloud even though it is not used for sending notifications
+  user_id    = 100
+  on_failure = [23456, 56788]
+  // the Type 5 is used for Microsoft Teams notifications
+  notification_type     = 5
+  ms_teams_channel_id   = "19:abcdef1234567890@thread.tacv2"
+  ms_teams_channel_name = "dbt alerts"
+}
+
+// instead of listing job IDs, we can select jobs dynamically
+// the matching jobs are retrieved at each plan, so new jobs get added to the notification automatically
+resource "dbtcloud_notification" "prod_jobs_failures" {
+  user_id            = 100
+  notification_type  = 2
+  slack_channel_id   = "C12345ABCDE"
+  slack_channel_name = "#on-call"
+  job_selector = {
+    project_ids     = [dbtcloud_project.my_project.id]
+    deployment_type = "production"
+    job_name_regex  = "^daily"
+    notify_on       = ["failure", "cancel"]
+  }
+}
@@ -1,4 +1,3 @@
-
 resource "dbtcloud_oauth_configuration" "test" {
   type               = "entra"
   name               = "My Entra ID Oauth integration"
@@ -10,12 +9,29 @@ resource "dbtcloud_oauth_configuration" "test" {
   application_id_uri = "uri"
 }
 
+// client_secret is write-only (Terraform 1.11+), it is only sent when creating the configuration
+// and when client_secret_version changes, and it can be removed from the config after the first apply
 resource "dbtcloud_oauth_configuration" "test" {
-  type          = "okta"
-  name          = "My Okta Oauth integration"
-  client_id     = "client-id"
-  client_secret = "client-secret"
-  redirect_uri  = "http://example.com"
-  token_url     = "http://example.com"
-  authorize_url = "http://example.com"
-}
\ No newline at end of file
+  type                  = "okta"
+  name                  = "My Okta Oauth integration"
+  client_id             = "client-id"
+  client_secret         = var.okta_client_secret
+  client_secret_version = 1
+  redirect_uri          = "http://example.com"
+  token_url             = "https://example.okta.com/oauth2/default/v1/token"
+  authorize_url         = "https://example.okta.com/oauth2/default/v1/authorize"
+  issuer                = "https://example.okta.com/oauth2/default"
+  audience              = "https://example.snowflakecomputing.com"
+  scopes                = ["session:role-any", "offline_access"]
+}
+
+resource "dbtcloud_oauth_configuration" "test" {
+  type                      = "snowflake"
+  name                      = "My Snowflake native Oauth integration"
+  client_id                 = "client-id"
+  client_secret             = "client-secret"
+  redirect_uri              = "http://example.com"
+  token_url                 = "https://example.snowflakecomputing.com/oauth/token-request"
+  authorize_url             = "https://example.snowflakecomputing.com/oauth/authorize"
+  security_integration_name = "DBT_CLOUD"
+}
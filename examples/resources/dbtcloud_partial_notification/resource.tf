@@ -31,4 +31,14 @@ resource "dbtcloud_partial_notification" "prod_job_slack_notifications" {
   notification_type  = 2
   slack_channel_id   = "C12345ABCDE"
   slack_channel_name = "#my-awesome-channel"
-}
\ No newline at end of file
+}
+// jobs can also be selected dynamically, for example all the jobs of the environments of a given team
+resource "dbtcloud_partial_notification" "team_jobs_failures" {
+  user_id           = 100
+  notification_type = 4
+  external_email    = "team-alerts@example.com"
+  job_selector = {
+    environment_ids = [dbtcloud_environment.team_prod.environment_id]
+    notify_on       = ["failure", "warning"]
+  }
+}
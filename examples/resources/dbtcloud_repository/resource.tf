@@ -9,29 +9,22 @@ resource "dbtcloud_repository" "github_repo" {
 
 
 ### repo cloned via the GitHub integration, with auto-retrieval of the `github_installation_id`
-# here, we assume that `token` and `host_url` are respectively accessible via `var.dbt_token` and `var.dbt_host_url`
 # NOTE: the following requires connecting via a user token and can't be retrieved with a service token
-data "http" "github_installations_response" {
-  url = format("%s/v2/integrations/github/installations/", var.dbt_host_url)
-  request_headers = {
-    Authorization = format("Bearer %s", var.dbt_token)
-  }
-}
-
-locals {
-  github_installation_id = jsondecode(data.http.github_installations_response.response_body)[0].id
+data "dbtcloud_github_installations" "github_repo_other" {
+  remote_url = "git@github.com:<github_org>/<github_repo>.git"
 }
 
 resource "dbtcloud_repository" "github_repo_other" {
   project_id             = dbtcloud_project.dbt_project.id
   remote_url             = "git@github.com:<github_org>/<github_repo>.git"
-  github_installation_id = local.github_installation_id
+  github_installation_id = data.dbtcloud_github_installations.github_repo_other.installations[0].id
   git_clone_strategy     = "github_app"
 }
 
 
 ### repo cloned via the GitLab integration
 # as of 15 Sept 2023 this resource requires using a user token and can't be set with a service token - CC-791
+# the project ID can also be retrieved with the data source `dbtcloud_gitlab_project`
 resource "dbtcloud_repository" "gitlab_repo" {
   project_id         = dbtcloud_project.dbt_project.id
   remote_url         = "<gitlab-group>/<gitlab-project>"
@@ -45,6 +38,26 @@ resource "dbtcloud_repository" "deploy_repo" {
   project_id         = dbtcloud_project.dbt_project.id
   remote_url         = "git://github.com/<github_org>/<github_repo>.git"
   git_clone_strategy = "deploy_key"
+
+  # changing the value generates a new deploy key, the previous one is available in
+  # `previous_deploy_key` until the next apply to update the keys in the Git provider without downtime
+  deploy_key_rotation_trigger = "2024-09-01"
+}
+
+# for example, with the GitHub provider
+resource "github_repository_deploy_key" "dbt_cloud" {
+  title      = "dbt Cloud"
+  repository = "<github_repo>"
+  key        = dbtcloud_repository.deploy_repo.deploy_key
+  read_only  = false
+}
+
+resource "github_repository_deploy_key" "dbt_cloud_previous" {
+  count      = dbtcloud_repository.deploy_repo.previous_deploy_key != null ? 1 : 0
+  title      = "dbt Cloud (previous key)"
+  repository = "<github_repo>"
+  key        = dbtcloud_repository.deploy_repo.previous_deploy_key
+  read_only  = false
 }
 
 
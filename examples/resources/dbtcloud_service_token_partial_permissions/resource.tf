@@ -0,0 +1,22 @@
+// the service token is managed in another Terraform project/workspace, we only reference its ID
+data "dbtcloud_service_token" "ci_token" {
+  service_token_id = 12345
+}
+
+// we add permissions for a new project to the existing CI service token
+resource "dbtcloud_service_token_partial_permissions" "ci_token_my_project" {
+  service_token_id = data.dbtcloud_service_token.ci_token.service_token_id
+  service_token_permissions = [
+    {
+      permission_set = "job_admin"
+      project_id     = dbtcloud_project.dbt_project.id
+      all_projects   = false
+    },
+    {
+      permission_set                  = "developer"
+      project_id                      = dbtcloud_project.dbt_project.id
+      all_projects                    = false
+      writable_environment_categories = ["development", "staging"]
+    }
+  ]
+}
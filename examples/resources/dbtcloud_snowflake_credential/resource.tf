@@ -6,3 +6,15 @@ resource "dbtcloud_snowflake_credential" "prod_credential" {
   user        = "user"
   password    = "password"
 }
+
+// the credential can be tested with a connection after each apply
+resource "dbtcloud_snowflake_credential" "validated_credential" {
+  project_id               = dbtcloud_project.dbt_project.id
+  auth_type                = "password"
+  num_threads              = 16
+  schema                   = "SCHEMA"
+  user                     = "user"
+  password                 = "password"
+  validate_on_apply        = true
+  validation_connection_id = dbtcloud_global_connection.snowflake.id
+}
@@ -0,0 +1,14 @@
+# using  import blocks (requires Terraform >= 1.5)
+import {
+  to = dbtcloud_sso_configuration.sso
+  id = "account_id"
+}
+
+import {
+  to = dbtcloud_sso_configuration.sso
+  id = "12345"
+}
+
+# using the older import command
+terraform import dbtcloud_sso_configuration.sso "account_id"
+terraform import dbtcloud_sso_configuration.sso 12345
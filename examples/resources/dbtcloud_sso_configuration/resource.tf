@@ -0,0 +1,23 @@
+// only one of saml, okta or entra_id can be set
+resource "dbtcloud_sso_configuration" "sso" {
+  login_slug = "my-company"
+
+  entra_id = {
+    domain        = "my-company.onmicrosoft.com"
+    tenant_id     = "00000000-0000-0000-0000-000000000000"
+    client_id     = "11111111-1111-1111-1111-111111111111"
+    client_secret = var.entra_id_client_secret
+  }
+}
+
+// SAML 2.0, with the metadata of the identity provider
+resource "dbtcloud_sso_configuration" "sso_saml" {
+  login_slug = "my-company"
+
+  saml = {
+    metadata_url = "https://idp.my-company.com/saml/metadata.xml"
+  }
+
+  // set to true only when moving from one SSO method to another
+  allow_method_change = false
+}
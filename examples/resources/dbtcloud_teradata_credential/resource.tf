@@ -0,0 +1,7 @@
+resource "dbtcloud_teradata_credential" "my_teradata_cred" {
+  project_id  = dbtcloud_project.dbt_project.id
+  user        = "my_user"
+  password    = "my_password"
+  schema      = "my_schema"
+  num_threads = 8
+}
@@ -11,3 +11,14 @@ resource "dbtcloud_webhook" "test_webhook" {
     5678
   ]
 }
+
+// the HMAC secret can be rotated by changing the value of `rotate_secret_trigger`
+// and a test event can be sent to the endpoint after each apply
+resource "dbtcloud_webhook" "test_webhook_rotated" {
+  name        = "test-webhook-rotated"
+  client_url  = "https://example.com/dbt-cloud-events"
+  event_types = ["job.run.errored"]
+
+  rotate_secret_trigger = "2024-06-01"
+  test_on_apply         = true
+}
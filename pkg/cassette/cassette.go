@@ -0,0 +1,268 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// the fields set to random names by the acceptance tests, their values are ignored when matching requests
+var defaultIgnoredFields = []string{
+	"name",
+	"new_name",
+	"description",
+	"sso_mapping_groups",
+	"custom_branch",
+}
+
+var accountIDRegex = regexp.MustCompile(`/accounts/(\d+)/`)
+
+// Interaction is a request sent to the dbt Cloud API and the response received
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+// Cassette contains the interactions recorded for the acceptance tests of a package
+type Cassette struct {
+	AccountID    int           `json:"account_id,omitempty"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+func load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cassette := Cassette{}
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, err
+	}
+	return &cassette, nil
+}
+
+func (c *Cassette) save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func accountIDFromPath(path string) int {
+	matches := accountIDRegex.FindStringSubmatch(path)
+	if matches == nil {
+		return 0
+	}
+	accountID, _ := strconv.Atoi(matches[1])
+	return accountID
+}
+
+// isSecretField returns true for the fields that must not be written in the fixtures, e.g. token_string or password
+// the IDs and versions of secrets and the keys only containing "token" like service_token_permissions are kept
+func isSecretField(key string) bool {
+	key = strings.ToLower(key)
+	if strings.HasSuffix(key, "_id") || strings.HasSuffix(key, "_version") {
+		return false
+	}
+	if key == "token" || key == "token_string" || strings.HasSuffix(key, "_token") {
+		return true
+	}
+	for _, secret := range []string{"password", "secret", "private_key", "api_key", "passphrase"} {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// scrub replaces the secrets of a JSON body and all the occurrences of the API token with REDACTED
+func scrub(body string, token string) string {
+	if token != "" {
+		body = strings.ReplaceAll(body, token, redacted)
+	}
+
+	value, ok := decode(body)
+	if !ok {
+		return body
+	}
+	return encode(mapFields(value, func(key string, value any) any {
+		if isSecretField(key) {
+			return redact(value)
+		}
+		return value
+	}))
+}
+
+// redact replaces all the strings and numbers of a secret, including the nested ones like
+// credential_details.fields.password.value, the objects and lists are kept so that the client can read the replayed responses
+func redact(value any) any {
+	switch typedValue := value.(type) {
+	case string:
+		return redacted
+	case json.Number:
+		return json.Number("0")
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range typedValue {
+			result[key] = redact(item)
+		}
+		return result
+	case []any:
+		result := []any{}
+		for _, item := range typedValue {
+			result = append(result, redact(item))
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// matchingKey is used to find the recorded interaction of a request, the values of the ignored and secret fields
+// are replaced so that requests with different random names or secrets match
+func matchingKey(method string, path string, query string, body string, ignoredFields map[string]bool) string {
+	parsedQuery, _ := url.ParseQuery(query)
+
+	if value, ok := decode(body); ok {
+		body = encode(mapFields(value, func(key string, value any) any {
+			if ignoredFields[key] || isSecretField(key) {
+				return "*"
+			}
+			return value
+		}))
+	}
+
+	return strings.Join([]string{method, path, parsedQuery.Encode(), body}, " ")
+}
+
+// volatileValues returns the values of the ignored fields that differ between the recorded and the actual request
+func volatileValues(recorded any, actual any, ignoredFields map[string]bool, values map[string]string) {
+	switch recordedValue := recorded.(type) {
+	case map[string]any:
+		actualValue, ok := actual.(map[string]any)
+		if !ok {
+			return
+		}
+		for key, item := range recordedValue {
+			if ignoredFields[key] {
+				collectStrings(item, actualValue[key], values)
+				continue
+			}
+			volatileValues(item, actualValue[key], ignoredFields, values)
+		}
+	case []any:
+		actualValue, ok := actual.([]any)
+		if !ok {
+			return
+		}
+		for i := 0; i < min(len(recordedValue), len(actualValue)); i++ {
+			volatileValues(recordedValue[i], actualValue[i], ignoredFields, values)
+		}
+	}
+}
+
+func collectStrings(recorded any, actual any, values map[string]string) {
+	switch recordedValue := recorded.(type) {
+	case string:
+		if actualValue, ok := actual.(string); ok && recordedValue != "" && recordedValue != actualValue {
+			values[recordedValue] = actualValue
+		}
+	case []any:
+		actualValue, ok := actual.([]any)
+		if !ok {
+			return
+		}
+		for i := 0; i < min(len(recordedValue), len(actualValue)); i++ {
+			collectStrings(recordedValue[i], actualValue[i], values)
+		}
+	}
+}
+
+// replaceVolatileValues replaces the recorded random values by the ones of the current run, longest first
+func replaceVolatileValues(body string, values map[string]string) string {
+	recordedValues := make([]string, 0, len(values))
+	for recordedValue := range values {
+		recordedValues = append(recordedValues, recordedValue)
+	}
+	sort.Slice(recordedValues, func(i, j int) bool {
+		if len(recordedValues[i]) != len(recordedValues[j]) {
+			return len(recordedValues[i]) > len(recordedValues[j])
+		}
+		return recordedValues[i] < recordedValues[j]
+	})
+
+	pairs := []string{}
+	for _, recordedValue := range recordedValues {
+		pairs = append(pairs, recordedValue, values[recordedValue])
+	}
+	return strings.NewReplacer(pairs...).Replace(body)
+}
+
+func decode(body string) (any, bool) {
+	if body == "" {
+		return nil, false
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+func encode(value any) string {
+	buffer := bytes.Buffer{}
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value)
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// mapFields replaces the values of all the fields of the JSON objects, at any depth, by the result of update
+func mapFields(value any, update func(key string, value any) any) any {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range typedValue {
+			result[key] = update(key, mapFields(item, update))
+		}
+		return result
+	case []any:
+		result := []any{}
+		for _, item := range typedValue {
+			result = append(result, mapFields(item, update))
+		}
+		return result
+	default:
+		return value
+	}
+}
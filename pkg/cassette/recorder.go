@@ -0,0 +1,265 @@
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Mode string
+
+const (
+	// ModeRecord sends the requests to the API and writes all the interactions to the cassette
+	ModeRecord Mode = "record"
+	// ModeReplay answers the requests from the cassette and sends the ones not recorded to the API
+	ModeReplay Mode = "replay"
+	// ModeCI answers the requests from the cassette and fails the ones not recorded, to catch API contract changes
+	ModeCI Mode = "ci"
+)
+
+const defaultPath = "testdata/dbt_cloud_cassette.json"
+
+// Recorder is an http.RoundTripper recording the interactions with the dbt Cloud API or replaying them
+type Recorder struct {
+	mode          Mode
+	path          string
+	token         string
+	ignoredFields map[string]bool
+	next          http.RoundTripper
+
+	mu             sync.Mutex
+	cassette       *Cassette
+	keys           []string
+	used           []bool
+	volatileValues map[string]string
+}
+
+// New creates a recorder for the cassette at path, the values of ignoredFields are not compared when matching
+// requests, in addition to the fields set to random names by the tests, and token is scrubbed from the fixtures
+func New(mode Mode, path string, token string, ignoredFields []string, next http.RoundTripper) (*Recorder, error) {
+	r := &Recorder{
+		mode:           mode,
+		path:           path,
+		token:          token,
+		ignoredFields:  map[string]bool{},
+		next:           next,
+		cassette:       &Cassette{Interactions: []Interaction{}},
+		volatileValues: map[string]string{},
+	}
+	for _, field := range append(defaultIgnoredFields, ignoredFields...) {
+		r.ignoredFields[field] = true
+	}
+
+	switch mode {
+	case ModeRecord:
+		// the cassette is recorded again from scratch
+	case ModeReplay, ModeCI:
+		cassette, err := load(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the cassette %s: %w", path, err)
+		}
+		r.cassette = cassette
+		for _, interaction := range cassette.Interactions {
+			r.keys = append(r.keys, r.interactionKey(interaction.Request))
+		}
+		r.used = make([]bool, len(cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("unknown cassette mode %q, it must be one of record, replay or ci", mode)
+	}
+
+	return r, nil
+}
+
+// AccountID returns the dbt Cloud account used when recording the cassette
+func (r *Recorder) AccountID() int {
+	return r.cassette.AccountID
+}
+
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		body = string(data)
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+
+	if response, ok := r.replay(req, body); ok {
+		return response, nil
+	}
+	if r.mode == ModeCI {
+		return nil, fmt.Errorf(
+			"no interaction recorded in %s for %s %s, the API contract might have changed and the cassette must be recorded again",
+			r.path,
+			req.Method,
+			req.URL.RequestURI(),
+		)
+	}
+	return r.next.RoundTrip(req)
+}
+
+func (r *Recorder) record(req *http.Request, body string) (*http.Response, error) {
+	res, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette.AccountID == 0 {
+		r.cassette.AccountID = accountIDFromPath(req.URL.Path)
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: Request{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  scrub(req.URL.RawQuery, r.token),
+			Body:   scrub(body, r.token),
+		},
+		Response: Response{
+			StatusCode:  res.StatusCode,
+			ContentType: res.Header.Get("Content-Type"),
+			Body:        scrub(string(responseBody), r.token),
+		},
+	})
+
+	// the cassette is saved after each interaction as there is no hook at the end of the tests
+	if err := r.cassette.save(r.path); err != nil {
+		return nil, fmt.Errorf("error writing the cassette %s: %w", r.path, err)
+	}
+	return res, nil
+}
+
+// replay returns the first unused interaction matching the request, or the last used one when the request is
+// sent more times than when recording, e.g. for an additional refresh
+func (r *Recorder) replay(req *http.Request, body string) (*http.Response, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := matchingKey(req.Method, req.URL.Path, req.URL.RawQuery, body, r.ignoredFields)
+
+	match := -1
+	for i, recordedKey := range r.keys {
+		if recordedKey != key {
+			continue
+		}
+		match = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if match == -1 {
+		return nil, false
+	}
+	r.used[match] = true
+
+	interaction := r.cassette.Interactions[match]
+	recordedBody, recordedOK := decode(interaction.Request.Body)
+	actualBody, actualOK := decode(body)
+	if recordedOK && actualOK {
+		volatileValues(recordedBody, actualBody, r.ignoredFields, r.volatileValues)
+	}
+
+	responseBody := replaceVolatileValues(interaction.Response.Body, r.volatileValues)
+	header := http.Header{}
+	if interaction.Response.ContentType != "" {
+		header.Set("Content-Type", interaction.Response.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       req,
+	}, true
+}
+
+func (r *Recorder) interactionKey(request Request) string {
+	return matchingKey(request.Method, request.Path, request.Query, request.Body, r.ignoredFields)
+}
+
+var (
+	sharedRecorder    *Recorder
+	sharedRecorderErr error
+	sharedRecorderMu  sync.Mutex
+)
+
+// FromEnv returns the recorder shared by all the clients, configured with DBT_CLOUD_CASSETTE_MODE (record, replay
+// or ci), DBT_CLOUD_CASSETTE (defaults to testdata/dbt_cloud_cassette.json in the package of the tests) and
+// DBT_CLOUD_CASSETTE_IGNORED_FIELDS (comma separated), nil when DBT_CLOUD_CASSETTE_MODE is not set
+func FromEnv() (*Recorder, error) {
+	mode := os.Getenv("DBT_CLOUD_CASSETTE_MODE")
+	if mode == "" {
+		return nil, nil
+	}
+
+	sharedRecorderMu.Lock()
+	defer sharedRecorderMu.Unlock()
+
+	if sharedRecorder == nil && sharedRecorderErr == nil {
+		path := os.Getenv("DBT_CLOUD_CASSETTE")
+		if path == "" {
+			path = defaultPath
+		}
+
+		ignoredFields := []string{}
+		for _, field := range strings.Split(os.Getenv("DBT_CLOUD_CASSETTE_IGNORED_FIELDS"), ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				ignoredFields = append(ignoredFields, field)
+			}
+		}
+
+		sharedRecorder, sharedRecorderErr = New(
+			Mode(mode),
+			path,
+			os.Getenv("DBT_CLOUD_TOKEN"),
+			ignoredFields,
+			http.DefaultTransport,
+		)
+	}
+
+	return sharedRecorder, sharedRecorderErr
+}
+
+// HTTPClient returns an HTTP client going through the recorder when DBT_CLOUD_CASSETTE_MODE is set
+func HTTPClient(timeout time.Duration) (*http.Client, error) {
+	client := &http.Client{Timeout: timeout}
+
+	recorder, err := FromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if recorder != nil {
+		client.Transport = recorder
+	}
+	return client, nil
+}
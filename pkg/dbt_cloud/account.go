@@ -0,0 +1,122 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type AccountResponse struct {
+	Data   AuthResponseData `json:"data"`
+	Status ResponseStatus   `json:"status"`
+}
+
+// GetAccount returns the details of the account of the client
+func (c *Client) GetAccount() (*AuthResponseData, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/v2/accounts/%d/", c.HostURL, c.AccountID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	accountResponse := AccountResponse{}
+	err = json.Unmarshal(body, &accountResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountResponse.Data, nil
+}
+
+// AccountSettings contains the settings of the account that can be changed, the nil values are kept as they are
+// and a docs or freshness job ID of 0 removes the job
+type AccountSettings struct {
+	Name                    *string
+	RunDurationLimitSeconds *int
+	GitAuthLevel            *string
+	DocsJobID               *int
+	FreshnessJobID          *int
+}
+
+type rawAccountResponse struct {
+	Data   map[string]any `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+// UpdateAccountSettings updates the settings of the account
+// the whole account needs to be sent, so we start from the current one to keep the fields we don't manage
+func (c *Client) UpdateAccountSettings(settings AccountSettings) (*AuthResponseData, error) {
+	accountURL := fmt.Sprintf("%s/v2/accounts/%d/", c.HostURL, c.AccountID)
+
+	req, err := http.NewRequest("GET", accountURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	currentAccount := rawAccountResponse{}
+	err = json.Unmarshal(body, &currentAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	account := currentAccount.Data
+	if settings.Name != nil {
+		account["name"] = *settings.Name
+	}
+	if settings.RunDurationLimitSeconds != nil {
+		account["run_duration_limit_seconds"] = *settings.RunDurationLimitSeconds
+	}
+	if settings.GitAuthLevel != nil {
+		account["git_auth_level"] = *settings.GitAuthLevel
+	}
+	if settings.DocsJobID != nil {
+		account["docs_job_id"] = jobIDOrNil(*settings.DocsJobID)
+	}
+	if settings.FreshnessJobID != nil {
+		account["freshness_job_id"] = jobIDOrNil(*settings.FreshnessJobID)
+	}
+
+	accountData, err := json.Marshal(account)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err = http.NewRequest("POST", accountURL, strings.NewReader(string(accountData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	accountResponse := AccountResponse{}
+	err = json.Unmarshal(body, &accountResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountResponse.Data, nil
+}
+
+func jobIDOrNil(jobID int) *int {
+	if jobID == 0 {
+		return nil
+	}
+	return &jobID
+}
@@ -8,7 +8,7 @@ import (
 )
 
 type AccountFeaturesResponse struct {
-	Data   AccountFeatures `json:"data"`
+	Data   json.RawMessage `json:"data"`
 	Status ResponseStatus  `json:"status"`
 	Extra  ResponseExtra   `json:"extra"`
 }
@@ -17,6 +17,8 @@ type AccountFeatures struct {
 	AdvancedCI     bool `json:"advanced-ci"`
 	PartialParsing bool `json:"partial-parsing"`
 	RepoCaching    bool `json:"repo-caching"`
+	// All contains all the boolean features of the account, including the ones above
+	All map[string]bool `json:"-"`
 }
 
 type AccountFeatureUpdateRequest struct {
@@ -45,7 +47,27 @@ func (c *Client) GetAccountFeatures() (*AccountFeatures, error) {
 		return nil, err
 	}
 
-	return &featuresResponse.Data, nil
+	features := AccountFeatures{}
+	err = json.Unmarshal(featuresResponse.Data, &features)
+	if err != nil {
+		return nil, err
+	}
+
+	// new features are rolled out regularly, so we also keep all of them in a map
+	// the features that are not booleans can't be set with UpdateAccountFeature and are skipped
+	allFeatures := map[string]any{}
+	err = json.Unmarshal(featuresResponse.Data, &allFeatures)
+	if err != nil {
+		return nil, err
+	}
+	features.All = map[string]bool{}
+	for feature, value := range allFeatures {
+		if boolValue, ok := value.(bool); ok {
+			features.All[feature] = boolValue
+		}
+	}
+
+	return &features, nil
 }
 
 func (c *Client) UpdateAccountFeature(feature string, value bool) error {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -62,6 +63,51 @@ type AdapterCredentialFieldMetadataOptions struct {
 	Value string `json:"value"`
 }
 
+// Keys returns the names of the fields, which are the keys of the dbt profile they are set in
+func (d AdapterCredentialDetails) Keys() []string {
+	keys := make([]string, 0, len(d.Fields))
+	for key := range d.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// AdapterProfileKeys returns the keys of the dbt profile described by the connection and credential field
+// metadata of an adapter version, e.g. databricks_v0, and false when the provider doesn't have this metadata
+func AdapterProfileKeys(adapterVersion string) ([]string, bool) {
+	adapter := adapterVersion
+	if lastUnderscoreIndex := strings.LastIndex(adapterVersion, "_"); lastUnderscoreIndex != -1 {
+		adapter = adapterVersion[:lastUnderscoreIndex]
+	}
+
+	details := []AdapterCredentialDetails{}
+	var credentialDetails AdapterCredentialDetails
+	var err error
+	switch adapter {
+	case "databricks":
+		details = append(details, *GetDatabricksConnectionDetails("", "", "", "", ""))
+		credentialDetails, err = GenerateDatabricksCredentialDetails("", "", "", "")
+	case "fabric":
+		details = append(details, *GetFabricConnectionDetails("", 0, "", 0, 0, 0))
+		credentialDetails, err = GenerateFabricCredentialDetails("", "", "", "", "", "", "")
+	case "teradata":
+		credentialDetails, err = GenerateTeradataCredentialDetails("", "", "", 0)
+	default:
+		return nil, false
+	}
+	if err != nil {
+		return nil, false
+	}
+	details = append(details, credentialDetails)
+
+	keys := []string{}
+	for _, detail := range details {
+		keys = append(keys, detail.Keys()...)
+	}
+	return keys, true
+}
+
 func createGenericAdapter(c *Client, newAdapter Adapter, projectID int) (*int, error) {
 	currentUser, err := c.GetConnectedUser()
 	if err != nil {
@@ -0,0 +1,18 @@
+package dbt_cloud
+
+import "encoding/json"
+
+type AuditLog struct {
+	ID           int64           `json:"id"`
+	AccountID    int64           `json:"account_id"`
+	ProjectID    *int64          `json:"project_id"`
+	ActorType    string          `json:"actor_type"`
+	ActorID      *int64          `json:"actor_id"`
+	ActorName    string          `json:"actor_name"`
+	EventType    string          `json:"event_type"`
+	Action       string          `json:"action"`
+	ObjectType   string          `json:"object_type"`
+	ObjectID     *int64          `json:"object_id"`
+	LoggedAt     string          `json:"logged_at"`
+	EventContext json.RawMessage `json:"event_context"`
+}
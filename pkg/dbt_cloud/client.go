@@ -93,13 +93,24 @@ type APIError struct {
 
 // NewClient -
 func NewClient(account_id *int, token *string, host_url *string) (*Client, error) {
+	return NewClientWithTransport(account_id, token, host_url, nil)
+}
+
+// NewClientWithTransport creates a client sending the requests with transport, e.g. to record them in the acceptance
+// tests, http.DefaultTransport is used when it is nil
+func NewClientWithTransport(
+	account_id *int,
+	token *string,
+	host_url *string,
+	transport http.RoundTripper,
+) (*Client, error) {
 
 	if (token == nil) || (*token == "") {
 		return nil, fmt.Errorf("token is set but it is empty")
 	}
 
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+		HTTPClient: &http.Client{Timeout: 30 * time.Second, Transport: transport},
 		HostURL:    *host_url,
 		Token:      *token,
 		AccountID:  *account_id,
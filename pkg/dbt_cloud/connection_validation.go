@@ -0,0 +1,153 @@
+package dbt_cloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	CONNECTION_TEST_STATE_PENDING = "pending"
+	CONNECTION_TEST_STATE_RUNNING = "running"
+	CONNECTION_TEST_STATE_SUCCESS = "success"
+	CONNECTION_TEST_STATE_FAILURE = "failure"
+)
+
+// the connection tests are run asynchronously by dbt Cloud, we poll the task until it is finished
+// they are variables so that the tests don't have to wait for minutes
+var (
+	ConnectionTestPollInterval = 2 * time.Second
+	ConnectionTestTimeout      = 5 * time.Minute
+)
+
+type ConnectionTestRequest struct {
+	ProjectID     *int `json:"project_id,omitempty"`
+	CredentialsID *int `json:"credentials_id,omitempty"`
+}
+
+type ConnectionTestTask struct {
+	ID           string `json:"id"`
+	ConnectionID int64  `json:"connection_id"`
+	State        string `json:"state"`
+	// Message contains the error returned by the adapter when the test fails
+	Message string `json:"message,omitempty"`
+}
+
+type ConnectionTestTaskResponse struct {
+	Data   ConnectionTestTask `json:"data"`
+	Status ResponseStatus     `json:"status"`
+}
+
+// IsFinished returns true when the test has succeeded or failed
+func (t ConnectionTestTask) IsFinished() bool {
+	return t.State == CONNECTION_TEST_STATE_SUCCESS || t.State == CONNECTION_TEST_STATE_FAILURE
+}
+
+// StartConnectionTest starts testing the connection, with the credentials of a project when credentialID is not 0
+func (c *Client) StartConnectionTest(
+	connectionID int64,
+	projectID int,
+	credentialID int,
+) (*ConnectionTestTask, error) {
+
+	connectionTest := ConnectionTestRequest{}
+	if credentialID != 0 {
+		connectionTest.ProjectID = &projectID
+		connectionTest.CredentialsID = &credentialID
+	}
+
+	connectionTestData, err := json.Marshal(connectionTest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/connections/%d/test/",
+			c.HostURL,
+			c.AccountID,
+			connectionID,
+		),
+		bytes.NewBuffer(connectionTestData),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionTestResponse := ConnectionTestTaskResponse{}
+	err = json.Unmarshal(body, &connectionTestResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connectionTestResponse.Data, nil
+}
+
+func (c *Client) GetConnectionTestTask(taskID string) (*ConnectionTestTask, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/tasks/%s/",
+			c.HostURL,
+			c.AccountID,
+			taskID,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionTestResponse := ConnectionTestTaskResponse{}
+	err = json.Unmarshal(body, &connectionTestResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connectionTestResponse.Data, nil
+}
+
+// TestConnection tests the connection and waits for the result, a failed test is not returned as an error
+func (c *Client) TestConnection(
+	connectionID int64,
+	projectID int,
+	credentialID int,
+) (*ConnectionTestTask, error) {
+
+	task, err := c.StartConnectionTest(connectionID, projectID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(ConnectionTestTimeout)
+	for !task.IsFinished() {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf(
+				"the test of the connection %d didn't finish after %s",
+				connectionID,
+				ConnectionTestTimeout,
+			)
+		}
+		time.Sleep(ConnectionTestPollInterval)
+
+		task, err = c.GetConnectionTestTask(task.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return task, nil
+}
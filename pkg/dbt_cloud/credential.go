@@ -5,6 +5,15 @@ import (
 	"net/http"
 )
 
+// Credential contains the fields common to the credentials of all the adapters
+type Credential struct {
+	ID        *int   `json:"id,omitempty"`
+	AccountID int    `json:"account_id"`
+	ProjectID int    `json:"project_id"`
+	Type      string `json:"type"`
+	State     int    `json:"state"`
+}
+
 func (c *Client) DeleteCredential(credentialId, projectId string) (string, error) {
 	req, err := http.NewRequest(
 		"DELETE",
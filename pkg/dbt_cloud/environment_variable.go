@@ -38,10 +38,8 @@ type CreateEnvironmentVariableResponse struct {
 	Status ResponseStatus                           `json:"status"`
 }
 
-func (c *Client) GetEnvironmentVariable(
-	projectID int,
-	environmentVariableName string,
-) (*EnvironmentVariable, error) {
+// getEnvironmentVariables returns the values of all the environment variables of the project, by name and environment
+func (c *Client) getEnvironmentVariables(projectID int) (*EnvironmentVariablesGet, error) {
 	req, err := http.NewRequest(
 		"GET",
 		fmt.Sprintf(
@@ -67,7 +65,19 @@ func (c *Client) GetEnvironmentVariable(
 		return nil, err
 	}
 
-	environmentsVariables, _ := environmentVariableResponse.Data.Variables[environmentVariableName]
+	return &environmentVariableResponse.Data, nil
+}
+
+func (c *Client) GetEnvironmentVariable(
+	projectID int,
+	environmentVariableName string,
+) (*EnvironmentVariable, error) {
+	environmentVariables, err := c.getEnvironmentVariables(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentsVariables, _ := environmentVariables.Variables[environmentVariableName]
 	if environmentsVariables == nil {
 		return nil, fmt.Errorf(
 			"resource-not-found: Environment variables %s not found in project ID %d",
@@ -89,6 +99,27 @@ func (c *Client) GetEnvironmentVariable(
 	return &environmentVariable, nil
 }
 
+func (c *Client) GetAllEnvironmentVariables(projectID int) ([]EnvironmentVariable, error) {
+	environmentVariables, err := c.getEnvironmentVariables(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	allEnvironmentVariables := []EnvironmentVariable{}
+	for name, environmentsVariables := range environmentVariables.Variables {
+		environmentValues := make(map[string]string)
+		for environmentName, environmentVariableNameValue := range environmentsVariables {
+			environmentValues[environmentName] = environmentVariableNameValue.Value
+		}
+		allEnvironmentVariables = append(allEnvironmentVariables, EnvironmentVariable{
+			Name:                  name,
+			ProjectID:             projectID,
+			EnvironmentNameValues: environmentValues,
+		})
+	}
+	return allEnvironmentVariables, nil
+}
+
 func (c *Client) CreateEnvironmentVariable(
 	projectID int,
 	name string,
@@ -0,0 +1,109 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type GitHubAccount struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+	Type  string `json:"type"`
+}
+
+// GitHubInstallation is an installation of the dbt Cloud GitHub App, as returned by GitHub
+type GitHubInstallation struct {
+	ID                  int64         `json:"id"`
+	Account             GitHubAccount `json:"account"`
+	AppID               int64         `json:"app_id"`
+	HTMLURL             string        `json:"html_url"`
+	RepositorySelection string        `json:"repository_selection"`
+}
+
+type GitHubRepository struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	Private       bool   `json:"private"`
+	HTMLURL       string `json:"html_url"`
+	CloneURL      string `json:"clone_url"`
+	SSHURL        string `json:"ssh_url"`
+	DefaultBranch string `json:"default_branch"`
+}
+
+type GitHubInstallationRepositoriesResponse struct {
+	TotalCount   int                `json:"total_count"`
+	Repositories []GitHubRepository `json:"repositories"`
+}
+
+// GetGitHubInstallations returns the GitHub App installations the user has access to
+// it requires a user token, the endpoint doesn't work with service tokens
+func (c *Client) GetGitHubInstallations() ([]GitHubInstallation, error) {
+
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/v2/integrations/github/installations/", c.HostURL),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// the response is the list returned by GitHub, without the usual data and status keys
+	gitHubInstallations := []GitHubInstallation{}
+	err = json.Unmarshal(body, &gitHubInstallations)
+	if err != nil {
+		return nil, err
+	}
+
+	return gitHubInstallations, nil
+}
+
+// the maximum page size accepted by GitHub, the default is 30
+const gitHubRepositoriesPerPage = 100
+
+// GetGitHubInstallationRepositories returns the repositories the GitHub App installation can access
+// the list is paginated by GitHub, the pages are requested until all the repositories are returned
+func (c *Client) GetGitHubInstallationRepositories(installationID int64) ([]GitHubRepository, error) {
+
+	repositories := []GitHubRepository{}
+	for page := 1; ; page++ {
+		req, err := http.NewRequest(
+			"GET",
+			fmt.Sprintf(
+				"%s/v2/integrations/github/installations/%d/repositories/?per_page=%d&page=%d",
+				c.HostURL,
+				installationID,
+				gitHubRepositoriesPerPage,
+				page,
+			),
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		repositoriesResponse := GitHubInstallationRepositoriesResponse{}
+		err = json.Unmarshal(body, &repositoriesResponse)
+		if err != nil {
+			return nil, err
+		}
+		repositories = append(repositories, repositoriesResponse.Repositories...)
+
+		// an empty page means that some repositories were removed since the first page
+		if len(repositories) >= repositoriesResponse.TotalCount || len(repositoriesResponse.Repositories) == 0 {
+			return repositories, nil
+		}
+	}
+}
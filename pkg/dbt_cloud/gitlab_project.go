@@ -0,0 +1,79 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type GitlabProject struct {
+	ID                int64  `json:"id"`
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+	HTTPURLToRepo     string `json:"http_url_to_repo"`
+	SSHURLToRepo      string `json:"ssh_url_to_repo"`
+	DefaultBranch     string `json:"default_branch"`
+}
+
+type GitlabProjectsResponse struct {
+	Data   []GitlabProject `json:"data"`
+	Status ResponseStatus  `json:"status"`
+}
+
+// GetGitlabProjects returns the GitLab projects matching the search the user has access to
+// it requires a user token, the endpoint doesn't work with service tokens
+func (c *Client) GetGitlabProjects(search string) ([]GitlabProject, error) {
+
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v3/integrations/gitlab/projects/?account_id=%d&search=%s",
+			c.HostURL,
+			c.AccountID,
+			url.QueryEscape(search),
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	gitlabProjectsResponse := GitlabProjectsResponse{}
+	err = json.Unmarshal(body, &gitlabProjectsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return gitlabProjectsResponse.Data, nil
+}
+
+// GetGitlabProject returns the GitLab project with the given path, e.g. my-group/my-project
+func (c *Client) GetGitlabProject(projectPath string) (*GitlabProject, error) {
+
+	// GitLab searches on the project name, not on the full path
+	projectName := projectPath[strings.LastIndex(projectPath, "/")+1:]
+
+	gitlabProjects, err := c.GetGitlabProjects(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, gitlabProject := range gitlabProjects {
+		if strings.EqualFold(gitlabProject.PathWithNamespace, projectPath) {
+			return &gitlabProject, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"Did not find any GitLab project with the path = '%s'",
+		projectPath,
+	)
+}
@@ -10,12 +10,13 @@ import (
 )
 
 type GlobalConnectionConfig interface {
+	// AdapterVersion returns the version used when none is provided
 	AdapterVersion() string
+	// AdapterVersions returns all the versions of the adapter supported by the provider
+	AdapterVersions() []string
 }
 
-// TODO: Could be improved in the future, maybe creating a client with empty Config
-// For now, I couldn't use it as the  AdapterVersion is not returned in the GET response
-// To be revisited when we handle different versions for the same adapter
+// GlobalConnectionAdapter is used to find the adapter of a connection before knowing its config type
 type GlobalConnectionAdapter struct {
 	Data struct {
 		ID             int64  `json:"id"`
@@ -55,6 +56,7 @@ func (c *Client) GetGlobalConnectionAdapter(connectionID int64) (*GlobalConnecti
 type GlobalConnectionCommon struct {
 	ID                    *int64                    `json:"id,omitempty"`
 	Name                  *string                   `json:"name,omitempty"`
+	AdapterVersion        *string                   `json:"adapter_version,omitempty"`
 	IsSshTunnelEnabled    *bool                     `json:"is_ssh_tunnel_enabled,omitempty"`
 	PrivateLinkEndpointId nullable.Nullable[string] `json:"private_link_endpoint_id,omitempty"`
 	OauthConfigurationId  nullable.Nullable[int64]  `json:"oauth_configuration_id,omitempty"`
@@ -64,9 +66,8 @@ type GlobalConnectionCommon struct {
 
 type globalConnectionPayload[T GlobalConnectionConfig] struct {
 	GlobalConnectionCommon
-	AccountID      int64   `json:"account_id"`
-	AdapterVersion *string `json:"adapter_version,omitempty"`
-	Config         T       `json:"config"`
+	AccountID int64 `json:"account_id"`
+	Config    T     `json:"config"`
 }
 
 type globalConnectionResponse[T GlobalConnectionConfig] struct {
@@ -121,12 +122,15 @@ func (c *GlobalConnectionClient[T]) Create(
 	buffer := new(bytes.Buffer)
 	enc := json.NewEncoder(buffer)
 
-	av := config.AdapterVersion()
+	// we use the default version of the adapter unless a specific one is requested
+	if common.AdapterVersion == nil {
+		av := config.AdapterVersion()
+		common.AdapterVersion = &av
+	}
 
 	payload := globalConnectionPayload[T]{
 		GlobalConnectionCommon: common,
 		AccountID:              int64(c.AccountID),
-		AdapterVersion:         &av,
 		Config:                 config,
 	}
 
@@ -352,6 +356,10 @@ func (EmptyConfig) AdapterVersion() string {
 	return "n/a"
 }
 
+func (EmptyConfig) AdapterVersions() []string {
+	return nil
+}
+
 type SnowflakeConfig struct {
 	Account                *string                   `json:"account,omitempty"`
 	Database               *string                   `json:"database,omitempty"`
@@ -367,6 +375,10 @@ func (SnowflakeConfig) AdapterVersion() string {
 	return "snowflake_v0"
 }
 
+func (SnowflakeConfig) AdapterVersions() []string {
+	return []string{"snowflake_v0", "snowflake_v1"}
+}
+
 type BigQueryConfig struct {
 	ProjectID                 *string                   `json:"project_id,omitempty"`
 	TimeoutSeconds            *int64                    `json:"timeout_seconds,omitempty"`
@@ -398,6 +410,10 @@ func (BigQueryConfig) AdapterVersion() string {
 	return "bigquery_v0"
 }
 
+func (BigQueryConfig) AdapterVersions() []string {
+	return []string{"bigquery_v0"}
+}
+
 type DatabricksConfig struct {
 	Host         *string                   `json:"host,omitempty"`
 	HTTPPath     *string                   `json:"http_path,omitempty"`
@@ -410,6 +426,10 @@ func (DatabricksConfig) AdapterVersion() string {
 	return "databricks_v0"
 }
 
+func (DatabricksConfig) AdapterVersions() []string {
+	return []string{"databricks_v0", "databricks_v1"}
+}
+
 // Redshift and Postgres are the same today but they might diverge in the future to support more authentication methods
 type RedshiftConfig struct {
 	HostName *string                   `json:"hostname,omitempty"`
@@ -421,6 +441,10 @@ func (RedshiftConfig) AdapterVersion() string {
 	return "redshift_v0"
 }
 
+func (RedshiftConfig) AdapterVersions() []string {
+	return []string{"redshift_v0"}
+}
+
 type PostgresConfig struct {
 	HostName *string                   `json:"hostname,omitempty"`
 	Port     *int64                    `json:"port,omitempty"`
@@ -431,6 +455,10 @@ func (PostgresConfig) AdapterVersion() string {
 	return "postgres_v0"
 }
 
+func (PostgresConfig) AdapterVersions() []string {
+	return []string{"postgres_v0"}
+}
+
 var FabricDriver = "ODBC Driver 18 for SQL Server"
 
 type FabricConfig struct {
@@ -447,6 +475,10 @@ func (FabricConfig) AdapterVersion() string {
 	return "fabric_v0"
 }
 
+func (FabricConfig) AdapterVersions() []string {
+	return []string{"fabric_v0"}
+}
+
 // Right now Synapse and Fabric are the same
 // If they diverge in the future, we can update the SynapseConfig struct
 var SynapseDriver = FabricDriver
@@ -465,6 +497,10 @@ func (SynapseConfig) AdapterVersion() string {
 	return "synapse_v0"
 }
 
+func (SynapseConfig) AdapterVersions() []string {
+	return []string{"synapse_v0"}
+}
+
 type StarburstConfig struct {
 	Method *string `json:"method,omitempty"`
 	Host   *string `json:"host,omitempty"`
@@ -475,6 +511,10 @@ func (StarburstConfig) AdapterVersion() string {
 	return "trino_v0"
 }
 
+func (StarburstConfig) AdapterVersions() []string {
+	return []string{"trino_v0"}
+}
+
 type AthenaConfig struct {
 	RegionName        *string                   `json:"region_name,omitempty"`
 	Database          *string                   `json:"database,omitempty"`
@@ -494,6 +534,10 @@ func (AthenaConfig) AdapterVersion() string {
 	return "athena_v0"
 }
 
+func (AthenaConfig) AdapterVersions() []string {
+	return []string{"athena_v0"}
+}
+
 type ApacheSparkConfig struct {
 	Method         *string                   `json:"method,omitempty"`
 	Host           *string                   `json:"host,omitempty"`
@@ -510,3 +554,23 @@ type ApacheSparkConfig struct {
 func (ApacheSparkConfig) AdapterVersion() string {
 	return "apache_spark_v0"
 }
+
+func (ApacheSparkConfig) AdapterVersions() []string {
+	return []string{"apache_spark_v0"}
+}
+
+type TeradataConfig struct {
+	Host           *string `json:"host,omitempty"`
+	Port           *int64  `json:"port,omitempty"`
+	Tmode          *string `json:"tmode,omitempty"`
+	Retries        *int64  `json:"retries,omitempty"`
+	RequestTimeout *int64  `json:"request_timeout,omitempty"`
+}
+
+func (TeradataConfig) AdapterVersion() string {
+	return "teradata_v0"
+}
+
+func (TeradataConfig) AdapterVersions() []string {
+	return []string{"teradata_v0"}
+}
@@ -28,6 +28,7 @@ type Group struct {
 	AssignByDefault  bool              `json:"assign_by_default"`
 	SSOMappingGroups []string          `json:"sso_mapping_groups"`
 	Permissions      []GroupPermission `json:"group_permissions,omitempty"`
+	CreatedAt        string            `json:"created_at,omitempty"`
 }
 
 type GroupResponse struct {
@@ -21,10 +21,12 @@ type IPRestrictionsRule struct {
 	Description            string  `json:"description"`
 	Cidrs                  []Cidrs `json:"cidrs,"`
 	RuleSetEnabled         bool    `json:"rule_set_enabled"`
+	// only sent when set, to keep the current value when updating rules
+	EnabledForServiceTokens *bool `json:"enabled_for_service_tokens,omitempty"`
 	// not needed for TF
-	State                   int64 `json:"state,omitempty"`
-	CreatedByID             int64 `json:"created_by_id,omitempty"`
-	EnabledForServiceTokens bool  `json:"enabled_for_service_tokens,omitempty"`
+	State       int64  `json:"state,omitempty"`
+	CreatedByID int64  `json:"created_by_id,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
 }
 
 type Cidrs struct {
@@ -198,3 +200,56 @@ func (c *Client) DeleteIPRestrictionsRule(ipRestrictionsRuleID int64) error {
 
 	return nil
 }
+
+type IPRestrictionsSettings struct {
+	RuleSetEnabled          bool
+	EnabledForServiceTokens bool
+}
+
+// GetIPRestrictionsSettings returns the account wide enforcement settings, which are stored on each rule of the rule set
+func (c *Client) GetIPRestrictionsSettings() (*IPRestrictionsSettings, error) {
+	allIPRestrictions, err := c.GetIPRestrictions()
+	if err != nil {
+		return nil, err
+	}
+
+	settings := IPRestrictionsSettings{}
+	for _, ipRestrictionsRule := range *allIPRestrictions {
+		settings.RuleSetEnabled = settings.RuleSetEnabled || ipRestrictionsRule.RuleSetEnabled
+		if ipRestrictionsRule.EnabledForServiceTokens != nil {
+			settings.EnabledForServiceTokens = settings.EnabledForServiceTokens ||
+				*ipRestrictionsRule.EnabledForServiceTokens
+		}
+	}
+
+	return &settings, nil
+}
+
+// UpdateIPRestrictionsSettings sets the enforcement settings on all the rules of the rule set
+func (c *Client) UpdateIPRestrictionsSettings(
+	settings IPRestrictionsSettings,
+) (*IPRestrictionsSettings, error) {
+	allIPRestrictions, err := c.GetIPRestrictions()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(*allIPRestrictions) == 0 && settings.RuleSetEnabled {
+		return nil, fmt.Errorf("IP restrictions can't be enabled when no rule is defined")
+	}
+
+	for _, ipRestrictionsRule := range *allIPRestrictions {
+		ipRestrictionsRule.RuleSetEnabled = settings.RuleSetEnabled
+		ipRestrictionsRule.EnabledForServiceTokens = &settings.EnabledForServiceTokens
+
+		_, err := c.UpdateIPRestrictionsRule(
+			strconv.FormatInt(ipRestrictionsRule.ID, 10),
+			ipRestrictionsRule,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &settings, nil
+}
@@ -14,6 +14,7 @@ type LicenseMap struct {
 	AccountID               int      `json:"account_id"`
 	State                   int      `json:"state"`
 	SSOLicenseMappingGroups []string `json:"sso_license_mapping_groups"`
+	CreatedAt               string   `json:"created_at,omitempty"`
 }
 
 type LicenseMapResponse struct {
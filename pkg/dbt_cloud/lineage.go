@@ -7,11 +7,23 @@ import (
 	"strings"
 )
 
+const (
+	LINEAGE_INTEGRATION_TABLEAU  = "tableau"
+	LINEAGE_INTEGRATION_POWER_BI = "powerbi"
+)
+
+// LineageIntegrationConfig contains the fields of all the BI tools, only the ones of the tool set in the name are used
 type LineageIntegrationConfig struct {
+	// Tableau
 	Host      string `json:"host,omitempty"`
 	SiteID    string `json:"site_id,omitempty"`
 	TokenName string `json:"token_name,omitempty"`
 	Token     string `json:"token,omitempty"`
+	// Power BI
+	TenantID     string    `json:"tenant_id,omitempty"`
+	ClientID     string    `json:"client_id,omitempty"`
+	ClientSecret string    `json:"client_secret,omitempty"`
+	WorkspaceIDs *[]string `json:"workspace_ids,omitempty"`
 }
 
 type LineageIntegration struct {
@@ -27,6 +39,11 @@ type LineageIntegrationResponse struct {
 	Status ResponseStatus     `json:"status"`
 }
 
+type LineageIntegrationListResponse struct {
+	Data   []LineageIntegration `json:"data"`
+	Status ResponseStatus       `json:"status"`
+}
+
 func (c *Client) GetLineageIntegration(
 	projectID int64,
 	lineageIntegrationID int64,
@@ -60,24 +77,45 @@ func (c *Client) GetLineageIntegration(
 	return &lineageIntegrationResponse.Data, nil
 }
 
+func (c *Client) GetLineageIntegrations(projectID int64) ([]LineageIntegration, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/integrations/lineage/",
+			c.HostURL,
+			c.AccountID,
+			projectID,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	lineageIntegrationListResponse := LineageIntegrationListResponse{}
+	err = json.Unmarshal(body, &lineageIntegrationListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return lineageIntegrationListResponse.Data, nil
+}
+
 func (c *Client) CreateLineageIntegration(
 	projectID int64,
 	name string,
-	host string,
-	siteID string,
-	tokenName string,
-	token string,
+	config LineageIntegrationConfig,
 ) (*LineageIntegration, error) {
 	newLineageIntegration := LineageIntegration{
 		AccountID: int64(c.AccountID),
 		ProjectID: projectID,
 		Name:      name,
-		Config: LineageIntegrationConfig{
-			Host:      host,
-			SiteID:    siteID,
-			TokenName: tokenName,
-			Token:     token,
-		},
+		Config:    config,
 	}
 	newLineageIntegrationData, err := json.Marshal(newLineageIntegration)
 	if err != nil {
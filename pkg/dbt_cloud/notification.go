@@ -26,6 +26,9 @@ type Notification struct {
 	ExternalEmail    *string `json:"external_email"`
 	SlackChannelID   *string `json:"slack_channel_id"`
 	SlackChannelName *string `json:"slack_channel_name"`
+	TeamsChannelID   *string `json:"ms_teams_channel_id"`
+	TeamsChannelName *string `json:"ms_teams_channel_name"`
+	CreatedAt        string  `json:"created_at,omitempty"`
 }
 
 func (c *Client) GetNotification(notificationID string) (*Notification, error) {
@@ -67,7 +70,9 @@ func (c *Client) CreateNotification(
 	notificationType int,
 	externalEmail *string,
 	slackChannelID *string,
-	slackChannelName *string) (*Notification, error) {
+	slackChannelName *string,
+	teamsChannelID *string,
+	teamsChannelName *string) (*Notification, error) {
 
 	newNotification := Notification{
 		AccountId:        c.AccountID,
@@ -81,6 +86,8 @@ func (c *Client) CreateNotification(
 		ExternalEmail:    externalEmail,
 		SlackChannelID:   slackChannelID,
 		SlackChannelName: slackChannelName,
+		TeamsChannelID:   teamsChannelID,
+		TeamsChannelName: teamsChannelName,
 	}
 
 	newNotificationData, err := json.Marshal(newNotification)
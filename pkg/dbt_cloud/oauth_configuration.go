@@ -8,21 +8,35 @@ import (
 	"strings"
 )
 
+const (
+	OAUTH_TYPE_ENTRA     = "entra"
+	OAUTH_TYPE_OKTA      = "okta"
+	OAUTH_TYPE_SNOWFLAKE = "snowflake"
+)
+
 type OAuthConfiguration struct {
 	ID                      *int64                   `json:"id,omitempty"`
 	AccountId               int64                    `json:"account_id"`
 	Type                    string                   `json:"type"`
 	Name                    string                   `json:"name"`
 	ClientId                string                   `json:"client_id"`
-	ClientSecret            string                   `json:"client_secret"`
+	ClientSecret            string                   `json:"client_secret,omitempty"`
 	AuthorizeUrl            string                   `json:"authorize_url"`
 	TokenUrl                string                   `json:"token_url"`
 	RedirectUri             string                   `json:"redirect_uri"`
 	OAuthConfigurationExtra *OAuthConfigurationExtra `json:"extra_data,omitempty"`
+	CreatedAt               string                   `json:"created_at,omitempty"`
 }
 
 type OAuthConfigurationExtra struct {
+	// only for Entra ID
 	ApplicationIdUri *string `json:"application_id_uri,omitempty"`
+	// only for Okta
+	Issuer   *string  `json:"issuer,omitempty"`
+	Audience *string  `json:"audience,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
+	// only for Snowflake native OAuth
+	SecurityIntegrationName *string `json:"security_integration_name,omitempty"`
 }
 
 type OAuthConfigurationListResponse struct {
@@ -73,7 +87,7 @@ func (c *Client) CreateOAuthConfiguration(
 	authorizeUrl string,
 	tokenUrl string,
 	redirectUri string,
-	applicationURI string,
+	oAuthConfigurationExtra *OAuthConfigurationExtra,
 ) (*OAuthConfiguration, error) {
 	newOAuthConfiguration := OAuthConfiguration{
 		AccountId:    int64(c.AccountID),
@@ -84,13 +98,8 @@ func (c *Client) CreateOAuthConfiguration(
 		AuthorizeUrl: authorizeUrl,
 		TokenUrl:     tokenUrl,
 		RedirectUri:  redirectUri,
-	}
-
-	if applicationURI != "" {
-		newOAuthConfigurationExtra := OAuthConfigurationExtra{
-			ApplicationIdUri: &applicationURI,
-		}
-		newOAuthConfiguration.OAuthConfigurationExtra = &newOAuthConfigurationExtra
+		// the extra data is nil when there is no specific config for the type
+		OAuthConfigurationExtra: oAuthConfigurationExtra,
 	}
 
 	newOAuthConfigurationData, err := json.Marshal(newOAuthConfiguration)
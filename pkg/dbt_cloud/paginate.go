@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/samber/lo"
@@ -41,56 +42,58 @@ func (c *Client) GetEndpoint(url string) ([]byte, error) {
 }
 
 func (c *Client) GetData(url string) []any {
-
-	// get the first page
-	jsonPayload, err := c.GetEndpoint(url)
+	allResponses, err := c.GetAllPages(url)
 	if err != nil {
 		log.Fatal(err)
 	}
+	return allResponses
+}
 
-	var response Response
-
-	err = json.Unmarshal(jsonPayload, &response)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	allResponses := response.Data
-
-	count := response.Extra.Pagination.Count
-	for count < response.Extra.Pagination.TotalCount {
-		// get the next page
-
-		var newURL string
-		lastPartURL, _ := lo.Last(strings.Split(url, "/"))
-		if strings.Contains(lastPartURL, "?") {
-			newURL = fmt.Sprintf("%s&offset=%d", url, count)
-		} else {
-			newURL = fmt.Sprintf("%s?offset=%d", url, count)
+// GetAllPages returns the objects of all the pages of url, like GetData, but returns the errors instead of exiting
+func (c *Client) GetAllPages(url string) ([]any, error) {
+	allResponses := []any{}
+
+	count := 0
+	for {
+		pageURL := url
+		if count > 0 {
+			// get the next page
+			lastPartURL, _ := lo.Last(strings.Split(url, "/"))
+			if strings.Contains(lastPartURL, "?") {
+				pageURL = fmt.Sprintf("%s&offset=%d", url, count)
+			} else {
+				pageURL = fmt.Sprintf("%s?offset=%d", url, count)
+			}
 		}
 
-		jsonPayload, err := c.GetEndpoint(newURL)
+		req, err := http.NewRequest("GET", pageURL, nil)
 		if err != nil {
-			log.Fatal(err)
+			return nil, err
+		}
+		jsonPayload, err := c.doRequest(req)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching URL %v: %w", pageURL, err)
 		}
-		var response Response
 
+		var response Response
 		err = json.Unmarshal(jsonPayload, &response)
 		if err != nil {
-			log.Fatal(err)
+			return nil, err
 		}
+		allResponses = append(allResponses, response.Data...)
 
 		if response.Extra.Pagination.Count == 0 {
 			// Unlucky! one object might have been deleted since the first call
 			// if we don't stop here we will loop forever!
 			break
-		} else {
-			count += response.Extra.Pagination.Count
 		}
-		allResponses = append(allResponses, response.Data...)
+		count += response.Extra.Pagination.Count
+		if count >= response.Extra.Pagination.TotalCount {
+			break
+		}
 	}
 
-	return allResponses
+	return allResponses, nil
 }
 
 func (c *Client) GetAllGroupIDsByName(groupName string) []int {
@@ -106,6 +109,25 @@ func (c *Client) GetAllGroupIDsByName(groupName string) []int {
 	})
 }
 
+func (c *Client) GetAllGroups() ([]Group, error) {
+	url := fmt.Sprintf("%s/v3/accounts/%d/groups/", c.HostURL, c.AccountID)
+
+	allGroupsRaw := c.GetData(url)
+
+	allGroups := []Group{}
+	for _, group := range allGroupsRaw {
+
+		data, _ := json.Marshal(group)
+		currentGroup := Group{}
+		err := json.Unmarshal(data, &currentGroup)
+		if err != nil {
+			return nil, err
+		}
+		allGroups = append(allGroups, currentGroup)
+	}
+	return allGroups, nil
+}
+
 func (c *Client) GetAllEnvironments(projectID int) ([]Environment, error) {
 	url := fmt.Sprintf("%s/v3/accounts/%d/environments/", c.HostURL, c.AccountID)
 
@@ -230,3 +252,153 @@ func (c *Client) GetAllJobs(projectID int, environmentID int) ([]JobWithEnvironm
 	}
 	return allJobs, nil
 }
+
+func (c *Client) GetAllWebhooks() ([]WebhookRead, error) {
+	url := fmt.Sprintf("%s/v3/accounts/%d/webhooks/subscriptions", c.HostURL, c.AccountID)
+
+	allWebhooksRaw := c.GetData(url)
+
+	allWebhooks := []WebhookRead{}
+	for _, webhook := range allWebhooksRaw {
+
+		data, _ := json.Marshal(webhook)
+		currentWebhook := WebhookRead{}
+		err := json.Unmarshal(data, &currentWebhook)
+		if err != nil {
+			return nil, err
+		}
+		allWebhooks = append(allWebhooks, currentWebhook)
+	}
+	return allWebhooks, nil
+}
+
+// GetAllAuditLogs returns the audit logs logged between loggedAtStart and loggedAtEnd (RFC 3339), an empty loggedAtEnd means now
+func (c *Client) GetAllAuditLogs(loggedAtStart, loggedAtEnd string) ([]AuditLog, error) {
+	params := url.Values{}
+	params.Set("logged_at_start", loggedAtStart)
+	if loggedAtEnd != "" {
+		params.Set("logged_at_end", loggedAtEnd)
+	}
+
+	auditLogsURL := fmt.Sprintf(
+		"%s/v3/accounts/%d/audit-logs/?%s",
+		c.HostURL,
+		c.AccountID,
+		params.Encode(),
+	)
+
+	// the endpoint is only available for Enterprise accounts and returns a 403 otherwise
+	allAuditLogsRaw, err := c.GetAllPages(auditLogsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	allAuditLogs := []AuditLog{}
+	for _, auditLog := range allAuditLogsRaw {
+
+		data, _ := json.Marshal(auditLog)
+		currentAuditLog := AuditLog{}
+		err := json.Unmarshal(data, &currentAuditLog)
+		if err != nil {
+			return nil, err
+		}
+		allAuditLogs = append(allAuditLogs, currentAuditLog)
+	}
+	return allAuditLogs, nil
+}
+
+func (c *Client) GetAllOAuthConfigurations() ([]OAuthConfiguration, error) {
+	url := fmt.Sprintf("%s/v3/accounts/%d/oauth-configurations/", c.HostURL, c.AccountID)
+
+	allOAuthConfigurationsRaw := c.GetData(url)
+
+	allOAuthConfigurations := []OAuthConfiguration{}
+	for _, oAuthConfiguration := range allOAuthConfigurationsRaw {
+
+		data, _ := json.Marshal(oAuthConfiguration)
+		currentOAuthConfiguration := OAuthConfiguration{}
+		err := json.Unmarshal(data, &currentOAuthConfiguration)
+		if err != nil {
+			return nil, err
+		}
+		allOAuthConfigurations = append(allOAuthConfigurations, currentOAuthConfiguration)
+	}
+	return allOAuthConfigurations, nil
+}
+
+func (c *Client) GetAllRepositories(projectID int) ([]Repository, error) {
+	url := fmt.Sprintf("%s/v3/accounts/%d/projects/%d/repositories/", c.HostURL, c.AccountID, projectID)
+
+	allRepositoriesRaw := c.GetData(url)
+
+	allRepositories := []Repository{}
+	for _, repository := range allRepositoriesRaw {
+
+		data, _ := json.Marshal(repository)
+		currentRepository := Repository{}
+		err := json.Unmarshal(data, &currentRepository)
+		if err != nil {
+			return nil, err
+		}
+		allRepositories = append(allRepositories, currentRepository)
+	}
+	return allRepositories, nil
+}
+
+func (c *Client) GetAllCredentials(projectID int) ([]Credential, error) {
+	url := fmt.Sprintf("%s/v3/accounts/%d/projects/%d/credentials/", c.HostURL, c.AccountID, projectID)
+
+	allCredentialsRaw := c.GetData(url)
+
+	allCredentials := []Credential{}
+	for _, credential := range allCredentialsRaw {
+
+		data, _ := json.Marshal(credential)
+		currentCredential := Credential{}
+		err := json.Unmarshal(data, &currentCredential)
+		if err != nil {
+			return nil, err
+		}
+		allCredentials = append(allCredentials, currentCredential)
+	}
+	return allCredentials, nil
+}
+
+func (c *Client) GetAllExtendedAttributes(projectID int) ([]ExtendedAttributes, error) {
+	url := fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/", c.HostURL, c.AccountID, projectID)
+
+	allExtendedAttributesRaw := c.GetData(url)
+
+	allExtendedAttributes := []ExtendedAttributes{}
+	for _, extendedAttributes := range allExtendedAttributesRaw {
+
+		data, _ := json.Marshal(extendedAttributes)
+		currentExtendedAttributes := ExtendedAttributes{}
+		err := json.Unmarshal(data, &currentExtendedAttributes)
+		if err != nil {
+			return nil, err
+		}
+		allExtendedAttributes = append(allExtendedAttributes, currentExtendedAttributes)
+	}
+	return allExtendedAttributes, nil
+}
+
+// GetAllProjectConnections returns the legacy connections of a project, without their details which depend on the adapter
+func (c *Client) GetAllProjectConnections(projectID int) ([]BaseConnection, error) {
+	url := fmt.Sprintf("%s/v3/accounts/%d/projects/%d/connections/", c.HostURL, c.AccountID, projectID)
+
+	allConnectionsRaw := c.GetData(url)
+
+	allConnections := []BaseConnection{}
+	for _, connection := range allConnectionsRaw {
+
+		data, _ := json.Marshal(connection)
+		currentConnection := BaseConnection{}
+		err := json.Unmarshal(data, &currentConnection)
+		if err != nil {
+			return nil, err
+		}
+		allConnections = append(allConnections, currentConnection)
+	}
+	return allConnections, nil
+}
@@ -26,12 +26,8 @@ type PrivatelinkEndpointResponse struct {
 	Status ResponseStatus      `json:"status"`
 }
 
-func (c *Client) GetPrivatelinkEndpoint(endpointName string, privatelinkEndpointURL string) (*PrivatelinkEndpoint, error) {
-
-	if endpointName == "" && privatelinkEndpointURL == "" {
-		return nil, fmt.Errorf("The endpoint name or url needs to be provided")
-	}
-
+// GetPrivatelinkEndpoints returns all the PrivateLink endpoints of the account
+func (c *Client) GetPrivatelinkEndpoints() ([]PrivatelinkEndpoint, error) {
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/private-link-endpoints/", c.HostURL, c.AccountID), nil)
 	if err != nil {
 		return nil, err
@@ -42,16 +38,30 @@ func (c *Client) GetPrivatelinkEndpoint(endpointName string, privatelinkEndpoint
 		return nil, err
 	}
 
-	PrivatelinkEndpointListResponse := PrivatelinkEndpointListResponse{}
-	err = json.Unmarshal(body, &PrivatelinkEndpointListResponse)
+	privatelinkEndpointListResponse := PrivatelinkEndpointListResponse{}
+	err = json.Unmarshal(body, &privatelinkEndpointListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return privatelinkEndpointListResponse.Data, nil
+}
+
+func (c *Client) GetPrivatelinkEndpoint(endpointName string, privatelinkEndpointURL string) (*PrivatelinkEndpoint, error) {
+
+	if endpointName == "" && privatelinkEndpointURL == "" {
+		return nil, fmt.Errorf("The endpoint name or url needs to be provided")
+	}
+
+	privatelinkEndpoints, err := c.GetPrivatelinkEndpoints()
 	if err != nil {
 		return nil, err
 	}
 
-	for i, endpoint := range PrivatelinkEndpointListResponse.Data {
+	for i, endpoint := range privatelinkEndpoints {
 		if (endpointName == "" || endpoint.Name == endpointName) &&
 			(privatelinkEndpointURL == "" || endpoint.PrivatelinkEndpointURL == privatelinkEndpointURL) {
-			return &PrivatelinkEndpointListResponse.Data[i], nil
+			return &privatelinkEndpoints[i], nil
 		}
 	}
 
@@ -180,6 +180,21 @@ func (c *Client) UpdateRepository(
 		return nil, err
 	}
 
+	// the GitHub installation is only used by the github_app clone strategy, it is sent as null for the other
+	// strategies so that it is removed when switching strategy, as omitempty would not send it
+	if repository.GitCloneStrategy != "github_app" {
+		repositoryFields := map[string]json.RawMessage{}
+		err = json.Unmarshal(repositoryData, &repositoryFields)
+		if err != nil {
+			return nil, err
+		}
+		repositoryFields["github_installation_id"] = json.RawMessage("null")
+		repositoryData, err = json.Marshal(repositoryFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	req, err := http.NewRequest(
 		"POST",
 		fmt.Sprintf(
@@ -232,3 +247,66 @@ func (c *Client) DeleteRepository(repositoryID, projectID string) (string, error
 
 	return "", err
 }
+
+type DeployKeyResponse struct {
+	Data   DeployKey      `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+// CreateDeployKey generates a new SSH key pair in dbt Cloud, it needs to be linked to a repository with DeployKeyID
+func (c *Client) CreateDeployKey() (*DeployKey, error) {
+	newDeployKey := DeployKey{
+		AccountID: c.AccountID,
+		State:     STATE_ACTIVE,
+	}
+	newDeployKeyData, err := json.Marshal(newDeployKey)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf(
+			"%s/v3/accounts/%s/deploy-keys/",
+			c.HostURL,
+			strconv.Itoa(c.AccountID),
+		),
+		strings.NewReader(string(newDeployKeyData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	deployKeyResponse := DeployKeyResponse{}
+	err = json.Unmarshal(body, &deployKeyResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deployKeyResponse.Data, nil
+}
+
+// DeleteDeployKey deletes a deploy key, e.g. after it has been replaced by a new one in a repository
+func (c *Client) DeleteDeployKey(deployKeyID int) error {
+	req, err := http.NewRequest(
+		"DELETE",
+		fmt.Sprintf(
+			"%s/v3/accounts/%s/deploy-keys/%d/",
+			c.HostURL,
+			strconv.Itoa(c.AccountID),
+			deployKeyID,
+		),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
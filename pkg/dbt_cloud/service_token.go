@@ -27,6 +27,7 @@ type ServiceToken struct {
 	TokenString *string                  `json:"token_string,omitempty"`
 	State       int                      `json:"state"`
 	Permissions []ServiceTokenPermission `json:"service_token_permissions,omitempty"`
+	CreatedAt   string                   `json:"created_at,omitempty"`
 }
 
 type ServiceTokenResponse struct {
@@ -0,0 +1,87 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type SlackIntegration struct {
+	ID        int    `json:"id"`
+	AccountID int    `json:"account_id"`
+	State     int    `json:"state"`
+	TeamID    string `json:"team_id"`
+	TeamName  string `json:"team_name"`
+}
+
+type SlackIntegrationResponse struct {
+	Data   SlackIntegration `json:"data"`
+	Status ResponseStatus   `json:"status"`
+}
+
+type SlackChannel struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IsPrivate bool   `json:"is_private"`
+}
+
+type SlackChannelListResponse struct {
+	Data   []SlackChannel `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+func (c *Client) GetSlackIntegration() (*SlackIntegration, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/v2/accounts/%d/integrations/slack/", c.HostURL, c.AccountID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	slackIntegrationResponse := SlackIntegrationResponse{}
+	err = json.Unmarshal(body, &slackIntegrationResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	// the integration can be returned after it has been disconnected from the account
+	if slackIntegrationResponse.Data.State != STATE_ACTIVE {
+		return nil, fmt.Errorf(
+			"resource-not-found: the Slack integration is not active for the account %d",
+			c.AccountID,
+		)
+	}
+
+	return &slackIntegrationResponse.Data, nil
+}
+
+func (c *Client) GetSlackChannels() ([]SlackChannel, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/v2/accounts/%d/integrations/slack/channels/", c.HostURL, c.AccountID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	slackChannelListResponse := SlackChannelListResponse{}
+	err = json.Unmarshal(body, &slackChannelListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return slackChannelListResponse.Data, nil
+}
@@ -0,0 +1,111 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// the values of enterprise_authentication_method for the SSO methods
+const (
+	SSO_METHOD_SAML     = "saml2"
+	SSO_METHOD_OKTA     = "okta"
+	SSO_METHOD_ENTRA_ID = "azure_single_tenant"
+)
+
+// SSOConfiguration contains the fields of all the SSO methods, only the ones of the method in AuthenticationMethod are used
+type SSOConfiguration struct {
+	AccountID            int    `json:"account_id"`
+	AuthenticationMethod string `json:"authentication_method"`
+	LoginSlug            string `json:"login_slug"`
+	LoginURL             string `json:"login_url,omitempty"`
+	// SAML 2.0
+	MetadataURL string `json:"metadata_url,omitempty"`
+	MetadataXML string `json:"metadata_xml,omitempty"`
+	Certificate string `json:"certificate,omitempty"`
+	// Okta and Microsoft Entra ID
+	Domain       string `json:"domain,omitempty"`
+	ClientID     string `json:"client_id,omitempty"`
+	ClientSecret string `json:"client_secret,omitempty"`
+	// Microsoft Entra ID
+	TenantID string `json:"tenant_id,omitempty"`
+}
+
+type SSOConfigurationResponse struct {
+	Data   SSOConfiguration `json:"data"`
+	Status ResponseStatus   `json:"status"`
+}
+
+func (c *Client) GetSSOConfiguration() (*SSOConfiguration, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/v3/accounts/%d/sso-configuration/", c.HostURL, c.AccountID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ssoConfigurationResponse := SSOConfigurationResponse{}
+	err = json.Unmarshal(body, &ssoConfigurationResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssoConfigurationResponse.Data, nil
+}
+
+// UpdateSSOConfiguration sets the SSO configuration of the account, replacing the existing one
+func (c *Client) UpdateSSOConfiguration(
+	ssoConfiguration SSOConfiguration,
+) (*SSOConfiguration, error) {
+	ssoConfiguration.AccountID = c.AccountID
+
+	ssoConfigurationData, err := json.Marshal(ssoConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/v3/accounts/%d/sso-configuration/", c.HostURL, c.AccountID),
+		strings.NewReader(string(ssoConfigurationData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ssoConfigurationResponse := SSOConfigurationResponse{}
+	err = json.Unmarshal(body, &ssoConfigurationResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssoConfigurationResponse.Data, nil
+}
+
+// DeleteSSOConfiguration disables SSO for the account, users then log in with their password
+func (c *Client) DeleteSSOConfiguration() error {
+	req, err := http.NewRequest(
+		"DELETE",
+		fmt.Sprintf("%s/v3/accounts/%d/sso-configuration/", c.HostURL, c.AccountID),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
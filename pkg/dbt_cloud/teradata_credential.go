@@ -0,0 +1,262 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type TeradataCredentialResponse struct {
+	Data   TeradataCredential `json:"data"`
+	Status ResponseStatus     `json:"status"`
+}
+
+type TeradataUnencryptedCredentialDetails struct {
+	User    string `json:"user"`
+	Schema  string `json:"schema"`
+	Threads int    `json:"threads"`
+}
+
+type TeradataCredential struct {
+	ID                           *int                                 `json:"id"`
+	AccountID                    int                                  `json:"account_id"`
+	ProjectID                    int                                  `json:"project_id"`
+	Type                         string                               `json:"type"`
+	State                        int                                  `json:"state"`
+	Threads                      int                                  `json:"threads"`
+	AdapterVersion               string                               `json:"adapter_version,omitempty"`
+	CredentialDetails            AdapterCredentialDetails             `json:"credential_details"`
+	UnencryptedCredentialDetails TeradataUnencryptedCredentialDetails `json:"unencrypted_credential_details"`
+}
+
+type TeradataCredentialPatch struct {
+	ID                int                      `json:"id"`
+	Threads           int                      `json:"threads,omitempty"`
+	CredentialDetails AdapterCredentialDetails `json:"credential_details"`
+}
+
+func (c *Client) GetTeradataCredential(
+	projectId int,
+	credentialId int,
+) (*TeradataCredential, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
+			c.HostURL,
+			c.AccountID,
+			projectId,
+			credentialId,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialResponse := TeradataCredentialResponse{}
+	err = json.Unmarshal(body, &credentialResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentialResponse.Data, nil
+}
+
+func (c *Client) CreateTeradataCredential(
+	projectId int,
+	user string,
+	password string,
+	schema string,
+	threads int,
+) (*TeradataCredential, error) {
+
+	credentialDetails, err := GenerateTeradataCredentialDetails(
+		user,
+		password,
+		schema,
+		threads,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	newTeradataCredential := TeradataCredential{
+		AccountID:         c.AccountID,
+		ProjectID:         projectId,
+		Type:              "adapter",
+		AdapterVersion:    TeradataConfig{}.AdapterVersion(),
+		State:             STATE_ACTIVE,
+		Threads:           threads,
+		CredentialDetails: credentialDetails,
+	}
+
+	newTeradataCredentialData, err := json.Marshal(newTeradataCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/credentials/",
+			c.HostURL,
+			c.AccountID,
+			projectId,
+		),
+		strings.NewReader(string(newTeradataCredentialData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	teradataCredentialResponse := TeradataCredentialResponse{}
+	err = json.Unmarshal(body, &teradataCredentialResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &teradataCredentialResponse.Data, nil
+}
+
+func (c *Client) UpdateTeradataCredential(
+	projectId int,
+	credentialId int,
+	teradataCredential TeradataCredentialPatch,
+) (*TeradataCredential, error) {
+	teradataCredentialData, err := json.Marshal(teradataCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"PATCH",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
+			c.HostURL,
+			c.AccountID,
+			projectId,
+			credentialId,
+		),
+		strings.NewReader(string(teradataCredentialData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	teradataCredentialResponse := TeradataCredentialResponse{}
+	err = json.Unmarshal(body, &teradataCredentialResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &teradataCredentialResponse.Data, nil
+}
+
+func GenerateTeradataCredentialDetails(
+	user string,
+	password string,
+	schema string,
+	threads int,
+) (AdapterCredentialDetails, error) {
+	// the default config is taken from  the calls made to the API
+	// we just remove all the different values and set them to ""
+	defaultConfig := `{
+	"fields": {
+      "user": {
+        "metadata": {
+          "label": "Username",
+          "description": "The username to connect to Teradata with.",
+          "field_type": "text",
+          "encrypt": false,
+          "overrideable": false,
+          "validation": {
+            "required": true
+          }
+        },
+        "value": ""
+      },
+      "password": {
+        "metadata": {
+          "label": "Password",
+          "description": "The password to connect to Teradata with.",
+          "field_type": "text",
+          "encrypt": true,
+          "overrideable": false,
+          "validation": {
+            "required": true
+          }
+        },
+        "value": ""
+      },
+      "schema": {
+        "metadata": {
+          "label": "Schema",
+          "description": "User schema.",
+          "field_type": "text",
+          "encrypt": false,
+          "overrideable": false,
+          "validation": {
+            "required": true
+          }
+        },
+        "value": ""
+      },
+      "threads": {
+        "metadata": {
+          "label": "Threads",
+          "description": "The number of threads to use for dbt operations.",
+          "field_type": "number",
+          "encrypt": false,
+          "overrideable": false,
+          "validation": {
+            "required": true
+          }
+        },
+        "value": 6
+      }
+    }
+	}
+`
+	// we load the raw JSON to make it easier to update if the schema changes in the future
+	var teradataCredentialDetailsDefault AdapterCredentialDetails
+	err := json.Unmarshal([]byte(defaultConfig), &teradataCredentialDetailsDefault)
+	if err != nil {
+		return teradataCredentialDetailsDefault, err
+	}
+
+	fieldMapping := map[string]interface{}{
+		"user":     user,
+		"password": password,
+		"schema":   schema,
+		"threads":  threads,
+	}
+
+	teradataCredentialFields := map[string]AdapterCredentialField{}
+	for key, value := range teradataCredentialDetailsDefault.Fields {
+		value.Value = fieldMapping[key]
+		teradataCredentialFields[key] = value
+	}
+
+	credentialDetails := AdapterCredentialDetails{
+		Fields:      teradataCredentialFields,
+		Field_Order: []string{},
+	}
+	return credentialDetails, nil
+}
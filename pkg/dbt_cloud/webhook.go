@@ -24,6 +24,7 @@ type WebhookRead struct {
 	HmacSecret        *string  `json:"hmac_secret,omitempty"`
 	HttpStatusCode    *string  `json:"http_status_code,omitempty"`
 	AccountIdentifier *string  `json:"account_identifier,omitempty"`
+	CreatedAt         string   `json:"created_at,omitempty"`
 }
 
 type WebhookWrite struct {
@@ -173,3 +174,73 @@ func (c *Client) DeleteWebhook(webhookId string) (string, error) {
 
 	return "", err
 }
+
+type WebhookTest struct {
+	VerificationError      *string `json:"verification_error"`
+	VerificationStatusCode *string `json:"verification_status_code"`
+}
+
+type WebhookTestResponse struct {
+	Data   WebhookTest    `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+// TestWebhook asks dbt Cloud to send a test event to the webhook client URL and returns the delivery result
+func (c *Client) TestWebhook(webhookId string) (*WebhookTest, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v3/accounts/%s/webhooks/subscription/%s/test",
+			c.HostURL,
+			strconv.Itoa(c.AccountID),
+			webhookId,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookTestResponse := WebhookTestResponse{}
+	err = json.Unmarshal(body, &webhookTestResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookTestResponse.Data, nil
+}
+
+// RegenerateWebhookSecret generates a new HMAC secret for the webhook, the previous one stops being valid
+func (c *Client) RegenerateWebhookSecret(webhookId string) (*WebhookRead, error) {
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf(
+			"%s/v3/accounts/%s/webhooks/subscription/%s/regenerate-secret",
+			c.HostURL,
+			strconv.Itoa(c.AccountID),
+			webhookId,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookResponse := WebhookResponse{}
+	err = json.Unmarshal(body, &webhookResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookResponse.Data, nil
+}
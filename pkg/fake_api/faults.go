@@ -0,0 +1,106 @@
+package fake_api
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Fault makes the fake API fail the matching requests with the given status code
+type Fault struct {
+	// Method is the HTTP method to match, empty matches all the methods
+	Method string
+	// Path is a regular expression matched against the path of the request
+	Path *regexp.Regexp
+	// StatusCode is returned instead of handling the request, e.g. 404, 409, 429 or 503
+	StatusCode int
+	// Times is the number of requests to fail before the fault is removed, 0 fails all the requests
+	Times int
+}
+
+// InjectFault adds a fault, faults are checked in the order they were added
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all the faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+func (s *Server) matchFault(method string, path string) *Fault {
+	for i, fault := range s.faults {
+		if fault.Method != "" && !strings.EqualFold(fault.Method, method) {
+			continue
+		}
+		if fault.Path != nil && !fault.Path.MatchString(path) {
+			continue
+		}
+
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+func (f *Fault) write(w http.ResponseWriter) {
+	if f.StatusCode == http.StatusTooManyRequests {
+		w.Header().Set("Retry-After", "1")
+	}
+	respondError(w, f.StatusCode, fmt.Sprintf("injected fault: %s", http.StatusText(f.StatusCode)))
+}
+
+// ParseFaults reads faults separated by `;` in the format `[METHOD] PATH_REGEX STATUS_CODE[xTIMES]`
+// e.g. `GET /projects/ 429x2; POST /jobs/ 503`
+func ParseFaults(faults string) ([]Fault, error) {
+	parsedFaults := []Fault{}
+	for _, rawFault := range strings.Split(faults, ";") {
+		fields := strings.Fields(rawFault)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) > 3 || len(fields) < 2 {
+			return nil, fmt.Errorf("invalid fault %q, the format is `[METHOD] PATH_REGEX STATUS_CODE[xTIMES]`", rawFault)
+		}
+
+		fault := Fault{}
+		if len(fields) == 3 {
+			fault.Method = strings.ToUpper(fields[0])
+			fields = fields[1:]
+		}
+
+		path, err := regexp.Compile(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid path regex in fault %q: %w", rawFault, err)
+		}
+		fault.Path = path
+
+		statusCode, times, hasTimes := strings.Cut(fields[1], "x")
+		fault.StatusCode, err = strconv.Atoi(statusCode)
+		if err != nil || fault.StatusCode < 400 || fault.StatusCode > 599 {
+			return nil, fmt.Errorf("invalid status code in fault %q, it must be between 400 and 599", rawFault)
+		}
+		if hasTimes {
+			fault.Times, err = strconv.Atoi(times)
+			if err != nil || fault.Times < 1 {
+				return nil, fmt.Errorf("invalid number of times in fault %q", rawFault)
+			}
+		}
+
+		parsedFaults = append(parsedFaults, fault)
+	}
+	return parsedFaults, nil
+}
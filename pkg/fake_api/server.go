@@ -0,0 +1,722 @@
+package fake_api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	stateActive  = 1
+	stateDeleted = 2
+
+	defaultLimit = 100
+	// FailingWebhookURL is a client_url for which the test events of the webhooks fail with a 500
+	FailingWebhookURL = "https://webhook.example.com/failing"
+	// the page sizes of the GitHub API
+	gitHubDefaultPerPage = 30
+	gitHubMaxPerPage     = 100
+)
+
+// Request is a request received by the fake API, kept so that tests can check what the provider sent
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Body   any
+}
+
+// Server is an in-memory fake of the dbt Cloud v2, v3 and private API used by the provider
+//
+// Objects are stored by kind (the collection name in the URL, e.g. `projects` or `service-tokens`)
+// and parent IDs in the URL (e.g. `/projects/1/environments/`) are saved as fields of the objects
+// (`project_id`), so that the same objects can be listed from nested and account level endpoints.
+// Updating an object to the deleted state removes it, like the real API which then returns 404s.
+type Server struct {
+	*httptest.Server
+
+	AccountID int
+	Token     string
+	// the user owning the token, returned by whoami
+	UserID    int64
+	UserEmail string
+
+	mu       sync.Mutex
+	lastID   int64
+	account  map[string]any
+	features map[string]any
+	objects  map[string]map[int64]map[string]any
+	faults   []*Fault
+	requests []Request
+	// the results of the connection tests by connection ID, the tests fail for the connections not in the map
+	connectionTests map[int64]ConnectionTestResult
+}
+
+// ConnectionTestResult is the state and message of the tasks testing a connection
+type ConnectionTestResult struct {
+	State   string
+	Message string
+}
+
+// NewServer starts a fake API accepting requests for accountID authenticated with token
+func NewServer(accountID int, token string) *Server {
+	s := &Server{
+		AccountID: accountID,
+		Token:     token,
+		account: map[string]any{
+			"id":                               int64(accountID),
+			"name":                             "fake account",
+			"state":                            int64(stateActive),
+			"plan":                             "enterprise",
+			"pending_cancel":                   false,
+			"locked":                           false,
+			"run_slots":                        int64(5),
+			"developer_seats":                  int64(10),
+			"read_only_seats":                  int64(10),
+			"queue_limit":                      int64(50),
+			"run_duration_limit_seconds":       int64(86400),
+			"pod_memory_request_mebibytes":     int64(600),
+			"git_auth_level":                   "personal",
+			"develop_file_system":              true,
+			"enterprise_authentication_method": "okta",
+			"created_at":                       "2020-01-01T00:00:00Z",
+			"updated_at":                       "2020-01-01T00:00:00Z",
+		},
+		features: map[string]any{
+			"advanced-ci":     false,
+			"partial-parsing": false,
+			"repo-caching":    false,
+		},
+		objects:         map[string]map[int64]map[string]any{},
+		connectionTests: map[int64]ConnectionTestResult{},
+	}
+	s.UserEmail = "fake.user@example.com"
+	s.UserID = s.create("users", map[string]any{
+		"email":      s.UserEmail,
+		"first_name": "Fake",
+		"last_name":  "User",
+		"permissions": []any{map[string]any{
+			"account_id":   int64(accountID),
+			"license_type": "developer",
+			"groups":       []any{},
+		}},
+	}, nil)
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// HostURL is the URL to use as DBT_CLOUD_HOST_URL to send the provider requests to the fake API
+func (s *Server) HostURL() string {
+	return s.URL
+}
+
+// Seed adds an object of the given kind, as if it had been created outside of Terraform, and returns its ID
+func (s *Server) Seed(kind string, object map[string]any) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.create(kind, normalize(object).(map[string]any), nil)
+}
+
+// Objects returns the objects of the given kind currently stored
+func (s *Server) Objects(kind string) []map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	objects := []map[string]any{}
+	for _, object := range s.list(kind, nil) {
+		objects = append(objects, s.withRelated(object, ""))
+	}
+	return objects
+}
+
+// SetConnectionTestResult sets the result of the next tests of the connection, e.g. `success`, or `running` for a
+// test that never finishes
+func (s *Server) SetConnectionTestResult(connectionID int64, result ConnectionTestResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.connectionTests[connectionID] = result
+}
+
+// Requests returns all the requests received so far, including the ones that got a fault injected
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request{}, s.requests...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rawBody, _ := io.ReadAll(r.Body)
+	var body any
+	if len(rawBody) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(rawBody))
+		decoder.UseNumber()
+		if err := decoder.Decode(&body); err != nil {
+			respondError(w, http.StatusBadRequest, fmt.Sprintf("invalid JSON body: %s", err))
+			return
+		}
+		body = normalize(body)
+	}
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.RawQuery,
+		Body:   body,
+	})
+
+	if fault := s.matchFault(r.Method, r.URL.Path); fault != nil {
+		fault.write(w)
+		return
+	}
+
+	if r.Header.Get("Authorization") != fmt.Sprintf("Token %s", s.Token) {
+		respondError(w, http.StatusUnauthorized, "Invalid token.")
+		return
+	}
+
+	segments := strings.FieldsFunc(r.URL.Path, func(c rune) bool { return c == '/' })
+	if len(segments) == 2 && segments[1] == "whoami" {
+		respond(w, http.StatusOK, map[string]any{"user": s.get("users", strconv.FormatInt(s.UserID, 10))}, nil)
+		return
+	}
+	if len(segments) >= 4 && strings.Join(segments[1:4], "/") == "integrations/github/installations" {
+		s.handleGitHubInstallations(w, r, segments[4:])
+		return
+	}
+	if len(segments) < 2 || segments[1] != "accounts" {
+		respondError(w, http.StatusNotFound, fmt.Sprintf("unknown endpoint %s", r.URL.Path))
+		return
+	}
+
+	// the list of accounts is used by the provider to validate the token
+	if len(segments) == 2 {
+		respond(w, http.StatusOK, []any{s.account}, nil)
+		return
+	}
+
+	if segments[2] != strconv.Itoa(s.AccountID) {
+		respondError(w, http.StatusForbidden, "You do not have access to this account.")
+		return
+	}
+
+	switch {
+	case len(segments) == 3:
+		s.handleAccount(w, r.Method, body)
+	case segments[3] == "features":
+		s.handleFeatures(w, r.Method, body)
+	case len(segments) == 4 && segments[3] == "assign-groups" && r.Method == http.MethodPost:
+		s.handleAssignGroups(w, body)
+	case segments[3] == "group-permissions" && len(segments) == 5:
+		s.handleGroupPermissions(w, r.Method, segments[4], body)
+	case len(segments) == 5 && segments[3] == "integrations" && segments[4] == "slack":
+		s.handleSlackIntegration(w)
+	case len(segments) == 6 && segments[3] == "connections" && segments[5] == "test" && r.Method == http.MethodPost:
+		s.handleConnectionTest(w, segments[4])
+	case len(segments) == 5 && segments[3] == "tasks":
+		s.handleTask(w, segments[4])
+	case len(segments) == 7 && segments[3] == "webhooks" && segments[4] == "subscription":
+		s.handleWebhookAction(w, r.Method, segments[5], segments[6])
+	case len(segments) == 7 && segments[3] == "projects" && segments[5] == "environment-variables" &&
+		(segments[6] == "environment" || segments[6] == "job" || segments[6] == "bulk"):
+		s.handleEnvironmentVariables(w, r, segments[4], segments[6], body)
+	default:
+		s.handleObjects(w, r, segments[3:], body)
+	}
+}
+
+func (s *Server) handleAccount(w http.ResponseWriter, method string, body any) {
+	if method != http.MethodGet {
+		fields, ok := body.(map[string]any)
+		if !ok {
+			respondError(w, http.StatusBadRequest, "the account must be a JSON object")
+			return
+		}
+		for key, value := range fields {
+			if key != "id" {
+				s.account[key] = value
+			}
+		}
+	}
+	respond(w, http.StatusOK, s.account, nil)
+}
+
+func (s *Server) handleFeatures(w http.ResponseWriter, method string, body any) {
+	if method != http.MethodGet {
+		fields, _ := body.(map[string]any)
+		feature, ok := fields["feature"].(string)
+		if !ok {
+			respondError(w, http.StatusBadRequest, "feature is required")
+			return
+		}
+		s.features[feature] = fields["value"]
+	}
+	respond(w, http.StatusOK, s.features, nil)
+}
+
+// the group permissions are replaced all at once and returned with the group
+func (s *Server) handleGroupPermissions(w http.ResponseWriter, method string, groupID string, body any) {
+	group := s.get("groups", groupID)
+	if group == nil || method != http.MethodPost {
+		respondNotFound(w)
+		return
+	}
+
+	permissions, ok := body.([]any)
+	if !ok {
+		respondError(w, http.StatusBadRequest, "the group permissions must be a JSON list")
+		return
+	}
+	for _, permission := range permissions {
+		if fields, ok := permission.(map[string]any); ok {
+			s.lastID++
+			fields["id"] = s.lastID
+			fields["group_id"] = group["id"]
+			fields["account_id"] = int64(s.AccountID)
+			if _, ok := fields["state"]; !ok {
+				fields["state"] = int64(stateActive)
+			}
+			if _, ok := fields["writable_environment_categories"]; !ok {
+				fields["writable_environment_categories"] = []any{}
+			}
+		}
+	}
+	group["group_permissions"] = permissions
+	respond(w, http.StatusOK, permissions, nil)
+}
+
+// the groups of a user are replaced all at once and stored in the permission of the account
+func (s *Server) handleAssignGroups(w http.ResponseWriter, body any) {
+	fields, _ := body.(map[string]any)
+	user := s.get("users", fmt.Sprint(fields["user_id"]))
+	if user == nil {
+		respondNotFound(w)
+		return
+	}
+
+	groupIDs, _ := fields["desired_group_ids"].([]any)
+	groups := []any{}
+	for _, groupID := range groupIDs {
+		group := s.get("groups", fmt.Sprint(groupID))
+		if group == nil {
+			respondError(w, http.StatusBadRequest, fmt.Sprintf("the group %v doesn't exist", groupID))
+			return
+		}
+		groups = append(groups, group)
+	}
+
+	for _, permission := range user["permissions"].([]any) {
+		if permission := permission.(map[string]any); jsonInt(permission["account_id"]) == int64(s.AccountID) {
+			permission["groups"] = groups
+		}
+	}
+	respond(w, http.StatusOK, groups, nil)
+}
+
+// the Slack integration is set up in the dbt Cloud UI, tests can add it with Seed("integrations/slack", ...)
+func (s *Server) handleSlackIntegration(w http.ResponseWriter) {
+	integrations := s.list("integrations/slack", nil)
+	if len(integrations) == 0 {
+		respondNotFound(w)
+		return
+	}
+	respond(w, http.StatusOK, integrations[0], nil)
+}
+
+// the GitHub App is installed outside of dbt Cloud, tests can add installations with Seed("github-installations", ...)
+// and their repositories with Seed("github-repositories", ...) and an installation_id
+// the responses are the ones of GitHub, without the usual data and status keys, and the repositories are paginated
+// like GitHub does with page and per_page
+func (s *Server) handleGitHubInstallations(w http.ResponseWriter, r *http.Request, segments []string) {
+	var response any
+	switch {
+	case len(segments) == 0:
+		response = s.list("github-installations", nil)
+	case len(segments) == 2 && segments[1] == "repositories":
+		if s.get("github-installations", segments[0]) == nil {
+			respondNotFound(w)
+			return
+		}
+		repositories := s.list("github-repositories", map[string]string{"installation_id": segments[0]})
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+		perPage, err := strconv.Atoi(r.URL.Query().Get("per_page"))
+		if err != nil || perPage < 1 {
+			perPage = gitHubDefaultPerPage
+		}
+		perPage = min(perPage, gitHubMaxPerPage)
+		start := min((page-1)*perPage, len(repositories))
+
+		response = map[string]any{
+			"total_count":  len(repositories),
+			"repositories": repositories[start:min(start+perPage, len(repositories))],
+		}
+	default:
+		respondError(w, http.StatusNotFound, fmt.Sprintf("unknown endpoint %s", r.URL.Path))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// the fake API can't reach the warehouses, the connection tests fail right away like with wrong credentials unless
+// another result is set with SetConnectionTestResult
+func (s *Server) handleConnectionTest(w http.ResponseWriter, connectionID string) {
+	connection := s.get("connections", connectionID)
+	if connection == nil {
+		respondNotFound(w)
+		return
+	}
+	id, _ := strconv.ParseInt(connectionID, 10, 64)
+	result, ok := s.connectionTests[id]
+	if !ok {
+		result = ConnectionTestResult{
+			State:   "failure",
+			Message: fmt.Sprintf("Could not connect to the warehouse of the connection %s", connection["name"]),
+		}
+	}
+	taskID := s.create("tasks", map[string]any{
+		"connection_id": connection["id"],
+		"state":         result.State,
+		"message":       result.Message,
+	}, nil)
+	s.handleTask(w, strconv.FormatInt(taskID, 10))
+}
+
+// the tasks are identified by a string in the API
+func (s *Server) handleTask(w http.ResponseWriter, taskID string) {
+	task := s.get("tasks", taskID)
+	if task == nil {
+		respondNotFound(w)
+		return
+	}
+	response := map[string]any{}
+	for key, value := range task {
+		response[key] = value
+	}
+	response["id"] = taskID
+	respond(w, http.StatusOK, response, nil)
+}
+
+// the fake API doesn't call the webhooks, the test events are considered delivered except for FailingWebhookURL
+func (s *Server) handleWebhookAction(w http.ResponseWriter, method string, webhookID string, action string) {
+	webhook := s.get("webhooks/subscriptions", webhookID)
+	if webhook == nil {
+		respondNotFound(w)
+		return
+	}
+
+	switch {
+	case action == "test" && method == http.MethodGet && webhook["client_url"] == FailingWebhookURL:
+		respond(w, http.StatusOK, map[string]any{"verification_error": "Internal Server Error", "verification_status_code": "500"}, nil)
+	case action == "test" && method == http.MethodGet:
+		respond(w, http.StatusOK, map[string]any{"verification_error": nil, "verification_status_code": "200"}, nil)
+	case action == "regenerate-secret" && method == http.MethodPost:
+		s.lastID++
+		webhook["hmac_secret"] = fmt.Sprintf("secret%d", s.lastID)
+		webhook["updated_at"] = timestamp()
+		respond(w, http.StatusOK, webhook, nil)
+	default:
+		respondNotFound(w)
+	}
+}
+
+// the environment variables are stored with one object per environment, the API returns them grouped by name
+// and they are created, updated and deleted by name with the bulk endpoint. The job overrides are objects of the
+// type job created, updated and deleted like the other objects
+func (s *Server) handleEnvironmentVariables(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID string,
+	action string,
+	body any,
+) {
+	if s.get("projects", projectID) == nil {
+		respondNotFound(w)
+		return
+	}
+	method := r.Method
+	fields, _ := body.(map[string]any)
+
+	switch {
+	case action == "job" && method == http.MethodGet:
+		variables := s.environmentVariableValues(projectID)
+		jobOverrides := s.list("environment-variables", map[string]string{
+			"project_id":        projectID,
+			"type":              "job",
+			"job_definition_id": r.URL.Query().Get("job_definition_id"),
+		})
+		for _, jobOverride := range jobOverrides {
+			name := jobOverride["name"].(string)
+			if variables[name] == nil {
+				variables[name] = map[string]any{}
+			}
+			variables[name].(map[string]any)["job"] = map[string]any{
+				"id":    jobOverride["id"],
+				"value": jobOverride["raw_value"],
+			}
+		}
+		respond(w, http.StatusOK, variables, nil)
+	case action == "environment" && method == http.MethodGet:
+		environments := []any{"project"}
+		for _, environment := range s.list("environments", map[string]string{"project_id": projectID}) {
+			environments = append(environments, environment["name"])
+		}
+		variables := s.environmentVariableValues(projectID)
+		respond(w, http.StatusOK, map[string]any{"environments": environments, "variables": variables}, nil)
+	case action == "bulk" && method == http.MethodPost:
+		values, _ := fields["env_var"].(map[string]any)
+		name, _ := values["new_name"].(string)
+		if name == "" || len(s.environmentVariables(projectID, name)) > 0 {
+			respondError(w, http.StatusBadRequest, fmt.Sprintf("invalid environment variable %q", name))
+			return
+		}
+		delete(values, "new_name")
+		respond(w, http.StatusCreated, map[string]any{"new_var_ids": s.setEnvironmentVariables(projectID, name, values)}, nil)
+	case action == "bulk" && method == http.MethodPut:
+		values, _ := fields["env_vars"].(map[string]any)
+		name, _ := values["name"].(string)
+		if len(s.environmentVariables(projectID, name)) == 0 {
+			respondNotFound(w)
+			return
+		}
+		delete(values, "name")
+		respond(w, http.StatusOK, map[string]any{"new_var_ids": s.setEnvironmentVariables(projectID, name, values)}, nil)
+	case action == "bulk" && method == http.MethodDelete:
+		name, _ := fields["name"].(string)
+		variables := s.environmentVariables(projectID, name)
+		if len(variables) == 0 {
+			respondNotFound(w)
+			return
+		}
+		for _, variable := range variables {
+			delete(s.objects["environment-variables"], jsonInt(variable["id"]))
+		}
+		respond(w, http.StatusOK, map[string]any{"message": "deleted"}, nil)
+	default:
+		respondNotFound(w)
+	}
+}
+
+// environmentVariableValues returns the values of the environment variables of the project by name and environment
+func (s *Server) environmentVariableValues(projectID string) map[string]any {
+	variables := map[string]any{}
+	for _, variable := range s.environmentVariables(projectID, "") {
+		name := variable["name"].(string)
+		if variables[name] == nil {
+			variables[name] = map[string]any{}
+		}
+		variables[name].(map[string]any)[variable["environment_name"].(string)] = map[string]any{
+			"id":    variable["id"],
+			"value": variable["value"],
+		}
+	}
+	return variables
+}
+
+// environmentVariables returns the values of the environment variables of the project, all of them when name is empty
+func (s *Server) environmentVariables(projectID string, name string) []map[string]any {
+	filters := map[string]string{"project_id": projectID}
+	if name != "" {
+		filters["name"] = name
+	}
+	variables := []map[string]any{}
+	for _, variable := range s.list("environment-variables", filters) {
+		if variable["type"] != "job" {
+			variables = append(variables, variable)
+		}
+	}
+	return variables
+}
+
+// setEnvironmentVariables replaces the values of the environment variable name and returns their IDs
+func (s *Server) setEnvironmentVariables(projectID string, name string, values map[string]any) []any {
+	for _, variable := range s.environmentVariables(projectID, name) {
+		delete(s.objects["environment-variables"], jsonInt(variable["id"]))
+	}
+
+	parents := []parent{{kind: "projects", id: projectID}}
+	ids := []any{}
+	for environmentName, value := range values {
+		ids = append(ids, s.create("environment-variables", map[string]any{
+			"name":             name,
+			"environment_name": environmentName,
+			"value":            value,
+		}, parents))
+	}
+	return ids
+}
+
+func (s *Server) handleObjects(w http.ResponseWriter, r *http.Request, segments []string, body any) {
+	route := parseRoute(segments)
+
+	// creating or listing nested objects requires their parents to exist
+	for _, parent := range route.parents {
+		if s.get(parent.kind, parent.id) == nil {
+			respondNotFound(w)
+			return
+		}
+	}
+
+	if route.id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			s.handleList(w, r, route)
+		case http.MethodPost, http.MethodPut:
+			s.handleCreate(w, route, body)
+		default:
+			respondError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s is not allowed on a list", r.Method))
+		}
+		return
+	}
+
+	object := s.get(route.kind, route.id)
+	if object == nil {
+		respondNotFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		respond(w, http.StatusOK, s.withRelated(object, r.URL.Query().Get("include_related")), nil)
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		fields, ok := body.(map[string]any)
+		if !ok {
+			respondError(w, http.StatusBadRequest, "the object must be a JSON object")
+			return
+		}
+		delete(fields, "id")
+		if r.Method == http.MethodPatch {
+			merge(object, fields)
+		} else {
+			replace(route.kind, object, fields, route.parents)
+		}
+		s.setComputed(route.kind, object, fields)
+		object["updated_at"] = timestamp()
+		if jsonInt(object["state"]) == stateDeleted {
+			delete(s.objects[route.kind], jsonInt(object["id"]))
+		}
+		respond(w, http.StatusOK, object, nil)
+	case http.MethodDelete:
+		delete(s.objects[route.kind], jsonInt(object["id"]))
+		object["state"] = int64(stateDeleted)
+		respond(w, http.StatusOK, object, nil)
+	default:
+		respondError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s is not allowed on an object", r.Method))
+	}
+}
+
+func (s *Server) handleCreate(w http.ResponseWriter, route route, body any) {
+	switch typedBody := body.(type) {
+	case map[string]any:
+		if name, ok := typedBody["name"].(string); ok && route.uniqueNames() && s.nameTaken(route.kind, name) {
+			respondError(w, http.StatusConflict, fmt.Sprintf("an object named %s already exists", name))
+			return
+		}
+		id := s.create(route.kind, typedBody, route.parents)
+		respond(w, http.StatusCreated, s.objects[route.kind][id], nil)
+	case []any:
+		// a list replaces all the nested objects of the parent, e.g. the permissions of a service token
+		for _, existing := range s.list(route.kind, route.parentFilters()) {
+			delete(s.objects[route.kind], jsonInt(existing["id"]))
+		}
+		created := []any{}
+		for _, item := range typedBody {
+			if fields, ok := item.(map[string]any); ok {
+				created = append(created, s.objects[route.kind][s.create(route.kind, fields, route.parents)])
+			}
+		}
+		respond(w, http.StatusCreated, created, nil)
+	default:
+		respondError(w, http.StatusBadRequest, "the body must be a JSON object or list")
+	}
+}
+
+func (s *Server) handleList(w http.ResponseWriter, r *http.Request, route route) {
+	query := r.URL.Query()
+
+	filters := route.parentFilters()
+	for key, values := range query {
+		switch key {
+		case "offset", "limit", "include_related", "order_by", "search":
+			continue
+		}
+		filters[key] = values[0]
+	}
+
+	objects := s.list(route.kind, filters)
+
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultLimit
+	}
+	page := objects[min(offset, len(objects)):min(offset+limit, len(objects))]
+
+	data := []any{}
+	for _, object := range page {
+		data = append(data, s.withRelated(object, query.Get("include_related")))
+	}
+
+	respond(w, http.StatusOK, data, map[string]any{
+		"filters": map[string]any{
+			"limit":  limit,
+			"offset": offset,
+		},
+		"pagination": map[string]any{
+			"count":       len(page),
+			"total_count": len(objects),
+		},
+	})
+}
+
+func respond(w http.ResponseWriter, statusCode int, data any, extra map[string]any) {
+	payload := map[string]any{
+		"status": map[string]any{
+			"code":       statusCode,
+			"is_success": true,
+		},
+		"data": data,
+	}
+	if extra != nil {
+		payload["extra"] = extra
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+func respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"status": map[string]any{
+			"code":              statusCode,
+			"is_success":        false,
+			"user_message":      message,
+			"developer_message": "",
+		},
+		"data": nil,
+	})
+}
+
+// the client only treats a 404 as a missing resource when the body has the 404 status code
+func respondNotFound(w http.ResponseWriter) {
+	respondError(w, http.StatusNotFound, "The requested resource was not found.")
+}
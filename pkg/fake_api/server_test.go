@@ -0,0 +1,413 @@
+package fake_api_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/fake_api"
+)
+
+func newClient(t *testing.T) (*fake_api.Server, *dbt_cloud.Client) {
+	server := fake_api.NewServer(100, "token")
+	t.Cleanup(server.Close)
+
+	return server, &dbt_cloud.Client{
+		HTTPClient: &http.Client{Timeout: 5 * time.Second},
+		HostURL:    server.HostURL(),
+		Token:      server.Token,
+		AccountID:  server.AccountID,
+	}
+}
+
+func TestProjectLifecycle(t *testing.T) {
+	t.Parallel()
+
+	_, client := newClient(t)
+
+	project, err := client.CreateProject("analytics", "description", "")
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	projectID := strconv.Itoa(*project.ID)
+
+	project.Description = "new description"
+	if _, err := client.UpdateProject(projectID, *project); err != nil {
+		t.Fatalf("update: %s", err)
+	}
+
+	readProject, err := client.GetProject(projectID)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if readProject.Description != "new description" || readProject.AccountID != 100 {
+		t.Errorf("unexpected project %+v", readProject)
+	}
+
+	_, err = client.CreateProject("analytics", "", "")
+	if err == nil || !strings.Contains(err.Error(), "status: 409") {
+		t.Errorf("expected a conflict for a duplicate name, got %v", err)
+	}
+
+	// deleting a project updates its state, it is then not found anymore
+	readProject.State = dbt_cloud.STATE_DELETED
+	if _, err := client.UpdateProject(projectID, *readProject); err != nil {
+		t.Fatalf("delete: %s", err)
+	}
+	_, err = client.GetProject(projectID)
+	if err == nil || !strings.Contains(err.Error(), "resource-not-found") {
+		t.Errorf("expected resource-not-found after delete, got %v", err)
+	}
+}
+
+func TestNestedObjects(t *testing.T) {
+	t.Parallel()
+
+	_, client := newClient(t)
+
+	projects := []*dbt_cloud.Project{}
+	for _, name := range []string{"first", "second"} {
+		project, err := client.CreateProject(name, "", "")
+		if err != nil {
+			t.Fatalf("create project: %s", err)
+		}
+		projects = append(projects, project)
+
+		_, err = client.CreateEnvironment(true, *project.ID, "prod", "versionless", "deployment", false, "", 0, "", 0, 0, false)
+		if err != nil {
+			t.Fatalf("create environment: %s", err)
+		}
+	}
+
+	environments, err := client.GetAllEnvironments(*projects[1].ID)
+	if err != nil {
+		t.Fatalf("list environments: %s", err)
+	}
+	if len(environments) != 1 || environments[0].Project_Id != *projects[1].ID {
+		t.Errorf("expected the environment of the second project, got %+v", environments)
+	}
+
+	allEnvironments, err := client.GetAllEnvironments(0)
+	if err != nil {
+		t.Fatalf("list all environments: %s", err)
+	}
+	if len(allEnvironments) != 2 {
+		t.Errorf("expected 2 environments, got %d", len(allEnvironments))
+	}
+
+	_, err = client.CreateEnvironment(true, 999, "prod", "versionless", "deployment", false, "", 0, "", 0, 0, false)
+	if err == nil {
+		t.Errorf("expected an error creating an environment in a missing project")
+	}
+}
+
+func TestPermissionsAreReplaced(t *testing.T) {
+	t.Parallel()
+
+	_, client := newClient(t)
+
+	token, err := client.CreateServiceToken("token", dbt_cloud.STATE_ACTIVE)
+	if err != nil {
+		t.Fatalf("create token: %s", err)
+	}
+	if token.TokenString == nil || *token.TokenString == "" {
+		t.Errorf("expected the token string to be returned on create")
+	}
+
+	for _, permissionSets := range [][]string{{"admin", "git_admin"}, {"job_admin"}} {
+		permissions := []dbt_cloud.ServiceTokenPermission{}
+		for _, permissionSet := range permissionSets {
+			permissions = append(permissions, dbt_cloud.ServiceTokenPermission{
+				AllProjects: true,
+				Set:         permissionSet,
+			})
+		}
+		if _, err := client.UpdateServiceTokenPermissions(*token.ID, permissions); err != nil {
+			t.Fatalf("update permissions: %s", err)
+		}
+	}
+
+	readToken, err := client.GetServiceToken(*token.ID)
+	if err != nil {
+		t.Fatalf("read token: %s", err)
+	}
+	if len(readToken.Permissions) != 1 || readToken.Permissions[0].Set != "job_admin" ||
+		readToken.Permissions[0].ServiceTokenID != *token.ID {
+		t.Errorf("expected only the last permissions, got %+v", readToken.Permissions)
+	}
+
+	if _, err := client.DeleteServiceToken(*token.ID); err != nil {
+		t.Fatalf("delete token: %s", err)
+	}
+	_, err = client.GetServiceToken(*token.ID)
+	if err == nil || !strings.Contains(err.Error(), "resource-not-found") {
+		t.Errorf("expected resource-not-found after delete, got %v", err)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	t.Parallel()
+
+	server, client := newClient(t)
+
+	for i := 0; i < 250; i++ {
+		name := "other"
+		if i%2 == 0 {
+			name = "engineers"
+		}
+		server.Seed("groups", map[string]any{"name": name})
+	}
+
+	groupIDs := client.GetAllGroupIDsByName("engineers")
+	if len(groupIDs) != 125 {
+		t.Errorf("expected 125 groups, got %d", len(groupIDs))
+	}
+
+	listRequests := 0
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodGet && strings.HasSuffix(request.Path, "/groups/") {
+			listRequests++
+		}
+	}
+	if listRequests != 3 {
+		t.Errorf("expected 3 pages, got %d", listRequests)
+	}
+}
+
+func TestGitHubRepositoriesPagination(t *testing.T) {
+	t.Parallel()
+
+	server, client := newClient(t)
+
+	installationID := server.Seed("github-installations", map[string]any{
+		"account": map[string]any{"id": 1, "login": "dbt-labs", "type": "Organization"},
+	})
+	// more than the maximum page size of GitHub
+	for i := 0; i < 105; i++ {
+		server.Seed("github-repositories", map[string]any{
+			"installation_id": installationID,
+			"full_name":       fmt.Sprintf("dbt-labs/repository-%d", i),
+		})
+	}
+
+	repositories, err := client.GetGitHubInstallationRepositories(installationID)
+	if err != nil {
+		t.Fatalf("list repositories: %s", err)
+	}
+	if len(repositories) != 105 || repositories[104].FullName != "dbt-labs/repository-104" {
+		t.Errorf("expected the 105 repositories, got %d", len(repositories))
+	}
+
+	pageRequests := 0
+	for _, request := range server.Requests() {
+		if strings.HasSuffix(request.Path, "/repositories/") {
+			pageRequests++
+		}
+	}
+	if pageRequests != 2 {
+		t.Errorf("expected 2 pages, got %d", pageRequests)
+	}
+}
+
+func TestRepositoryUpdateUnsetsGitHubInstallation(t *testing.T) {
+	t.Parallel()
+
+	server, client := newClient(t)
+
+	project, err := client.CreateProject("analytics", "", "")
+	if err != nil {
+		t.Fatalf("create project: %s", err)
+	}
+	repository, err := client.CreateRepository(*project.ID, "git://github.com/dbt-labs/jaffle_shop.git", true, "github_app", 0, 123, "", "", false, "")
+	if err != nil {
+		t.Fatalf("create repository: %s", err)
+	}
+
+	// omitempty would not send the nil ID, the update needs to send null for the API to remove it
+	repository.GitCloneStrategy = "deploy_key"
+	repository.GithubInstallationID = nil
+	repositoryID := strconv.Itoa(*repository.ID)
+	if _, err := client.UpdateRepository(repositoryID, strconv.Itoa(*project.ID), *repository); err != nil {
+		t.Fatalf("update repository: %s", err)
+	}
+
+	requests := server.Requests()
+	update := requests[len(requests)-1]
+	fields, _ := update.Body.(map[string]any)
+	if githubInstallationID, ok := fields["github_installation_id"]; !ok || githubInstallationID != nil {
+		t.Errorf("expected github_installation_id to be sent as null, got %+v", update.Body)
+	}
+}
+
+func TestRelatedObjects(t *testing.T) {
+	t.Parallel()
+
+	server, client := newClient(t)
+
+	project, err := client.CreateProject("analytics", "", "")
+	if err != nil {
+		t.Fatalf("create project: %s", err)
+	}
+	environment, err := client.CreateEnvironment(true, *project.ID, "prod", "versionless", "deployment", false, "", 0, "", 0, 0, false)
+	if err != nil {
+		t.Fatalf("create environment: %s", err)
+	}
+	server.Seed("jobs", map[string]any{
+		"name":           "daily",
+		"project_id":     *project.ID,
+		"environment_id": *environment.ID,
+	})
+
+	jobs, err := client.GetAllJobs(*project.ID, 0)
+	if err != nil {
+		t.Fatalf("list jobs: %s", err)
+	}
+	if len(jobs) != 1 || jobs[0].Environment.Name != "prod" {
+		t.Errorf("expected the job with its environment, got %+v", jobs)
+	}
+}
+
+func TestWebhookUpdates(t *testing.T) {
+	t.Parallel()
+
+	_, client := newClient(t)
+
+	webhook, err := client.CreateWebhook("", "alerts", "description", "https://example.com", []string{"job.run.completed"}, []int{1}, true)
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	if !strings.HasPrefix(webhook.WebhookId, "wsu_") || len(webhook.JobIds) != 1 || webhook.JobIds[0] != "1" {
+		t.Errorf("expected a string ID and string job IDs, got %+v", webhook)
+	}
+
+	// the webhooks are updated with PUT, the fields not sent are unset and the secret is kept
+	_, err = client.UpdateWebhook(webhook.WebhookId, dbt_cloud.WebhookWrite{
+		Name:       "alerts",
+		ClientUrl:  "https://example.com",
+		EventTypes: []string{"job.run.completed"},
+		JobIds:     []int{},
+	})
+	if err != nil {
+		t.Fatalf("update: %s", err)
+	}
+
+	readWebhook, err := client.GetWebhook(webhook.WebhookId)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if readWebhook.Description != "" || readWebhook.Active || len(readWebhook.JobIds) != 0 {
+		t.Errorf("expected the fields not sent to be unset, got %+v", readWebhook)
+	}
+	if readWebhook.HmacSecret == nil || *readWebhook.HmacSecret != *webhook.HmacSecret {
+		t.Errorf("expected the secret to be kept, got %v", readWebhook.HmacSecret)
+	}
+}
+
+func TestFaults(t *testing.T) {
+	t.Parallel()
+
+	server, client := newClient(t)
+
+	project, err := client.CreateProject("analytics", "", "")
+	if err != nil {
+		t.Fatalf("create project: %s", err)
+	}
+	projectID := strconv.Itoa(*project.ID)
+
+	server.InjectFault(fake_api.Fault{
+		Method:     http.MethodGet,
+		Path:       regexp.MustCompile(`/projects/\d+/$`),
+		StatusCode: http.StatusTooManyRequests,
+		Times:      2,
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetProject(projectID)
+		if err == nil || !strings.Contains(err.Error(), "status: 429") {
+			t.Errorf("request %d: expected a 429, got %v", i, err)
+		}
+	}
+	if _, err := client.GetProject(projectID); err != nil {
+		t.Errorf("expected the fault to be removed after 2 requests, got %s", err)
+	}
+
+	server.InjectFault(fake_api.Fault{
+		Path:       regexp.MustCompile(`/projects/`),
+		StatusCode: http.StatusNotFound,
+	})
+	_, err = client.GetProject(projectID)
+	if err == nil || !strings.Contains(err.Error(), "resource-not-found") {
+		t.Errorf("expected resource-not-found, got %v", err)
+	}
+
+	server.ClearFaults()
+	if _, err := client.GetProject(projectID); err != nil {
+		t.Errorf("expected no fault after clearing them, got %s", err)
+	}
+}
+
+func TestParseFaults(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		faults   string
+		expected string
+		isError  bool
+	}{
+		{
+			faults:   "",
+			expected: "",
+		},
+		{
+			faults:   "GET /projects/ 429x2; /jobs/ 503",
+			expected: "GET /projects/ 429 2;  /jobs/ 503 0",
+		},
+		{
+			faults:   "post /connections/\\d+/$ 409x1",
+			expected: "POST /connections/\\d+/$ 409 1",
+		},
+		{
+			faults:  "GET /projects/",
+			isError: true,
+		},
+		{
+			faults:  "GET /projects/ 200",
+			isError: true,
+		},
+		{
+			faults:  "GET /projects/ 500x0",
+			isError: true,
+		},
+		{
+			faults:  "GET /projects/( 500",
+			isError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		faults, err := fake_api.ParseFaults(testCase.faults)
+		if testCase.isError {
+			if err == nil {
+				t.Errorf("%q: expected an error", testCase.faults)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %s", testCase.faults, err)
+			continue
+		}
+
+		parsed := []string{}
+		for _, fault := range faults {
+			parsed = append(parsed, fmt.Sprintf("%s %s %d %d", fault.Method, fault.Path, fault.StatusCode, fault.Times))
+		}
+		if strings.Join(parsed, "; ") != testCase.expected {
+			t.Errorf("%q: expected %q, got %q", testCase.faults, testCase.expected, parsed)
+		}
+	}
+}
@@ -0,0 +1,357 @@
+package fake_api
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the kinds where the real API refuses two active objects with the same name
+var uniqueNameKinds = map[string]bool{
+	"projects": true,
+	"groups":   true,
+}
+
+// some endpoints use the singular name of the collection for the objects
+var kindAliases = map[string]string{
+	"webhooks/subscription": "webhooks/subscriptions",
+}
+
+// some objects have a string ID made of a prefix and a number, e.g. wsu_12 for the webhooks
+var idPrefixes = map[string]string{
+	"webhooks/subscriptions":      "wsu_",
+	"integrations/slack/channels": "C",
+}
+
+// the fields always returned by the real API, even when they are not set
+var kindDefaults = map[string]map[string]any{
+	"credentials": {
+		"target_name": "default",
+	},
+	"connections": {
+		"is_ssh_tunnel_enabled":    false,
+		"private_link_endpoint_id": nil,
+		"oauth_configuration_id":   nil,
+	},
+}
+
+type parent struct {
+	kind string
+	id   string
+}
+
+type route struct {
+	kind    string
+	id      string
+	parents []parent
+}
+
+// parseRoute splits the path after /accounts/<id>/ into the kind and ID of the object and its parents
+// e.g. projects/1/environments/2 is the environment 2 with the parent project 1
+func parseRoute(segments []string) route {
+	r := route{}
+	for i := 0; i < len(segments); i++ {
+		kind := segments[i]
+		// a segment following a collection without ID is part of the kind, e.g. webhooks/subscriptions
+		if r.kind != "" && r.id == "" {
+			kind = r.kind + "/" + kind
+			r.kind = ""
+		}
+		if alias, ok := kindAliases[kind]; ok {
+			kind = alias
+		}
+
+		id := ""
+		if i+1 < len(segments) {
+			if _, err := strconv.ParseInt(strings.TrimPrefix(segments[i+1], idPrefixes[kind]), 10, 64); err == nil {
+				id = segments[i+1]
+				i++
+			}
+		}
+
+		if r.kind != "" {
+			r.parents = append(r.parents, parent{kind: r.kind, id: r.id})
+		}
+		r.kind = kind
+		r.id = id
+	}
+	return r
+}
+
+func (r route) parentFilters() map[string]string {
+	filters := map[string]string{}
+	for _, parent := range r.parents {
+		filters[parentField(parent.kind)] = parent.id
+	}
+	return filters
+}
+
+func (r route) uniqueNames() bool {
+	return uniqueNameKinds[r.kind]
+}
+
+// parentField is the field used to store the ID of a parent, e.g. project_id or service_token_id
+func parentField(kind string) string {
+	return strings.ReplaceAll(strings.TrimSuffix(kind, "s"), "-", "_") + "_id"
+}
+
+func (s *Server) create(kind string, fields map[string]any, parents []parent) int64 {
+	s.lastID++
+	now := timestamp()
+
+	fields["id"] = s.lastID
+	fields["account_id"] = int64(s.AccountID)
+	if _, ok := fields["state"]; !ok {
+		fields["state"] = int64(stateActive)
+	}
+	for _, parent := range parents {
+		parentID, _ := strconv.ParseInt(parent.id, 10, 64)
+		fields[parentField(parent.kind)] = parentID
+	}
+	// seeded objects can be created in the past, e.g. to test the sweepers
+	if fields["created_at"] == nil {
+		fields["created_at"] = now
+	}
+	fields["updated_at"] = now
+	for key, value := range kindDefaults[kind] {
+		if _, ok := fields[key]; !ok {
+			fields[key] = value
+		}
+	}
+
+	if prefix, ok := idPrefixes[kind]; ok {
+		fields["id"] = fmt.Sprintf("%s%d", prefix, s.lastID)
+	}
+
+	switch kind {
+	case "service-tokens":
+		fields["uid"] = fmt.Sprintf("uid%d", s.lastID)
+		fields["token_string"] = fmt.Sprintf("dbtc_fake%d", s.lastID)
+	case "webhooks/subscriptions":
+		fields["hmac_secret"] = fmt.Sprintf("secret%d", s.lastID)
+		fields["account_identifier"] = fmt.Sprintf("act_%d", s.AccountID)
+	case "encryptions", "deploy-keys":
+		fields["public_key"] = fmt.Sprintf("ssh-rsa AAAAfake%d dbt-cloud", s.lastID)
+	}
+
+	id := s.lastID
+	if s.objects[kind] == nil {
+		s.objects[kind] = map[int64]map[string]any{}
+	}
+	s.objects[kind][id] = fields
+	s.setComputed(kind, fields, fields)
+	return id
+}
+
+// setComputed sets the fields derived from other objects after an object is created or updated with fields
+func (s *Server) setComputed(kind string, object map[string]any, fields map[string]any) {
+	switch kind {
+	case "webhooks/subscriptions":
+		// the job IDs are sent as numbers and returned as strings
+		if jobIDs, ok := object["job_ids"].([]any); ok {
+			for i, jobID := range jobIDs {
+				jobIDs[i] = fmt.Sprint(jobID)
+			}
+		}
+	case "connections":
+		// the legacy connections have their settings in details and the global connections in config, the same
+		// connection can be read with both endpoints
+		if details, ok := fields["details"].(map[string]any); ok {
+			object["config"] = details
+		} else if config, ok := fields["config"].(map[string]any); ok {
+			object["details"] = config
+		}
+		// the adapter version of the legacy connections is derived from their type, e.g. snowflake_v0
+		if connectionType, ok := object["type"].(string); ok && connectionType != "adapter" &&
+			object["adapter_version"] == nil {
+			object["adapter_version"] = connectionType + "_v0"
+		}
+		// the OAuth of the BigQuery connections is configured when both the application ID and secret are set
+		if details, ok := object["details"].(map[string]any); ok && object["type"] == "bigquery" {
+			details["is_configured_for_oauth"] = details["application_id"] != nil && details["application_id"] != "" &&
+				details["application_secret"] != nil && details["application_secret"] != ""
+		}
+	case "credentials":
+		// the adapter credentials return the values of their fields which are not encrypted
+		details, _ := object["credential_details"].(map[string]any)
+		if credentialFields, ok := details["fields"].(map[string]any); ok {
+			unencrypted := map[string]any{"threads": object["threads"]}
+			for name, field := range credentialFields {
+				field, _ := field.(map[string]any)
+				metadata, _ := field["metadata"].(map[string]any)
+				if metadata["encrypt"] != true {
+					unencrypted[name] = field["value"]
+				}
+			}
+			object["unencrypted_credential_details"] = unencrypted
+		}
+	case "repositories":
+		// a deploy key is generated for the repositories cloned with SSH when none is linked
+		if object["git_clone_strategy"] == "deploy_key" && object["deploy_key_id"] == nil {
+			object["deploy_key_id"] = s.create("deploy-keys", map[string]any{}, nil)
+		}
+		object["deploy_key"] = nil
+		if object["deploy_key_id"] != nil {
+			object["deploy_key"] = s.get("deploy-keys", fmt.Sprint(object["deploy_key_id"]))
+		}
+	}
+}
+
+func (s *Server) get(kind string, id string) map[string]any {
+	intID, err := strconv.ParseInt(strings.TrimPrefix(id, idPrefixes[kind]), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return s.objects[kind][intID]
+}
+
+// list returns the objects of a kind matching all the filters, ordered by ID
+func (s *Server) list(kind string, filters map[string]string) []map[string]any {
+	objects := []map[string]any{}
+	for _, object := range s.objects[kind] {
+		matches := true
+		for key, value := range filters {
+			if fieldValue, ok := object[key]; ok && fmt.Sprint(fieldValue) != value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			objects = append(objects, object)
+		}
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return jsonInt(objects[i]["id"]) < jsonInt(objects[j]["id"])
+	})
+	return objects
+}
+
+func (s *Server) nameTaken(kind string, name string) bool {
+	for _, object := range s.objects[kind] {
+		if object["name"] == name {
+			return true
+		}
+	}
+	return false
+}
+
+// withRelated returns a copy of the object with the related objects requested with include_related=[a,b]
+// embedded, e.g. the environment of a job is returned in `environment` for `environment_id`
+func (s *Server) withRelated(object map[string]any, includeRelated string) map[string]any {
+	result := map[string]any{}
+	for key, value := range object {
+		result[key] = value
+	}
+
+	for _, related := range strings.Split(strings.Trim(includeRelated, "[]"), ",") {
+		relatedID, ok := object[related+"_id"]
+		if related == "" || !ok {
+			continue
+		}
+		if relatedObject := s.get(related+"s", fmt.Sprint(relatedID)); relatedObject != nil {
+			result[related] = relatedObject
+		}
+	}
+	return result
+}
+
+// normalize converts the JSON numbers decoded from requests to int64 or float64
+func normalize(value any) any {
+	switch typedValue := value.(type) {
+	case json.Number:
+		if intValue, err := typedValue.Int64(); err == nil {
+			return intValue
+		}
+		floatValue, _ := typedValue.Float64()
+		return floatValue
+	case int:
+		return int64(typedValue)
+	case map[string]any:
+		for key, item := range typedValue {
+			typedValue[key] = normalize(item)
+		}
+		return typedValue
+	case []any:
+		for i, item := range typedValue {
+			typedValue[i] = normalize(item)
+		}
+		return typedValue
+	default:
+		return value
+	}
+}
+
+func jsonInt(value any) int64 {
+	switch typedValue := value.(type) {
+	case int64:
+		return typedValue
+	case float64:
+		return int64(typedValue)
+	case string:
+		// the string IDs end with the number of the object
+		intValue, _ := strconv.ParseInt(strings.TrimLeftFunc(typedValue, func(r rune) bool {
+			return r < '0' || r > '9'
+		}), 10, 64)
+		return intValue
+	default:
+		return 0
+	}
+}
+
+func timestamp() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// the fields set by the API, kept when an object is replaced
+var serverFields = map[string]bool{
+	"id":           true,
+	"account_id":   true,
+	"created_at":   true,
+	"uid":          true,
+	"token_string": true,
+	"hmac_secret":  true,
+	"public_key":   true,
+
+	"account_identifier": true,
+}
+
+// replace sets the fields of the object like the real API does for POST and PUT, the fields not sent are unset
+func replace(kind string, object map[string]any, fields map[string]any, parents []parent) {
+	kept := map[string]bool{"state": fields["state"] == nil}
+	for _, parent := range parents {
+		kept[parentField(parent.kind)] = true
+	}
+	for key := range object {
+		if !serverFields[key] && !kept[key] {
+			delete(object, key)
+		}
+	}
+	for key, value := range kindDefaults[kind] {
+		object[key] = value
+	}
+	for key, value := range fields {
+		object[key] = value
+	}
+}
+
+// the fields holding a JSON document, replaced as a whole when updated
+var jsonFields = map[string]bool{
+	"extended_attributes": true,
+}
+
+// merge sets the fields in the object, the nested objects are merged like the real API does for PATCH
+func merge(object map[string]any, fields map[string]any) {
+	for key, value := range fields {
+		nestedFields, isMap := value.(map[string]any)
+		nestedObject, wasMap := object[key].(map[string]any)
+		if isMap && wasMap && !jsonFields[key] {
+			merge(nestedObject, nestedFields)
+			continue
+		}
+		object[key] = value
+	}
+}
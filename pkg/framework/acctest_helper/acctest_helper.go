@@ -6,10 +6,13 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/cassette"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/fake_api"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/provider"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -17,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 )
 
 func SharedClient() (*dbt_cloud.Client, error) {
@@ -30,8 +34,13 @@ func SharedClient() (*dbt_cloud.Client, error) {
 		hostURL = "https://cloud.getdbt.com/api"
 	}
 
+	httpClient, err := cassette.HTTPClient(30 * time.Second)
+	if err != nil {
+		return nil, err
+	}
+
 	client := dbt_cloud.Client{
-		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+		HTTPClient: httpClient,
 		HostURL:    hostURL,
 		Token:      token,
 		AccountID:  accountID,
@@ -42,13 +51,30 @@ func SharedClient() (*dbt_cloud.Client, error) {
 
 const (
 	DBT_CLOUD_VERSION = "versionless"
+	// TestNamePrefix starts the names of the objects created by the acceptance tests, the sweepers only delete those
+	TestNamePrefix = "tf_acc_"
 )
 
+// RandomName returns a random name starting with TestNamePrefix for the objects created by the acceptance tests
+func RandomName() string {
+	return TestNamePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+}
+
 var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"dbtcloud": func() (tfprotov6.ProviderServer, error) {
+		// the requests are recorded or replayed when running the acceptance tests with cassettes
+		var transport http.RoundTripper
+		recorder, err := cassette.FromEnv()
+		if err != nil {
+			return nil, err
+		}
+		if recorder != nil {
+			transport = recorder
+		}
+
 		upgradedSdkProvider, err := tf5to6server.UpgradeServer(
 			context.Background(),
-			provider.SDKProvider("test")().GRPCProvider,
+			provider.SDKProviderWithTransport("test", transport)().GRPCProvider,
 		)
 		if err != nil {
 			log.Fatal(err)
@@ -57,14 +83,67 @@ var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 			func() tfprotov6.ProviderServer {
 				return upgradedSdkProvider
 			},
-			providerserver.NewProtocol6(provider.New()),
+			providerserver.NewProtocol6(provider.NewWithTransport(transport)),
 		}
 
 		return tf6muxserver.NewMuxServer(context.Background(), providers...)
 	},
 }
 
+var (
+	fakeAPI     *fake_api.Server
+	fakeAPIErr  error
+	fakeAPIOnce sync.Once
+)
+
+// FakeAPI returns the fake dbt Cloud API shared by the acceptance tests when DBT_CLOUD_FAKE_API is set, nil otherwise
+//
+// The fake API is started on the first call and the DBT_CLOUD_* environment variables are set to point the
+// provider and SharedClient to it. Faults can be injected for all the tests with DBT_CLOUD_FAKE_API_FAULTS,
+// e.g. `GET /projects/ 429x2; POST /jobs/ 503x1`
+func FakeAPI() (*fake_api.Server, error) {
+	if os.Getenv("DBT_CLOUD_FAKE_API") == "" {
+		return nil, nil
+	}
+
+	fakeAPIOnce.Do(func() {
+		faults, err := fake_api.ParseFaults(os.Getenv("DBT_CLOUD_FAKE_API_FAULTS"))
+		if err != nil {
+			fakeAPIErr = err
+			return
+		}
+
+		fakeAPI = fake_api.NewServer(100, "fake-token")
+		for _, fault := range faults {
+			fakeAPI.InjectFault(fault)
+		}
+
+		os.Setenv("DBT_CLOUD_HOST_URL", fakeAPI.HostURL())
+		os.Setenv("DBT_CLOUD_ACCOUNT_ID", strconv.Itoa(fakeAPI.AccountID))
+		os.Setenv("DBT_CLOUD_TOKEN", fakeAPI.Token)
+	})
+
+	return fakeAPI, fakeAPIErr
+}
+
 func TestAccPreCheck(t *testing.T) {
+	if _, err := FakeAPI(); err != nil {
+		t.Fatalf("Error starting the fake dbt Cloud API: %s", err)
+	}
+
+	recorder, err := cassette.FromEnv()
+	if err != nil {
+		t.Fatalf("Error loading the cassette: %s", err)
+	}
+	// replaying a cassette doesn't require an account, the account and the token are the ones used when recording
+	if recorder != nil && recorder.Mode() != cassette.ModeRecord {
+		if os.Getenv("DBT_CLOUD_ACCOUNT_ID") == "" {
+			os.Setenv("DBT_CLOUD_ACCOUNT_ID", strconv.Itoa(recorder.AccountID()))
+		}
+		if os.Getenv("DBT_CLOUD_TOKEN") == "" {
+			os.Setenv("DBT_CLOUD_TOKEN", "replay")
+		}
+	}
 	if v := os.Getenv("DBT_CLOUD_ACCOUNT_ID"); v == "" {
 		t.Fatal("DBT_CLOUD_ACCOUNT_ID must be set for acceptance tests")
 	}
@@ -77,6 +156,10 @@ func IsDbtCloudPR() bool {
 	return os.Getenv("DBT_CLOUD_ACCOUNT_ID") == "1"
 }
 
+func IsFakeAPI() bool {
+	return os.Getenv("DBT_CLOUD_FAKE_API") != ""
+}
+
 func HelperTestResourceSchema[R resource.Resource](t *testing.T, r R) {
 	ctx := context.Background()
 
@@ -0,0 +1,84 @@
+package account
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &accountDataSource{}
+	_ datasource.DataSourceWithConfigure = &accountDataSource{}
+)
+
+func AccountDataSource() datasource.DataSource {
+	return &accountDataSource{}
+}
+
+type accountDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *accountDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *accountDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	account, err := d.client.GetAccount()
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the account", err.Error())
+		return
+	}
+
+	state := AccountDataSourceModel{
+		ID:                             types.Int64Value(int64(account.Id)),
+		Name:                           types.StringValue(account.Name),
+		State:                          types.Int64Value(int64(account.State)),
+		Plan:                           types.StringValue(account.Plan),
+		PendingCancel:                  types.BoolValue(account.PendingCancel),
+		Locked:                         types.BoolValue(account.Locked),
+		LockReason:                     types.StringValue(account.LockReason),
+		RunSlots:                       types.Int64Value(int64(account.RunSlots)),
+		DeveloperSeats:                 types.Int64Value(int64(account.DeveloperSeats)),
+		ReadOnlySeats:                  types.Int64Value(int64(account.ReadOnlySeats)),
+		QueueLimit:                     types.Int64Value(int64(account.QueueLimit)),
+		RunDurationLimitSeconds:        types.Int64Value(int64(account.RunDurationLimitSeconds)),
+		PodMemoryRequestMebibytes:      types.Int64Value(int64(account.PodMemoryRequestMebibytes)),
+		GitAuthLevel:                   types.StringValue(account.GitAuthLevel),
+		DevelopFileSystem:              types.BoolValue(account.DevelopFileSystem),
+		DocsJobID:                      helper.SetIntToInt64OrNull(account.DocsJobId),
+		FreshnessJobID:                 helper.SetIntToInt64OrNull(account.FreshnessJobId),
+		CreatedAt:                      types.StringValue(account.CreatedAt),
+		UpdatedAt:                      types.StringValue(account.UpdatedAt),
+		EnterpriseAuthenticationMethod: types.StringValue(account.EnterpriseAuthenticationMethod),
+		EnterpriseLoginSlug:            types.StringValue(account.EnterpriseLoginSlug),
+		EnterpriseUniqueIdentifier:     types.StringValue(account.EnterpriseUniqueIdentifier),
+		EnterpriseLoginURL:             types.StringValue(account.EnterpriseLoginUrl),
+		SSOReauth:                      types.BoolValue(account.SsoReauth),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *accountDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,38 @@
+package account_test
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudAccountDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "dbtcloud_account" "test" {
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dbtcloud_account.test", "id"),
+					resource.TestCheckResourceAttrSet("data.dbtcloud_account.test", "name"),
+					resource.TestCheckResourceAttrSet(
+						"data.dbtcloud_account.test",
+						"enterprise_authentication_method",
+					),
+					resource.TestCheckResourceAttrSet("data.dbtcloud_account.test", "plan"),
+					resource.TestCheckResourceAttrSet("data.dbtcloud_account.test", "run_slots"),
+					resource.TestCheckResourceAttrSet(
+						"data.dbtcloud_account.test",
+						"run_duration_limit_seconds",
+					),
+				),
+			},
+		},
+	})
+}
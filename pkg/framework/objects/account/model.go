@@ -0,0 +1,41 @@
+package account
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AccountDataSourceModel struct {
+	ID                             types.Int64  `tfsdk:"id"`
+	Name                           types.String `tfsdk:"name"`
+	State                          types.Int64  `tfsdk:"state"`
+	Plan                           types.String `tfsdk:"plan"`
+	PendingCancel                  types.Bool   `tfsdk:"pending_cancel"`
+	Locked                         types.Bool   `tfsdk:"locked"`
+	LockReason                     types.String `tfsdk:"lock_reason"`
+	RunSlots                       types.Int64  `tfsdk:"run_slots"`
+	DeveloperSeats                 types.Int64  `tfsdk:"developer_seats"`
+	ReadOnlySeats                  types.Int64  `tfsdk:"read_only_seats"`
+	QueueLimit                     types.Int64  `tfsdk:"queue_limit"`
+	RunDurationLimitSeconds        types.Int64  `tfsdk:"run_duration_limit_seconds"`
+	PodMemoryRequestMebibytes      types.Int64  `tfsdk:"pod_memory_request_mebibytes"`
+	GitAuthLevel                   types.String `tfsdk:"git_auth_level"`
+	DevelopFileSystem              types.Bool   `tfsdk:"develop_file_system"`
+	DocsJobID                      types.Int64  `tfsdk:"docs_job_id"`
+	FreshnessJobID                 types.Int64  `tfsdk:"freshness_job_id"`
+	CreatedAt                      types.String `tfsdk:"created_at"`
+	UpdatedAt                      types.String `tfsdk:"updated_at"`
+	EnterpriseAuthenticationMethod types.String `tfsdk:"enterprise_authentication_method"`
+	EnterpriseLoginSlug            types.String `tfsdk:"enterprise_login_slug"`
+	EnterpriseUniqueIdentifier     types.String `tfsdk:"enterprise_unique_identifier"`
+	EnterpriseLoginURL             types.String `tfsdk:"enterprise_login_url"`
+	SSOReauth                      types.Bool   `tfsdk:"sso_reauth"`
+}
+
+type AccountSettingsResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	RunDurationLimitSeconds types.Int64  `tfsdk:"run_duration_limit_seconds"`
+	GitAuthLevel            types.String `tfsdk:"git_auth_level"`
+	DocsJobID               types.Int64  `tfsdk:"docs_job_id"`
+	FreshnessJobID          types.Int64  `tfsdk:"freshness_job_id"`
+}
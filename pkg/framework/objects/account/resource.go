@@ -0,0 +1,198 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &accountSettingsResource{}
+	_ resource.ResourceWithConfigure   = &accountSettingsResource{}
+	_ resource.ResourceWithImportState = &accountSettingsResource{}
+)
+
+func AccountSettingsResource() resource.Resource {
+	return &accountSettingsResource{}
+}
+
+type accountSettingsResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *accountSettingsResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_account_settings"
+}
+
+// setSettingsFromAPI sets the settings of the account returned by dbt Cloud
+func setSettingsFromAPI(state *AccountSettingsResourceModel, account *dbt_cloud.AuthResponseData) {
+	state.ID = types.StringValue(fmt.Sprintf("%d", account.Id))
+	state.Name = types.StringValue(account.Name)
+	state.RunDurationLimitSeconds = types.Int64Value(int64(account.RunDurationLimitSeconds))
+	state.GitAuthLevel = types.StringValue(account.GitAuthLevel)
+	state.DocsJobID = jobIDFromAPI(state.DocsJobID, account.DocsJobId)
+	state.FreshnessJobID = jobIDFromAPI(state.FreshnessJobID, account.FreshnessJobId)
+}
+
+// jobIDFromAPI keeps the 0 used in the config to remove a job, so that it doesn't show as a diff
+func jobIDFromAPI(current types.Int64, jobID int) types.Int64 {
+	if jobID == 0 && !current.IsNull() && !current.IsUnknown() && current.ValueInt64() == 0 {
+		return current
+	}
+	return helper.SetIntToInt64OrNull(jobID)
+}
+
+func (r *accountSettingsResource) updateSettings(
+	plan AccountSettingsResourceModel,
+) (AccountSettingsResourceModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	settings := dbt_cloud.AccountSettings{}
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		settings.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.RunDurationLimitSeconds.IsNull() && !plan.RunDurationLimitSeconds.IsUnknown() {
+		runDurationLimitSeconds := int(plan.RunDurationLimitSeconds.ValueInt64())
+		settings.RunDurationLimitSeconds = &runDurationLimitSeconds
+	}
+	if !plan.GitAuthLevel.IsNull() && !plan.GitAuthLevel.IsUnknown() {
+		settings.GitAuthLevel = plan.GitAuthLevel.ValueStringPointer()
+	}
+	if !plan.DocsJobID.IsNull() && !plan.DocsJobID.IsUnknown() {
+		docsJobID := int(plan.DocsJobID.ValueInt64())
+		settings.DocsJobID = &docsJobID
+	}
+	if !plan.FreshnessJobID.IsNull() && !plan.FreshnessJobID.IsUnknown() {
+		freshnessJobID := int(plan.FreshnessJobID.ValueInt64())
+		settings.FreshnessJobID = &freshnessJobID
+	}
+
+	account, err := r.client.UpdateAccountSettings(settings)
+	if err != nil {
+		diags.AddError("Error updating the account settings", err.Error())
+		return plan, diags
+	}
+
+	setSettingsFromAPI(&plan, account)
+	return plan, diags
+}
+
+func (r *accountSettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan AccountSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.updateSettings(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *accountSettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state AccountSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := r.client.GetAccount()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading the account settings", err.Error())
+		return
+	}
+
+	setSettingsFromAPI(&state, account)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *accountSettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan AccountSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.updateSettings(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *accountSettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// no-op, the account settings can't be deleted and we keep their current values
+}
+
+func (r *accountSettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// the settings can only be managed for the account configured in the provider
+	if req.ID != strconv.Itoa(r.client.AccountID) {
+		resp.Diagnostics.AddError(
+			"Invalid account ID",
+			fmt.Sprintf(
+				"The account settings can only be imported for the account configured in the provider, expected the ID %d but got %q",
+				r.client.AccountID,
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *accountSettingsResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
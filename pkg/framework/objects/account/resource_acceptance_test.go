@@ -0,0 +1,68 @@
+package account_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudAccountSettingsResource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the account is shared with the other tests, so we set the settings to their current values
+			{
+				Config: testAccDbtCloudAccountSettingsResourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_account_settings.test",
+						"name",
+						"data.dbtcloud_account.test",
+						"name",
+					),
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_account_settings.test",
+						"run_duration_limit_seconds",
+						"data.dbtcloud_account.test",
+						"run_duration_limit_seconds",
+					),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_account_settings.test",
+						"git_auth_level",
+					),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "dbtcloud_account_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// the settings of other accounts can't be imported
+			{
+				ResourceName:  "dbtcloud_account_settings.test",
+				ImportState:   true,
+				ImportStateId: "999999999",
+				ExpectError:   regexp.MustCompile("Invalid account ID"),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudAccountSettingsResourceConfig() string {
+	return `
+data "dbtcloud_account" "test" {
+}
+
+resource "dbtcloud_account_settings" "test" {
+  name                       = data.dbtcloud_account.test.name
+  run_duration_limit_seconds = data.dbtcloud_account.test.run_duration_limit_seconds
+  docs_job_id                = data.dbtcloud_account.test.docs_job_id
+  freshness_job_id           = data.dbtcloud_account.test.freshness_job_id
+}
+`
+}
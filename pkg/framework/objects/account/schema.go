@@ -0,0 +1,196 @@
+package account
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (d *accountDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the details of the dbt Cloud account configured in the provider, like its plan and its limits, to adapt the configuration to the account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the account",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the account",
+			},
+			"state": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The state of the account, 1 for active and 2 for deleted",
+			},
+			"plan": schema.StringAttribute{
+				Computed:    true,
+				Description: "The dbt Cloud plan of the account, e.g. `team` or `enterprise`",
+			},
+			"pending_cancel": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the subscription of the account is being cancelled",
+			},
+			"locked": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the account is locked",
+			},
+			"lock_reason": schema.StringAttribute{
+				Computed:    true,
+				Description: "The reason why the account is locked",
+			},
+			"run_slots": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of runs that can run at the same time in the account",
+			},
+			"developer_seats": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of developer licenses of the account",
+			},
+			"read_only_seats": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of read-only licenses of the account",
+			},
+			"queue_limit": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The maximum number of runs that can be queued in the account",
+			},
+			"run_duration_limit_seconds": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The maximum duration of a run, in seconds",
+			},
+			"pod_memory_request_mebibytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The memory requested for the runs, in MiB",
+			},
+			"git_auth_level": schema.StringAttribute{
+				Computed:    true,
+				Description: "The level at which the users authenticate to the git provider",
+			},
+			"develop_file_system": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the file system is enabled for the IDE",
+			},
+			"docs_job_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the job used by default to generate the docs of the account",
+			},
+			"freshness_job_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the job used by default to get the source freshness of the account",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the account was created",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the account was last updated",
+			},
+			"enterprise_authentication_method": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SSO method of the account, e.g. `saml2`, `okta` or `azure_single_tenant`. Empty when SSO is not configured",
+			},
+			"enterprise_login_slug": schema.StringAttribute{
+				Computed:    true,
+				Description: "The slug used in the SSO login URL",
+			},
+			"enterprise_unique_identifier": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the account for the identity provider",
+			},
+			"enterprise_login_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL to log in to the account with SSO",
+			},
+			"sso_reauth": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the users need to log in again with SSO periodically",
+			},
+		},
+	}
+}
+
+func (r *accountSettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resource_schema.Schema{
+		Description: helper.DocString(
+			`Manages the settings of the dbt Cloud account configured in the provider. This resource should only be defined once per account.
+
+			The attributes not set in the configuration keep their current value, ~~~docs_job_id~~~ and ~~~freshness_job_id~~~ can be set to ~~~0~~~ to remove the job from the account. Destroying the resource doesn't change the settings of the account.
+
+			The resource can be imported with the ID of the account configured in the provider.`,
+		),
+		Attributes: map[string]resource_schema.Attribute{
+			"id": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the account",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"run_duration_limit_seconds": resource_schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum duration of a run, in seconds, after which it is cancelled",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"git_auth_level": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The level at which the users authenticate to the git provider",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"docs_job_id": resource_schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the job used by default to generate the docs of the account, `0` removes the job",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"freshness_job_id": resource_schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the job used by default to get the source freshness of the account, `0` removes the job",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
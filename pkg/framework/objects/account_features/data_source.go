@@ -0,0 +1,71 @@
+package account_features
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &accountFeaturesDataSource{}
+	_ datasource.DataSourceWithConfigure = &accountFeaturesDataSource{}
+)
+
+func AccountFeaturesDataSource() datasource.DataSource {
+	return &accountFeaturesDataSource{}
+}
+
+type accountFeaturesDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *accountFeaturesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_account_features"
+}
+
+func (d *accountFeaturesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	features, err := d.client.GetAccountFeatures()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading account features", err.Error())
+		return
+	}
+
+	featuresMap, diags := types.MapValueFrom(ctx, types.BoolType, features.All)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := AccountFeaturesDataSourceModel{
+		ID:             types.StringValue(fmt.Sprintf("%d", d.client.AccountID)),
+		AdvancedCI:     types.BoolValue(features.AdvancedCI),
+		PartialParsing: types.BoolValue(features.PartialParsing),
+		RepoCaching:    types.BoolValue(features.RepoCaching),
+		Features:       featuresMap,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *accountFeaturesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,40 @@
+package account_features_test
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudAccountFeaturesDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "dbtcloud_account_features" "test" {
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.dbtcloud_account_features.test",
+						"id",
+					),
+					resource.TestCheckResourceAttrSet(
+						"data.dbtcloud_account_features.test",
+						"features.advanced-ci",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.dbtcloud_account_features.test",
+						"advanced_ci",
+						"data.dbtcloud_account_features.test",
+						"features.advanced-ci",
+					),
+				),
+			},
+		},
+	})
+}
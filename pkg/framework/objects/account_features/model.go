@@ -9,4 +9,13 @@ type AccountFeaturesResourceModel struct {
 	AdvancedCI     types.Bool   `tfsdk:"advanced_ci"`
 	PartialParsing types.Bool   `tfsdk:"partial_parsing"`
 	RepoCaching    types.Bool   `tfsdk:"repo_caching"`
+	Features       types.Map    `tfsdk:"features"`
+}
+
+type AccountFeaturesDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	AdvancedCI     types.Bool   `tfsdk:"advanced_ci"`
+	PartialParsing types.Bool   `tfsdk:"partial_parsing"`
+	RepoCaching    types.Bool   `tfsdk:"repo_caching"`
+	Features       types.Map    `tfsdk:"features"`
 }
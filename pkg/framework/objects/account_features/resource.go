@@ -3,17 +3,30 @@ package account_features
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource              = &accountFeaturesResource{}
-	_ resource.ResourceWithConfigure = &accountFeaturesResource{}
+	_ resource.Resource                   = &accountFeaturesResource{}
+	_ resource.ResourceWithConfigure      = &accountFeaturesResource{}
+	_ resource.ResourceWithValidateConfig = &accountFeaturesResource{}
+	_ resource.ResourceWithModifyPlan     = &accountFeaturesResource{}
 )
 
+// dedicatedFeatures are the features with their own attribute, they can't be set in the features map
+var dedicatedFeatures = map[string]string{
+	"advanced-ci":     "advanced_ci",
+	"partial-parsing": "partial_parsing",
+	"repo-caching":    "repo_caching",
+}
+
 type accountFeaturesResource struct {
 	client *dbt_cloud.Client
 }
@@ -22,18 +35,88 @@ func AccountFeaturesResource() resource.Resource {
 	return &accountFeaturesResource{}
 }
 
-func readFeatures(client *dbt_cloud.Client) (AccountFeaturesResourceModel, error) {
+// readFeatures returns the features of the account, only the keys of managedFeatures are kept in the features map
+func readFeatures(
+	ctx context.Context,
+	client *dbt_cloud.Client,
+	managedFeatures types.Map,
+) (AccountFeaturesResourceModel, diag.Diagnostics, error) {
 	features, err := client.GetAccountFeatures()
 	if err != nil {
-		return AccountFeaturesResourceModel{}, err
+		return AccountFeaturesResourceModel{}, nil, err
 	}
 
+	featuresMap, diags := liveManagedFeatures(ctx, managedFeatures, features.All)
+
 	return AccountFeaturesResourceModel{
 		ID:             types.StringValue(fmt.Sprintf("%d", client.AccountID)),
 		AdvancedCI:     types.BoolValue(features.AdvancedCI),
 		PartialParsing: types.BoolValue(features.PartialParsing),
 		RepoCaching:    types.BoolValue(features.RepoCaching),
-	}, nil
+		Features:       featuresMap,
+	}, diags, nil
+}
+
+// liveManagedFeatures returns the values in dbt Cloud of the features managed by the resource
+// so that the drift is reported for each key, the features that don't exist anymore are removed
+func liveManagedFeatures(
+	ctx context.Context,
+	managedFeatures types.Map,
+	liveFeatures map[string]bool,
+) (types.Map, diag.Diagnostics) {
+	if managedFeatures.IsNull() || managedFeatures.IsUnknown() {
+		return types.MapNull(types.BoolType), nil
+	}
+
+	values := map[string]bool{}
+	for feature := range managedFeatures.Elements() {
+		if value, ok := liveFeatures[feature]; ok {
+			values[feature] = value
+		}
+	}
+	return types.MapValueFrom(ctx, types.BoolType, values)
+}
+
+// featuresToUpdate returns the features of the plan that are new or have a different value in the state
+func featuresToUpdate(
+	ctx context.Context,
+	plan, state types.Map,
+) (map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	planFeatures := map[string]bool{}
+	if !plan.IsNull() && !plan.IsUnknown() {
+		diags.Append(plan.ElementsAs(ctx, &planFeatures, false)...)
+	}
+	stateFeatures := map[string]bool{}
+	if !state.IsNull() && !state.IsUnknown() {
+		diags.Append(state.ElementsAs(ctx, &stateFeatures, false)...)
+	}
+
+	updates := map[string]bool{}
+	for feature, value := range planFeatures {
+		if stateValue, ok := stateFeatures[feature]; !ok || stateValue != value {
+			updates[feature] = value
+		}
+	}
+	return updates, diags
+}
+
+func (r *accountFeaturesResource) updateFeatures(features map[string]bool) error {
+	// we sort the features to send the requests in a predictable order
+	featureNames := make([]string, 0, len(features))
+	for feature := range features {
+		featureNames = append(featureNames, feature)
+	}
+	sort.Strings(featureNames)
+
+	for _, feature := range featureNames {
+		err := r.client.UpdateAccountFeature(feature, features[feature])
+		if err != nil {
+			return fmt.Errorf("error updating the %s feature: %w", feature, err)
+		}
+	}
+	return nil
 }
 
 func (r *accountFeaturesResource) Metadata(
@@ -44,6 +127,87 @@ func (r *accountFeaturesResource) Metadata(
 	resp.TypeName = req.ProviderTypeName + "_account_features"
 }
 
+func (r *accountFeaturesResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data AccountFeaturesResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Features.IsNull() || data.Features.IsUnknown() {
+		return
+	}
+
+	for feature := range data.Features.Elements() {
+		if attribute, ok := dedicatedFeatures[feature]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("features").AtMapKey(feature),
+				"Feature with a dedicated attribute",
+				fmt.Sprintf(
+					"The feature %s can't be set in the features map, use the attribute %s instead.",
+					feature,
+					attribute,
+				),
+			)
+		}
+	}
+}
+
+func (r *accountFeaturesResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan AccountFeaturesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Features.IsNull() || plan.Features.IsUnknown() {
+		return
+	}
+
+	// we check that the features exist in the account, so that typos are caught when planning
+	features, err := r.client.GetAccountFeatures()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading account features", err.Error())
+		return
+	}
+
+	availableFeatures := make([]string, 0, len(features.All))
+	for feature := range features.All {
+		if _, ok := dedicatedFeatures[feature]; !ok {
+			availableFeatures = append(availableFeatures, feature)
+		}
+	}
+	sort.Strings(availableFeatures)
+
+	for feature := range plan.Features.Elements() {
+		if _, ok := features.All[feature]; ok {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("features").AtMapKey(feature),
+			"Unknown account feature",
+			fmt.Sprintf(
+				"The feature %s doesn't exist for this account. The available features are: %s",
+				feature,
+				strings.Join(availableFeatures, ", "),
+			),
+		)
+	}
+}
+
 func (r *accountFeaturesResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -82,11 +246,23 @@ func (r *accountFeaturesResource) Create(
 		}
 	}
 
-	features, err := readFeatures(r.client)
+	updates, diags := featuresToUpdate(ctx, plan.Features, types.MapNull(types.BoolType))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.updateFeatures(updates)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating account features", err.Error())
+		return
+	}
+
+	features, diags, err := readFeatures(ctx, r.client, plan.Features)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading account features", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &features)
 	resp.Diagnostics.Append(diags...)
@@ -97,14 +273,21 @@ func (r *accountFeaturesResource) Read(
 	req resource.ReadRequest,
 	resp *resource.ReadResponse,
 ) {
+	var state AccountFeaturesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	features, err := readFeatures(r.client)
+	features, diags, err := readFeatures(ctx, r.client, state.Features)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading account features", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(diags...)
 
-	diags := resp.State.Set(ctx, &features)
+	diags = resp.State.Set(ctx, &features)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -152,11 +335,24 @@ func (r *accountFeaturesResource) Update(
 		}
 	}
 
-	features, err := readFeatures(r.client)
+	// the features removed from the map keep their current value
+	updates, diags := featuresToUpdate(ctx, plan.Features, state.Features)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.updateFeatures(updates)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating account features", err.Error())
+		return
+	}
+
+	features, diags, err := readFeatures(ctx, r.client, plan.Features)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading account features", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &features)
 	resp.Diagnostics.Append(diags...)
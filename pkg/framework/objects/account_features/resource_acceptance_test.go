@@ -1,6 +1,8 @@
 package account_features_test
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
@@ -49,6 +51,20 @@ func TestAccDbtCloudAccountFeaturesResource(t *testing.T) {
 					),
 				),
 			},
+			// the features with a dedicated attribute can't be set in the map
+			{
+				Config: testAccDbtCloudAccountFeaturesResourceFeaturesConfig("advanced-ci"),
+				ExpectError: regexp.MustCompile(
+					"Feature with a dedicated attribute",
+				),
+			},
+			// the features are checked against the ones of the account
+			{
+				Config: testAccDbtCloudAccountFeaturesResourceFeaturesConfig("not-a-real-feature"),
+				ExpectError: regexp.MustCompile(
+					"Unknown account feature",
+				),
+			},
 		},
 	})
 }
@@ -71,3 +87,16 @@ resource "dbtcloud_account_features" "test" {
 }
 `
 }
+
+func testAccDbtCloudAccountFeaturesResourceFeaturesConfig(feature string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_account_features" "test" {
+    advanced_ci     = true
+    partial_parsing = true
+    repo_caching    = true
+    features = {
+        "%s" = true
+    }
+}
+`, feature)
+}
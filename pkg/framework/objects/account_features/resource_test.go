@@ -0,0 +1,60 @@
+package account_features
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFeaturesToUpdate(t *testing.T) {
+	t.Parallel()
+
+	mapValue := func(values map[string]bool) types.Map {
+		mapValue, _ := types.MapValueFrom(context.Background(), types.BoolType, values)
+		return mapValue
+	}
+
+	testCases := []struct {
+		name     string
+		plan     types.Map
+		state    types.Map
+		expected map[string]bool
+	}{
+		{
+			name:     "no features",
+			plan:     types.MapNull(types.BoolType),
+			state:    types.MapNull(types.BoolType),
+			expected: map[string]bool{},
+		},
+		{
+			name:     "new features",
+			plan:     mapValue(map[string]bool{"feature-a": true, "feature-b": false}),
+			state:    types.MapNull(types.BoolType),
+			expected: map[string]bool{"feature-a": true, "feature-b": false},
+		},
+		{
+			name:     "only changed features",
+			plan:     mapValue(map[string]bool{"feature-a": true, "feature-b": true}),
+			state:    mapValue(map[string]bool{"feature-a": true, "feature-b": false}),
+			expected: map[string]bool{"feature-b": true},
+		},
+		{
+			name:     "removed features are not updated",
+			plan:     mapValue(map[string]bool{"feature-a": true}),
+			state:    mapValue(map[string]bool{"feature-a": true, "feature-b": false}),
+			expected: map[string]bool{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		updates, diags := featuresToUpdate(context.Background(), testCase.plan, testCase.state)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", testCase.name, diags)
+		}
+		if !reflect.DeepEqual(updates, testCase.expected) {
+			t.Errorf("%s: expected %v, got %v", testCase.name, testCase.expected, updates)
+		}
+	}
+}
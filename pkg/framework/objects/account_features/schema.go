@@ -4,10 +4,13 @@ import (
 	"context"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func (r *accountFeaturesResource) Schema(
@@ -19,7 +22,9 @@ func (r *accountFeaturesResource) Schema(
 		Description: helper.DocString(
 			`Manages dbt Cloud global features at the account level, like Advanced CI. The same feature should not be configured in different resources to avoid conflicts.
 		
-		When destroying the resource or removing the value for an attribute, the features status will not be changed. Deactivating features will require applying them wih the value set to ~~~false~~~.`,
+		When destroying the resource or removing the value for an attribute, the features status will not be changed. Deactivating features will require applying them wih the value set to ~~~false~~~.
+
+		Features that don't have a dedicated attribute yet can be set in the ~~~features~~~ map, with the name used by dbt Cloud (e.g. ~~~advanced-ci~~~). The names are checked against the features of the account when planning, and the list of features can be retrieved with the ~~~dbtcloud_account_features~~~ data source.`,
 		),
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -44,6 +49,44 @@ func (r *accountFeaturesResource) Schema(
 				Optional:    true,
 				Computed:    true,
 			},
+			"features": schema.MapAttribute{
+				Description: "Map of the features to enable or disable, using the name of the feature in dbt Cloud as the key. Only the features in the map are managed by the resource, and the features with a dedicated attribute can't be set here.",
+				Optional:    true,
+				ElementType: types.BoolType,
+			},
+		},
+	}
+}
+
+func (d *accountFeaturesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasource_schema.Schema{
+		Description: "Retrieve all the features of the account and whether they are enabled.",
+		Attributes: map[string]datasource_schema.Attribute{
+			"id": datasource_schema.StringAttribute{
+				Description: "The ID of the account.",
+				Computed:    true,
+			},
+			"advanced_ci": datasource_schema.BoolAttribute{
+				Description: "Whether advanced CI is enabled.",
+				Computed:    true,
+			},
+			"partial_parsing": datasource_schema.BoolAttribute{
+				Description: "Whether partial parsing is enabled.",
+				Computed:    true,
+			},
+			"repo_caching": datasource_schema.BoolAttribute{
+				Description: "Whether repository caching is enabled.",
+				Computed:    true,
+			},
+			"features": datasource_schema.MapAttribute{
+				Description: "Map of all the features of the account, using the name of the feature in dbt Cloud as the key.",
+				Computed:    true,
+				ElementType: types.BoolType,
+			},
 		},
 	}
 }
@@ -0,0 +1,114 @@
+package audit_log
+
+import (
+	"context"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ datasource.DataSource              = &auditLogsDataSource{}
+	_ datasource.DataSourceWithConfigure = &auditLogsDataSource{}
+)
+
+func AuditLogsDataSource() datasource.DataSource {
+	return &auditLogsDataSource{}
+}
+
+type auditLogsDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *auditLogsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+func (d *auditLogsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state AuditLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startTime, err := time.Parse(time.RFC3339, state.StartTime.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start_time"),
+			"Invalid start time",
+			"The start time needs to be in RFC 3339 format: "+err.Error(),
+		)
+		return
+	}
+
+	endTime := ""
+	if !state.EndTime.IsNull() {
+		parsedEndTime, err := time.Parse(time.RFC3339, state.EndTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_time"),
+				"Invalid end time",
+				"The end time needs to be in RFC 3339 format: "+err.Error(),
+			)
+			return
+		}
+		if !parsedEndTime.After(startTime) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_time"),
+				"Invalid time window",
+				"The end time needs to be after the start time",
+			)
+			return
+		}
+		endTime = parsedEndTime.UTC().Format(time.RFC3339)
+	}
+
+	auditLogs, err := d.client.GetAllAuditLogs(startTime.UTC().Format(time.RFC3339), endTime)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue when retrieving the audit logs",
+			err.Error(),
+		)
+		return
+	}
+
+	filteredAuditLogs := filterAuditLogs(
+		auditLogs,
+		helper.StringSetToStringSlice(state.EventTypes),
+		helper.StringSetToStringSlice(state.Actors),
+		helper.StringSetToStringSlice(state.ObjectTypes),
+	)
+
+	state.Events = []AuditLogEventDataSourceModel{}
+	for _, auditLog := range filteredAuditLogs {
+		state.Events = append(state.Events, auditLogToModel(auditLog))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *auditLogsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	switch c := req.ProviderData.(type) {
+	case nil: // do nothing
+	case *dbt_cloud.Client:
+		d.client = c
+	default:
+		resp.Diagnostics.AddError("Missing client", "A client is required to configure the audit logs data source")
+	}
+}
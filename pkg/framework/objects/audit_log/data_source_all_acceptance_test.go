@@ -0,0 +1,92 @@
+package audit_log_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/fake_api"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudAuditLogsDataSource(t *testing.T) {
+
+	startTime := time.Now().UTC().Add(-24 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudAuditLogsDataSourceConfig(startTime),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dbtcloud_audit_logs.all", "events.#"),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_audit_logs.unknown_actor",
+						"events.#",
+						"0",
+					),
+				),
+			},
+			{
+				Config: `
+data "dbtcloud_audit_logs" "invalid" {
+  start_time = "yesterday"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid start time"),
+			},
+		},
+	})
+}
+
+func TestAccDbtCloudAuditLogsDataSourceNotEnterprise(t *testing.T) {
+
+	// the accounts used for the acceptance tests have access to the audit logs
+	if !acctest_helper.IsFakeAPI() {
+		t.Skip("Skipping the audit logs access check, it requires an account without access to the audit logs")
+	}
+
+	startTime := time.Now().UTC().Add(-24 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest_helper.TestAccPreCheck(t)
+			server, _ := acctest_helper.FakeAPI()
+			server.InjectFault(fake_api.Fault{
+				Method:     http.MethodGet,
+				Path:       regexp.MustCompile(`/audit-logs/`),
+				StatusCode: http.StatusForbidden,
+				Times:      1,
+			})
+		},
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the API returns a 403 for the accounts which are not on the Enterprise plan
+			{
+				Config: fmt.Sprintf(`
+data "dbtcloud_audit_logs" "all" {
+  start_time = "%s"
+}
+`, startTime),
+				ExpectError: regexp.MustCompile("Issue when retrieving the audit logs"),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudAuditLogsDataSourceConfig(startTime string) string {
+	return fmt.Sprintf(`
+data "dbtcloud_audit_logs" "all" {
+  start_time = "%s"
+}
+
+data "dbtcloud_audit_logs" "unknown_actor" {
+  start_time = "%s"
+  actors     = ["terraform-acceptance-test-unknown-actor"]
+}
+`, startTime, startTime)
+}
@@ -0,0 +1,85 @@
+package audit_log
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+type AuditLogsDataSourceModel struct {
+	StartTime   types.String                   `tfsdk:"start_time"`
+	EndTime     types.String                   `tfsdk:"end_time"`
+	EventTypes  types.Set                      `tfsdk:"event_types"`
+	Actors      types.Set                      `tfsdk:"actors"`
+	ObjectTypes types.Set                      `tfsdk:"object_types"`
+	Events      []AuditLogEventDataSourceModel `tfsdk:"events"`
+}
+
+type AuditLogEventDataSourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Timestamp  types.String `tfsdk:"timestamp"`
+	ActorType  types.String `tfsdk:"actor_type"`
+	ActorID    types.Int64  `tfsdk:"actor_id"`
+	Actor      types.String `tfsdk:"actor"`
+	EventType  types.String `tfsdk:"event_type"`
+	Action     types.String `tfsdk:"action"`
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectID   types.Int64  `tfsdk:"object_id"`
+	ProjectID  types.Int64  `tfsdk:"project_id"`
+	Metadata   types.String `tfsdk:"metadata"`
+}
+
+// matchesAny returns true when there is no filter or when the value matches one of the filters, ignoring the case
+func matchesAny(filters []string, values ...string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	return lo.SomeBy(filters, func(filter string) bool {
+		return lo.SomeBy(values, func(value string) bool {
+			return value != "" && strings.EqualFold(filter, value)
+		})
+	})
+}
+
+// filterAuditLogs returns the audit logs matching all the filters, empty filters match all the audit logs
+// the actors can be given with their name or their ID
+func filterAuditLogs(
+	auditLogs []dbt_cloud.AuditLog,
+	eventTypes []string,
+	actors []string,
+	objectTypes []string,
+) []dbt_cloud.AuditLog {
+	return lo.Filter(auditLogs, func(auditLog dbt_cloud.AuditLog, _ int) bool {
+		actorID := ""
+		if auditLog.ActorID != nil {
+			actorID = strconv.FormatInt(*auditLog.ActorID, 10)
+		}
+		return matchesAny(eventTypes, auditLog.EventType) &&
+			matchesAny(actors, auditLog.ActorName, actorID) &&
+			matchesAny(objectTypes, auditLog.ObjectType)
+	})
+}
+
+func auditLogToModel(auditLog dbt_cloud.AuditLog) AuditLogEventDataSourceModel {
+	metadata := types.StringNull()
+	if len(auditLog.EventContext) > 0 && string(auditLog.EventContext) != "null" {
+		metadata = types.StringValue(string(auditLog.EventContext))
+	}
+
+	return AuditLogEventDataSourceModel{
+		ID:         types.Int64Value(auditLog.ID),
+		Timestamp:  types.StringValue(auditLog.LoggedAt),
+		ActorType:  types.StringValue(auditLog.ActorType),
+		ActorID:    types.Int64PointerValue(auditLog.ActorID),
+		Actor:      types.StringValue(auditLog.ActorName),
+		EventType:  types.StringValue(auditLog.EventType),
+		Action:     types.StringValue(auditLog.Action),
+		ObjectType: types.StringValue(auditLog.ObjectType),
+		ObjectID:   types.Int64PointerValue(auditLog.ObjectID),
+		ProjectID:  types.Int64PointerValue(auditLog.ProjectID),
+		Metadata:   metadata,
+	}
+}
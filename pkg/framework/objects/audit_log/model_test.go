@@ -0,0 +1,90 @@
+package audit_log
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/samber/lo"
+)
+
+func TestFilterAuditLogs(t *testing.T) {
+	t.Parallel()
+
+	auditLogs := []dbt_cloud.AuditLog{
+		{
+			ID:         1,
+			ActorID:    lo.ToPtr(int64(10)),
+			ActorName:  "alice@example.com",
+			EventType:  "job.definition.changed",
+			ObjectType: "job",
+		},
+		{
+			ID:         2,
+			ActorID:    lo.ToPtr(int64(20)),
+			ActorName:  "bob@example.com",
+			EventType:  "environment.definition.changed",
+			ObjectType: "environment",
+		},
+		{
+			ID:         3,
+			ActorName:  "terraform-token",
+			EventType:  "group.permission.changed",
+			ObjectType: "group",
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		eventTypes  []string
+		actors      []string
+		objectTypes []string
+		expectedIDs []int64
+	}{
+		{
+			name:        "no filter",
+			expectedIDs: []int64{1, 2, 3},
+		},
+		{
+			name:        "event type",
+			eventTypes:  []string{"job.definition.changed"},
+			expectedIDs: []int64{1},
+		},
+		{
+			name:        "actor name ignoring the case",
+			actors:      []string{"BOB@example.com"},
+			expectedIDs: []int64{2},
+		},
+		{
+			name:        "actor ID",
+			actors:      []string{"10", "terraform-token"},
+			expectedIDs: []int64{1, 3},
+		},
+		{
+			name:        "object types",
+			objectTypes: []string{"environment", "group"},
+			expectedIDs: []int64{2, 3},
+		},
+		{
+			name:        "all filters need to match",
+			actors:      []string{"alice@example.com"},
+			objectTypes: []string{"group"},
+			expectedIDs: []int64{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		filteredAuditLogs := filterAuditLogs(
+			auditLogs,
+			testCase.eventTypes,
+			testCase.actors,
+			testCase.objectTypes,
+		)
+		filteredIDs := lo.Map(filteredAuditLogs, func(auditLog dbt_cloud.AuditLog, _ int) int64 {
+			return auditLog.ID
+		})
+		if len(filteredIDs) != len(testCase.expectedIDs) ||
+			len(lo.Intersect(filteredIDs, testCase.expectedIDs)) != len(testCase.expectedIDs) {
+			t.Errorf("%s: expected %v, got %v", testCase.name, testCase.expectedIDs, filteredIDs)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package audit_log
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (d *auditLogsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: helper.DocString(
+			`Retrieve the events of the audit log of the account for a given time window, for example to keep evidence of the changes made to jobs, environments and permissions.
+
+			The events are read for the whole time window before being filtered, so long time windows on busy accounts can take some time to be read.`,
+		),
+		Attributes: map[string]schema.Attribute{
+			"start_time": schema.StringAttribute{
+				Required:    true,
+				Description: "The start of the time window, in RFC 3339 format (e.g. `2024-01-01T00:00:00Z`)",
+			},
+			"end_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "The end of the time window, in RFC 3339 format. Defaults to now",
+			},
+			"event_types": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return the events with one of those event types (e.g. `job.definition.changed`)",
+			},
+			"actors": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return the events triggered by one of those actors, given with their name (e.g. the email of the user) or their ID",
+			},
+			"object_types": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return the events on one of those object types (e.g. `job`, `environment` or `group`)",
+			},
+			"events": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The events of the audit log matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the event",
+						},
+						"timestamp": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the event was logged",
+						},
+						"actor_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of actor that triggered the event, e.g. a user or a service token",
+						},
+						"actor_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the actor that triggered the event",
+						},
+						"actor": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the actor that triggered the event",
+						},
+						"event_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the event",
+						},
+						"action": schema.StringAttribute{
+							Computed:    true,
+							Description: "The action done on the object",
+						},
+						"object_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the object the event is about",
+						},
+						"object_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the object the event is about",
+						},
+						"project_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the project of the object, when the object belongs to a project",
+						},
+						"metadata": schema.StringAttribute{
+							Computed:    true,
+							Description: "The details of the event as a JSON encoded string, they can be read with `jsondecode()`",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package connection_ssh_tunnel
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectionSSHTunnelResourceModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	ConnectionID     types.Int64  `tfsdk:"connection_id"`
+	HostName         types.String `tfsdk:"hostname"`
+	Port             types.Int64  `tfsdk:"port"`
+	Username         types.String `tfsdk:"username"`
+	PublicKey        types.String `tfsdk:"public_key"`
+	RotateKeyTrigger types.String `tfsdk:"rotate_key_trigger"`
+}
+
+func (m *ConnectionSSHTunnelResourceModel) setFromAPI(
+	sshTunnel *dbt_cloud.GlobalConnectionEncryptionPayload,
+) {
+	m.ID = types.Int64PointerValue(sshTunnel.ID)
+	m.ConnectionID = types.Int64Value(sshTunnel.ConnectionID)
+	m.HostName = types.StringValue(sshTunnel.HostName)
+	m.Port = types.Int64Value(sshTunnel.Port)
+	m.Username = types.StringValue(sshTunnel.Username)
+	m.PublicKey = types.StringValue(sshTunnel.PublicKey)
+}
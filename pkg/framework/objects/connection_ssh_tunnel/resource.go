@@ -0,0 +1,276 @@
+package connection_ssh_tunnel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &connectionSSHTunnelResource{}
+	_ resource.ResourceWithConfigure   = &connectionSSHTunnelResource{}
+	_ resource.ResourceWithImportState = &connectionSSHTunnelResource{}
+	_ resource.ResourceWithModifyPlan  = &connectionSSHTunnelResource{}
+)
+
+func ConnectionSSHTunnelResource() resource.Resource {
+	return &connectionSSHTunnelResource{}
+}
+
+type connectionSSHTunnelResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *connectionSSHTunnelResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_connection_ssh_tunnel"
+}
+
+// isKeyRotationRequested returns true when the trigger has been changed to a new non-empty value
+func isKeyRotationRequested(plan, state ConnectionSSHTunnelResourceModel) bool {
+	return !plan.RotateKeyTrigger.IsNull() &&
+		!plan.RotateKeyTrigger.IsUnknown() &&
+		plan.RotateKeyTrigger.ValueString() != "" &&
+		!plan.RotateKeyTrigger.Equal(state.RotateKeyTrigger)
+}
+
+func (r *connectionSSHTunnelResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var plan, state ConnectionSSHTunnelResourceModel
+
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		// we only check when both plan and state are not null
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKeyRotationRequested(plan, state) {
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("id"), types.Int64Unknown())...,
+		)
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("public_key"), types.StringUnknown())...,
+		)
+	}
+}
+
+func (r *connectionSSHTunnelResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ConnectionSSHTunnelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](r.client)
+	sshTunnels, err := c.GetEncryptionsForConnection(state.ConnectionID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the SSH tunnel", err.Error())
+		return
+	}
+
+	var sshTunnel *dbt_cloud.GlobalConnectionEncryptionPayload
+	for _, connectionSSHTunnel := range *sshTunnels {
+		// after an import, we only know the connection ID
+		if state.ID.IsNull() ||
+			(connectionSSHTunnel.ID != nil && *connectionSSHTunnel.ID == state.ID.ValueInt64()) {
+			sshTunnel = &connectionSSHTunnel
+			break
+		}
+	}
+
+	if sshTunnel == nil {
+		resp.Diagnostics.AddWarning(
+			"Resource not found",
+			"The SSH tunnel resource was not found and has been removed from the state.",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setFromAPI(sshTunnel)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *connectionSSHTunnelResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ConnectionSSHTunnelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionID := plan.ConnectionID.ValueInt64()
+	c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](r.client)
+
+	existingSSHTunnels, err := c.GetEncryptionsForConnection(connectionID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the SSH tunnels of the connection", err.Error())
+		return
+	}
+	if len(*existingSSHTunnels) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("connection_id"),
+			"The connection already has an SSH tunnel",
+			fmt.Sprintf(
+				"The connection %d already has an SSH tunnel, it can be imported with the connection ID or removed from the `ssh_tunnel` block of the connection.",
+				connectionID,
+			),
+		)
+		return
+	}
+
+	sshTunnel, err := c.CreateUpdateEncryption(r.payload(plan, nil))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create the SSH tunnel", "Error: "+err.Error())
+		return
+	}
+
+	plan.setFromAPI(sshTunnel)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *connectionSSHTunnelResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state ConnectionSSHTunnelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](r.client)
+	stateID := state.ID.ValueInt64()
+
+	if isKeyRotationRequested(plan, state) {
+		// dbt Cloud generates the key pair when the SSH tunnel is created and a connection can only have one
+		// SSH tunnel, so we delete it before creating the new one, the connection is unusable in between
+		deletePayload := r.payload(state, &stateID)
+		deletePayload.State = dbt_cloud.STATE_DELETED
+		_, err := c.CreateUpdateEncryption(deletePayload)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to rotate the SSH tunnel key", "Error: "+err.Error())
+			return
+		}
+
+		sshTunnel, err := c.CreateUpdateEncryption(r.payload(plan, nil))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to rotate the SSH tunnel key", "Error: "+err.Error())
+			return
+		}
+
+		plan.setFromAPI(sshTunnel)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	sshTunnel, err := c.CreateUpdateEncryption(r.payload(plan, &stateID))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update the SSH tunnel", "Error: "+err.Error())
+		return
+	}
+
+	plan.setFromAPI(sshTunnel)
+	// the key pair doesn't change when updating the SSH tunnel
+	if sshTunnel.PublicKey == "" {
+		plan.PublicKey = state.PublicKey
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *connectionSSHTunnelResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ConnectionSSHTunnelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// to delete the SSH tunnel we update it with state=2
+	stateID := state.ID.ValueInt64()
+	deletePayload := r.payload(state, &stateID)
+	deletePayload.State = dbt_cloud.STATE_DELETED
+
+	c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](r.client)
+	_, err := c.CreateUpdateEncryption(deletePayload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting the SSH tunnel", err.Error())
+		return
+	}
+}
+
+func (r *connectionSSHTunnelResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	connectionID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing the connection ID",
+			"The SSH tunnel is imported with the ID of its connection: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), connectionID)...)
+}
+
+func (r *connectionSSHTunnelResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
+
+// payload returns the encryption to send to dbt Cloud, a nil ID creates a new SSH tunnel
+func (r *connectionSSHTunnelResource) payload(
+	model ConnectionSSHTunnelResourceModel,
+	id *int64,
+) dbt_cloud.GlobalConnectionEncryptionPayload {
+	return dbt_cloud.GlobalConnectionEncryptionPayload{
+		ID:           id,
+		AccountID:    int64(r.client.AccountID),
+		ConnectionID: model.ConnectionID.ValueInt64(),
+		Username:     model.Username.ValueString(),
+		Port:         model.Port.ValueInt64(),
+		HostName:     model.HostName.ValueString(),
+	}
+}
@@ -0,0 +1,143 @@
+package connection_ssh_tunnel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDbtCloudConnectionSSHTunnelResource(t *testing.T) {
+
+	connectionName := acctest_helper.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudConnectionSSHTunnelResourceConfig(connectionName, "host1", 22, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_connection_ssh_tunnel.test",
+						"connection_id",
+						"dbtcloud_global_connection.test",
+						"id",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_connection_ssh_tunnel.test",
+						"hostname",
+						"host1",
+					),
+					resource.TestCheckResourceAttrSet("dbtcloud_connection_ssh_tunnel.test", "id"),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_connection_ssh_tunnel.test",
+						"public_key",
+					),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudConnectionSSHTunnelResourceConfig(connectionName, "host2", 2222, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_connection_ssh_tunnel.test",
+							plancheck.ResourceActionUpdate,
+						),
+						plancheck.ExpectKnownValue(
+							"dbtcloud_connection_ssh_tunnel.test",
+							tfjsonpath.New("public_key"),
+							knownvalue.NotNull(),
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_connection_ssh_tunnel.test",
+						"hostname",
+						"host2",
+					),
+					resource.TestCheckResourceAttr("dbtcloud_connection_ssh_tunnel.test", "port", "2222"),
+				),
+			},
+			// ROTATE KEY
+			{
+				Config: testAccDbtCloudConnectionSSHTunnelResourceConfig(
+					connectionName,
+					"host2",
+					2222,
+					"2024-01-01",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue(
+							"dbtcloud_connection_ssh_tunnel.test",
+							tfjsonpath.New("public_key"),
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_connection_ssh_tunnel.test",
+						"public_key",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_connection_ssh_tunnel.test",
+						"rotate_key_trigger",
+						"2024-01-01",
+					),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "dbtcloud_connection_ssh_tunnel.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["dbtcloud_connection_ssh_tunnel.test"].Primary.Attributes["connection_id"], nil
+				},
+				ImportStateVerifyIdentifierAttribute: "connection_id",
+				ImportStateVerifyIgnore: []string{
+					"rotate_key_trigger",
+				},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudConnectionSSHTunnelResourceConfig(
+	connectionName, hostname string,
+	port int,
+	rotateKeyTrigger string,
+) string {
+	rotateKeyTriggerConfig := ""
+	if rotateKeyTrigger != "" {
+		rotateKeyTriggerConfig = fmt.Sprintf(`rotate_key_trigger = "%s"`, rotateKeyTrigger)
+	}
+
+	return fmt.Sprintf(`
+resource "dbtcloud_global_connection" "test" {
+  name = "%s"
+
+  postgres = {
+    hostname = "test.com"
+    port     = 5432
+    dbname   = "my_database"
+  }
+}
+
+resource "dbtcloud_connection_ssh_tunnel" "test" {
+  connection_id = dbtcloud_global_connection.test.id
+  hostname      = "%s"
+  port          = %d
+  username      = "user"
+  %s
+}
+`, connectionName, hostname, port, rotateKeyTriggerConfig)
+}
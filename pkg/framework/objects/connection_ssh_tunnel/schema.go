@@ -0,0 +1,72 @@
+package connection_ssh_tunnel
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func (r *connectionSSHTunnelResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: helper.DocString(
+			`Manage the SSH tunnel of a PostgreSQL or Redshift connection separately from the connection.
+
+			The ~~~public_key~~~ generated by dbt Cloud can be added to the ~~~authorized_keys~~~ of the bastion host and rotated with ~~~rotate_key_trigger~~~.
+
+			~> A connection can only have one SSH tunnel, so this resource can't be used with the ~~~ssh_tunnel~~~ block of ~~~dbtcloud_global_connection~~~ for the same connection.
+
+			~> dbt Cloud can't rotate the key of an existing SSH tunnel, so changing ~~~rotate_key_trigger~~~ deletes the SSH tunnel and creates a new one. The connection can't reach the warehouse from the deletion until the new ~~~public_key~~~ is added to the ~~~authorized_keys~~~ of the bastion host, so rotations should be planned when no job is running.`,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the SSH tunnel",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the global connection using the SSH tunnel. Changing it recreates the SSH tunnel",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Required:    true,
+				Description: "The hostname of the bastion host",
+			},
+			"port": schema.Int64Attribute{
+				Required:    true,
+				Description: "The SSH port of the bastion host",
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The username to use to connect to the bastion host",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SSH public key generated by dbt Cloud, to add to the `authorized_keys` of the bastion host",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_key_trigger": schema.StringAttribute{
+				Optional: true,
+				Description: helper.DocString(
+					`Arbitrary value that triggers the rotation of the key pair when it is changed to a new non-empty value (e.g. a date or a version number).
+					The rotation replaces the SSH tunnel in dbt Cloud, so the ~~~id~~~ and the ~~~public_key~~~ both change and the previous key stops being valid immediately, causing a downtime of the connection until the new key is authorized on the bastion host.`,
+				),
+			},
+		},
+	}
+}
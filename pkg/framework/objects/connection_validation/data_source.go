@@ -0,0 +1,88 @@
+package connection_validation
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &connectionTestDataSource{}
+	_ datasource.DataSourceWithConfigure        = &connectionTestDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &connectionTestDataSource{}
+)
+
+func ConnectionTestDataSource() datasource.DataSource {
+	return &connectionTestDataSource{}
+}
+
+type connectionTestDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *connectionTestDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_connection_test"
+}
+
+func (d *connectionTestDataSource) ConfigValidators(
+	_ context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("project_id"),
+			path.MatchRoot("credential_id"),
+		),
+	}
+}
+
+func (d *connectionTestDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state connectionTestDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionTest, err := d.client.TestConnection(
+		state.ConnectionID.ValueInt64(),
+		int(state.ProjectID.ValueInt64()),
+		int(state.CredentialID.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to test the connection",
+			err.Error(),
+		)
+		return
+	}
+
+	state.TaskID = types.StringValue(connectionTest.ID)
+	state.Success = types.BoolValue(connectionTest.State == dbt_cloud.CONNECTION_TEST_STATE_SUCCESS)
+	state.Message = types.StringValue(connectionTest.Message)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *connectionTestDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
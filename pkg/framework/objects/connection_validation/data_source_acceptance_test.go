@@ -0,0 +1,50 @@
+package connection_validation_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudConnectionTestDataSource(t *testing.T) {
+
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet("data.dbtcloud_connection_test.test", "task_id"),
+		// the connection points to a fake account, so the test fails with the error of the adapter
+		resource.TestCheckResourceAttr("data.dbtcloud_connection_test.test", "success", "false"),
+		resource.TestCheckResourceAttrSet("data.dbtcloud_connection_test.test", "message"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: connectionTest(connectionName),
+				Check:  check,
+			},
+		},
+	})
+}
+
+func connectionTest(connectionName string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_global_connection" "test" {
+  name = "%s"
+  snowflake = {
+    account   = "test-account"
+    database  = "db"
+    warehouse = "wh"
+  }
+}
+
+data "dbtcloud_connection_test" "test" {
+  connection_id = dbtcloud_global_connection.test.id
+}
+`, connectionName)
+}
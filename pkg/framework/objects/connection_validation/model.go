@@ -0,0 +1,14 @@
+package connection_validation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type connectionTestDataSourceModel struct {
+	ConnectionID types.Int64  `tfsdk:"connection_id"`
+	ProjectID    types.Int64  `tfsdk:"project_id"`
+	CredentialID types.Int64  `tfsdk:"credential_id"`
+	TaskID       types.String `tfsdk:"task_id"`
+	Success      types.Bool   `tfsdk:"success"`
+	Message      types.String `tfsdk:"message"`
+}
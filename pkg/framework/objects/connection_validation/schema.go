@@ -0,0 +1,49 @@
+package connection_validation
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *connectionTestDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: helper.DocString(
+			`Test a connection, optionally with a credential, and return whether dbt Cloud could connect to the warehouse.
+
+			The test is run every time the data source is read and a failed test doesn't return an error, so that it can be used in ~~~check~~~ blocks to validate connections and credentials at every plan.`,
+		),
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the connection to test",
+			},
+			"project_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the project of the credential, required when `credential_id` is set",
+			},
+			"credential_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the credential to test with the connection. When not set, dbt Cloud only tests the connection details",
+			},
+			"task_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the dbt Cloud task that ran the test",
+			},
+			"success": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether dbt Cloud could connect to the warehouse",
+			},
+			"message": schema.StringAttribute{
+				Computed:    true,
+				Description: "The error returned by the adapter when the test failed",
+			},
+		},
+	}
+}
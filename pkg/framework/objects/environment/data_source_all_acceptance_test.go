@@ -5,16 +5,15 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccDbtCloudEnvironmentsDataSource(t *testing.T) {
 
-	randomProjectName1 := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
-	randomProjectName2 := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
-	randomEnvironmentName1 := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
-	randomEnvironmentName2 := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	randomProjectName1 := acctest_helper.RandomName()
+	randomProjectName2 := acctest_helper.RandomName()
+	randomEnvironmentName1 := acctest_helper.RandomName()
+	randomEnvironmentName2 := acctest_helper.RandomName()
 
 	config := environments(
 		randomProjectName1,
@@ -45,6 +44,7 @@ func TestAccDbtCloudEnvironmentsDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -0,0 +1,288 @@
+package extended_attributes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/global_connection"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+type ExtendedAttributesResourceModel struct {
+	ID                   types.String  `tfsdk:"id"`
+	ExtendedAttributesID types.Int64   `tfsdk:"extended_attributes_id"`
+	State                types.Int64   `tfsdk:"state"`
+	ProjectID            types.Int64   `tfsdk:"project_id"`
+	ConnectionID         types.Int64   `tfsdk:"connection_id"`
+	ExtendedAttributes   types.Dynamic `tfsdk:"extended_attributes"`
+}
+
+// attrValueToAny converts a Terraform value to the Go value used to encode it in JSON
+func attrValueToAny(value attr.Value) (any, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("the value is not known yet")
+	}
+
+	switch v := value.(type) {
+	case types.Dynamic:
+		return attrValueToAny(v.UnderlyingValue())
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.Number:
+		return bigFloatToAny(v.ValueBigFloat()), nil
+	case types.Int64:
+		return v.ValueInt64(), nil
+	case types.Float64:
+		return v.ValueFloat64(), nil
+	case types.Object:
+		return attrMapToAny(v.Attributes())
+	case types.Map:
+		return attrMapToAny(v.Elements())
+	case types.Tuple:
+		return attrListToAny(v.Elements())
+	case types.List:
+		return attrListToAny(v.Elements())
+	case types.Set:
+		return attrListToAny(v.Elements())
+	default:
+		return nil, fmt.Errorf("the type %s is not supported", value.Type(context.Background()))
+	}
+}
+
+func bigFloatToAny(value *big.Float) any {
+	if value.IsInt() {
+		if intValue, accuracy := value.Int64(); accuracy == big.Exact {
+			return intValue
+		}
+	}
+	floatValue, _ := value.Float64()
+	return floatValue
+}
+
+func attrMapToAny(elements map[string]attr.Value) (map[string]any, error) {
+	result := map[string]any{}
+	for key, element := range elements {
+		value, err := attrValueToAny(element)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+func attrListToAny(elements []attr.Value) ([]any, error) {
+	result := []any{}
+	for _, element := range elements {
+		value, err := attrValueToAny(element)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+// anyToAttrValue converts a value decoded from JSON to a Terraform value
+func anyToAttrValue(value any) attr.Value {
+	switch v := value.(type) {
+	case nil:
+		return types.DynamicNull()
+	case string:
+		return types.StringValue(v)
+	case bool:
+		return types.BoolValue(v)
+	case json.Number:
+		number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return types.StringValue(v.String())
+		}
+		return types.NumberValue(number)
+	case float64:
+		return types.NumberValue(big.NewFloat(v))
+	case map[string]any:
+		attributeTypes := map[string]attr.Type{}
+		attributes := map[string]attr.Value{}
+		for key, element := range v {
+			attributes[key] = anyToAttrValue(element)
+			attributeTypes[key] = attributes[key].Type(context.Background())
+		}
+		return types.ObjectValueMust(attributeTypes, attributes)
+	case []any:
+		elementTypes := []attr.Type{}
+		elements := []attr.Value{}
+		for _, element := range v {
+			elementValue := anyToAttrValue(element)
+			elements = append(elements, elementValue)
+			elementTypes = append(elementTypes, elementValue.Type(context.Background()))
+		}
+		return types.TupleValueMust(elementTypes, elements)
+	default:
+		return types.StringValue(fmt.Sprint(v))
+	}
+}
+
+// decodeJSONObject decodes a JSON object, keeping the numbers as they are written
+func decodeJSONObject(data []byte) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var result map[string]any
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, fmt.Errorf("the extended attributes need to be a JSON object")
+	}
+	return result, nil
+}
+
+// extendedAttributesToMap returns the extended attributes configured as an object or as a JSON encoded string
+func extendedAttributesToMap(value types.Dynamic) (map[string]any, error) {
+	if stringValue, ok := value.UnderlyingValue().(types.String); ok {
+		if stringValue.IsUnknown() {
+			return nil, fmt.Errorf("the value is not known yet")
+		}
+		return decodeJSONObject([]byte(stringValue.ValueString()))
+	}
+
+	result, err := attrValueToAny(value)
+	if err != nil {
+		return nil, err
+	}
+	mapResult, ok := result.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("the extended attributes need to be an object or a JSON encoded object")
+	}
+	return mapResult, nil
+}
+
+// normalizeJSON encodes the extended attributes with sorted keys and without whitespaces
+// so that the key order and the formatting don't create diffs
+func normalizeJSON(extendedAttributes map[string]any) (string, error) {
+	normalized, err := json.Marshal(extendedAttributes)
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
+
+// extendedAttributesFromAPI returns the value to save in the state from the JSON returned by the API
+// the current value is kept when it is equivalent to the API one, otherwise the API value uses the same form as the current one
+func extendedAttributesFromAPI(current types.Dynamic, fromAPI json.RawMessage) (types.Dynamic, error) {
+	apiAttributes, err := decodeJSONObject(fromAPI)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+	apiNormalized, err := normalizeJSON(apiAttributes)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+
+	if !current.IsNull() && !current.IsUnknown() {
+		currentAttributes, err := extendedAttributesToMap(current)
+		if err == nil {
+			currentNormalized, err := normalizeJSON(currentAttributes)
+			if err == nil && currentNormalized == apiNormalized {
+				return current, nil
+			}
+		}
+
+		if _, isString := current.UnderlyingValue().(types.String); !isString {
+			return types.DynamicValue(anyToAttrValue(apiAttributes)), nil
+		}
+	}
+
+	// after an import or when the config uses jsonencode()
+	return types.DynamicValue(types.StringValue(apiNormalized)), nil
+}
+
+// checkKeys validates the keys of the extended attributes against the keys known for the adapter
+// unknown keys raise an error when the known keys are complete, otherwise a warning with a hint for the likely typos
+// as dbt Cloud accepts any key of the profile
+func checkKeys(extendedAttributes map[string]any, adapterVersion string, profileKeys global_connection.ProfileKeys) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	keys := lo.Keys(extendedAttributes)
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if lo.Contains(profileKeys.Keys, key) {
+			continue
+		}
+
+		hint := helper.DidYouMean(key, profileKeys.Keys)
+		if profileKeys.Complete {
+			diags.AddAttributeError(
+				path.Root("extended_attributes"),
+				"Invalid extended attribute",
+				strings.TrimSpace(fmt.Sprintf(
+					"`%s` is not a valid key for a connection using the adapter `%s`. %s",
+					key,
+					adapterVersion,
+					hint,
+				)),
+			)
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			path.Root("extended_attributes"),
+			"Unknown extended attribute",
+			strings.TrimSpace(fmt.Sprintf(
+				"`%s` is not a key known by the provider for a connection using the adapter `%s`, it will be sent as is to dbt Cloud. %s",
+				key,
+				adapterVersion,
+				hint,
+			)),
+		)
+	}
+
+	return diags
+}
+
+func (m *ExtendedAttributesResourceModel) setFromAPI(extendedAttributes *dbt_cloud.ExtendedAttributes) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(
+		fmt.Sprintf(
+			"%d%s%d",
+			extendedAttributes.ProjectID,
+			dbt_cloud.ID_DELIMITER,
+			*extendedAttributes.ID,
+		),
+	)
+	m.ExtendedAttributesID = types.Int64Value(int64(*extendedAttributes.ID))
+	m.ProjectID = types.Int64Value(int64(extendedAttributes.ProjectID))
+	if extendedAttributes.State != 0 {
+		m.State = types.Int64Value(int64(extendedAttributes.State))
+	}
+	if m.State.IsNull() {
+		m.State = types.Int64Value(dbt_cloud.STATE_ACTIVE)
+	}
+
+	value, err := extendedAttributesFromAPI(m.ExtendedAttributes, extendedAttributes.ExtendedAttributes)
+	if err != nil {
+		diags.AddError("Unable to read the extended attributes", err.Error())
+		return diags
+	}
+	m.ExtendedAttributes = value
+
+	return diags
+}
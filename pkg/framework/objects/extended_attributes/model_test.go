@@ -0,0 +1,264 @@
+package extended_attributes
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/global_connection"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExtendedAttributesFromAPI(t *testing.T) {
+	t.Parallel()
+
+	objectValue := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"type":    types.StringType,
+			"threads": types.NumberType,
+		},
+		map[string]attr.Value{
+			"type":    types.StringValue("databricks"),
+			"threads": anyToAttrValue(json.Number("8")),
+		},
+	))
+	heredocValue := types.DynamicValue(types.StringValue(`{
+		"type": "databricks",
+		"threads": 8
+	}`))
+
+	testCases := []struct {
+		name     string
+		current  types.Dynamic
+		fromAPI  string
+		expected types.Dynamic
+	}{
+		{
+			name:     "import",
+			current:  types.DynamicNull(),
+			fromAPI:  `{"type": "databricks", "catalog": "dbt_catalog"}`,
+			expected: types.DynamicValue(types.StringValue(`{"catalog":"dbt_catalog","type":"databricks"}`)),
+		},
+		{
+			name:     "string with a different formatting and key order",
+			current:  heredocValue,
+			fromAPI:  `{"threads":8,"type":"databricks"}`,
+			expected: heredocValue,
+		},
+		{
+			name:     "string with different values",
+			current:  heredocValue,
+			fromAPI:  `{"threads":4,"type":"databricks"}`,
+			expected: types.DynamicValue(types.StringValue(`{"threads":4,"type":"databricks"}`)),
+		},
+		{
+			name:     "object with the same values",
+			current:  objectValue,
+			fromAPI:  `{"type":"databricks","threads":8}`,
+			expected: objectValue,
+		},
+		{
+			name:    "object with different values",
+			current: objectValue,
+			fromAPI: `{"type":"databricks","threads":4}`,
+			expected: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"type":    types.StringType,
+					"threads": types.NumberType,
+				},
+				map[string]attr.Value{
+					"type":    types.StringValue("databricks"),
+					"threads": anyToAttrValue(json.Number("4")),
+				},
+			)),
+		},
+	}
+
+	for _, testCase := range testCases {
+		value, err := extendedAttributesFromAPI(testCase.current, json.RawMessage(testCase.fromAPI))
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", testCase.name, err)
+			continue
+		}
+		if !value.Equal(testCase.expected) {
+			t.Errorf("%s: expected %s, got %s", testCase.name, testCase.expected, value)
+		}
+	}
+}
+
+func TestExtendedAttributesToMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		value         types.Dynamic
+		expectedError bool
+	}{
+		{
+			name:  "JSON string",
+			value: types.DynamicValue(types.StringValue(`{"threads": 8}`)),
+		},
+		{
+			name: "object",
+			value: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{"threads": types.NumberType},
+				map[string]attr.Value{"threads": anyToAttrValue(json.Number("8"))},
+			)),
+		},
+		{
+			name:          "invalid JSON",
+			value:         types.DynamicValue(types.StringValue(`{"threads": 8`)),
+			expectedError: true,
+		},
+		{
+			name:          "JSON array",
+			value:         types.DynamicValue(types.StringValue(`["threads"]`)),
+			expectedError: true,
+		},
+		{
+			name:          "number",
+			value:         types.DynamicValue(types.Int64Value(8)),
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		extendedAttributes, err := extendedAttributesToMap(testCase.value)
+		if testCase.expectedError {
+			if err == nil {
+				t.Errorf("%s: expected an error", testCase.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", testCase.name, err)
+			continue
+		}
+		normalized, _ := normalizeJSON(extendedAttributes)
+		if normalized != `{"threads":8}` {
+			t.Errorf("%s: expected {\"threads\":8}, got %s", testCase.name, normalized)
+		}
+	}
+}
+
+func TestCheckKeys(t *testing.T) {
+	t.Parallel()
+
+	knownKeys := []string{"type", "threads", "schema", "catalog", "http_path", "password"}
+
+	testCases := []struct {
+		name             string
+		keys             []string
+		complete         bool
+		expectedErrors   int
+		expectedWarnings int
+	}{
+		{
+			name: "valid keys",
+			keys: []string{"threads", "catalog"},
+		},
+		{
+			name:             "typo",
+			keys:             []string{"treads", "catalog"},
+			expectedWarnings: 1,
+		},
+		{
+			name:           "typo with complete keys",
+			keys:           []string{"treads", "catalog"},
+			complete:       true,
+			expectedErrors: 1,
+		},
+		{
+			name:             "unknown key",
+			keys:             []string{"session_properties"},
+			expectedWarnings: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		extendedAttributes := map[string]any{}
+		for _, key := range testCase.keys {
+			extendedAttributes[key] = "value"
+		}
+
+		profileKeys := global_connection.ProfileKeys{Keys: knownKeys, Complete: testCase.complete}
+		diags := checkKeys(extendedAttributes, "databricks_v0", profileKeys)
+		errors := diags.Errors()
+		warnings := diags.Warnings()
+		if len(errors) != testCase.expectedErrors || len(warnings) != testCase.expectedWarnings {
+			t.Errorf(
+				"%s: expected %d errors and %d warnings, got %v",
+				testCase.name,
+				testCase.expectedErrors,
+				testCase.expectedWarnings,
+				diags,
+			)
+		}
+		if len(errors) > 0 {
+			checkDidYouMean(t, testCase.name, errors)
+		}
+	}
+}
+
+// the keys close to other keys of the same adapter must not be reported as typos
+func TestCheckKeysCloseToOtherKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		adapterVersion string
+		keys           []string
+	}{
+		{
+			adapterVersion: "postgres_v0",
+			keys:           []string{"password", "sslpassword", "sslcert", "sslkey", "sslrootcert", "sslmode", "schema", "search_path"},
+		},
+		{
+			adapterVersion: "redshift_v0",
+			keys:           []string{"password", "sslpassword", "sslmode", "retries", "role"},
+		},
+		{
+			adapterVersion: "databricks_v0",
+			keys:           []string{"token", "auth_type", "catalog", "client_id", "client_secret", "schema", "host", "http_path"},
+		},
+		{
+			adapterVersion: "fabric_v0",
+			keys:           []string{"server", "database", "login_timeout", "query_timeout", "schema", "schema_authorization"},
+		},
+		{
+			adapterVersion: "teradata_v0",
+			keys:           []string{"user", "password", "schema", "threads"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		profileKeys, ok := global_connection.ExtendedAttributesKeys(testCase.adapterVersion)
+		if !ok {
+			t.Fatalf("%s: expected the adapter to be known", testCase.adapterVersion)
+		}
+
+		extendedAttributes := map[string]any{}
+		for _, key := range testCase.keys {
+			extendedAttributes[key] = "value"
+		}
+
+		if diags := checkKeys(extendedAttributes, testCase.adapterVersion, profileKeys); len(diags) > 0 {
+			t.Errorf("%s: expected no diagnostics, got %v", testCase.adapterVersion, diags)
+		}
+	}
+
+	// a key missing from an incomplete list is only a warning, even when it is close to a known key
+	profileKeys, _ := global_connection.ExtendedAttributesKeys("postgres_v0")
+	diags := checkKeys(map[string]any{"sslpasword": "value"}, "postgres_v0", profileKeys)
+	if diags.HasError() || len(diags.Warnings()) != 1 {
+		t.Errorf("expected a single warning, got %v", diags)
+	}
+}
+
+func checkDidYouMean(t *testing.T, name string, errors diag.Diagnostics) {
+	detail := errors[0].Detail()
+	expected := "`treads` is not a valid key for a connection using the adapter `databricks_v0`. Did you mean `threads`?"
+	if detail != expected {
+		t.Errorf("%s: expected %q, got %q", name, expected, detail)
+	}
+}
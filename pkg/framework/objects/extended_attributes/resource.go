@@ -0,0 +1,462 @@
+package extended_attributes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/global_connection"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &extendedAttributesResource{}
+	_ resource.ResourceWithConfigure      = &extendedAttributesResource{}
+	_ resource.ResourceWithImportState    = &extendedAttributesResource{}
+	_ resource.ResourceWithValidateConfig = &extendedAttributesResource{}
+	_ resource.ResourceWithModifyPlan     = &extendedAttributesResource{}
+	_ resource.ResourceWithUpgradeState   = &extendedAttributesResource{}
+)
+
+func ExtendedAttributesResource() resource.Resource {
+	return &extendedAttributesResource{}
+}
+
+type extendedAttributesResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *extendedAttributesResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_extended_attributes"
+}
+
+func (r *extendedAttributesResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config ExtendedAttributesResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !isFullyKnown(ctx, config.ExtendedAttributes) {
+		return
+	}
+
+	if _, err := extendedAttributesToMap(config.ExtendedAttributes); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("extended_attributes"),
+			"Invalid extended attributes",
+			"The extended attributes need to be an object or a JSON encoded object: "+err.Error(),
+		)
+	}
+}
+
+// isFullyKnown returns true when the value and all its nested values are known
+func isFullyKnown(ctx context.Context, value types.Dynamic) bool {
+	terraformValue, err := value.ToTerraformValue(ctx)
+	return err == nil && terraformValue.IsFullyKnown() && !value.IsNull()
+}
+
+func (r *extendedAttributesResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var plan, state ExtendedAttributesResourceModel
+
+	if req.Plan.Raw.IsNull() {
+		// nothing to do when the resource is destroyed
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// the extended attributes are moved to the other project and their ID contains the project ID
+		if !plan.ProjectID.Equal(state.ProjectID) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+		}
+
+		// the keys have already been checked when they were last changed
+		if plan.ExtendedAttributes.Equal(state.ExtendedAttributes) &&
+			plan.ConnectionID.Equal(state.ConnectionID) {
+			return
+		}
+	}
+
+	if plan.ConnectionID.IsUnknown() || !isFullyKnown(ctx, plan.ExtendedAttributes) || r.client == nil {
+		return
+	}
+
+	extendedAttributes, err := extendedAttributesToMap(plan.ExtendedAttributes)
+	if err != nil {
+		// already reported when validating the config
+		return
+	}
+
+	connectionID, diags := r.connectionID(plan, state)
+	resp.Diagnostics.Append(diags...)
+	if connectionID == 0 {
+		return
+	}
+
+	connection, err := r.client.GetGlobalConnectionAdapter(connectionID)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("connection_id"),
+			"Unable to get the connection",
+			fmt.Sprintf(
+				"The connection %d is needed to check the keys of the extended attributes: %s",
+				connectionID,
+				err,
+			),
+		)
+		return
+	}
+
+	adapterVersion := connection.Data.AdapterVersion
+	profileKeys, ok := global_connection.ExtendedAttributesKeys(adapterVersion)
+	if !ok {
+		// the keys can't be checked for adapters not supported by the provider
+		return
+	}
+
+	resp.Diagnostics.Append(checkKeys(extendedAttributes, adapterVersion, profileKeys)...)
+}
+
+// connectionID returns the connection used to check the keys, either the one configured or the one of the
+// environments using the extended attributes, and warns when the keys can't be checked
+func (r *extendedAttributesResource) connectionID(
+	plan ExtendedAttributesResourceModel,
+	state ExtendedAttributesResourceModel,
+) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !plan.ConnectionID.IsNull() {
+		return plan.ConnectionID.ValueInt64(), diags
+	}
+
+	// the extended attributes are only assigned to environments once they exist
+	if !state.ExtendedAttributesID.IsNull() && !state.ExtendedAttributesID.IsUnknown() &&
+		plan.ProjectID.Equal(state.ProjectID) {
+		environments, err := r.client.GetAllEnvironments(int(plan.ProjectID.ValueInt64()))
+		if err != nil {
+			diags.AddWarning(
+				"Unable to check the keys of the extended attributes",
+				"Error getting the environments of the project: "+err.Error(),
+			)
+			return 0, diags
+		}
+
+		for _, environment := range environments {
+			if environment.ExtendedAttributesID != nil && environment.ConnectionID != nil &&
+				int64(*environment.ExtendedAttributesID) == state.ExtendedAttributesID.ValueInt64() {
+				return int64(*environment.ConnectionID), diags
+			}
+		}
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("connection_id"),
+		"The keys of the extended attributes are not checked",
+		"The adapter of the extended attributes is not known as `connection_id` is not set and no environment using them has a connection yet. Set `connection_id` to check the keys against the adapter of the connection when planning.",
+	)
+	return 0, diags
+}
+
+func (r *extendedAttributesResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ExtendedAttributesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, extendedAttributesID, err := helper.SplitIDToInts(
+		state.ID.ValueString(),
+		"dbtcloud_extended_attributes",
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the extended attributes ID", err.Error())
+		return
+	}
+
+	extendedAttributes, err := r.client.GetExtendedAttributes(projectID, extendedAttributesID)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The extended attributes resource was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error getting the extended attributes", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromAPI(extendedAttributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *extendedAttributesResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ExtendedAttributesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extendedAttributesJSON, err := plan.extendedAttributesJSON()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid extended attributes", err.Error())
+		return
+	}
+
+	extendedAttributes, err := r.client.CreateExtendedAttributes(
+		int(plan.State.ValueInt64()),
+		int(plan.ProjectID.ValueInt64()),
+		extendedAttributesJSON,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create the extended attributes", "Error: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setFromAPI(extendedAttributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *extendedAttributesResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state ExtendedAttributesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, extendedAttributesID, err := helper.SplitIDToInts(
+		state.ID.ValueString(),
+		"dbtcloud_extended_attributes",
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the extended attributes ID", err.Error())
+		return
+	}
+
+	extendedAttributesJSON, err := plan.extendedAttributesJSON()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid extended attributes", err.Error())
+		return
+	}
+
+	extendedAttributes, err := r.client.GetExtendedAttributes(projectID, extendedAttributesID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the extended attributes", err.Error())
+		return
+	}
+
+	extendedAttributes.State = int(plan.State.ValueInt64())
+	extendedAttributes.ProjectID = int(plan.ProjectID.ValueInt64())
+	extendedAttributes.ExtendedAttributes = extendedAttributesJSON
+
+	extendedAttributes, err = r.client.UpdateExtendedAttributes(
+		projectID,
+		extendedAttributesID,
+		*extendedAttributes,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update the extended attributes", "Error: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setFromAPI(extendedAttributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *extendedAttributesResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ExtendedAttributesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, extendedAttributesID, err := helper.SplitIDToInts(
+		state.ID.ValueString(),
+		"dbtcloud_extended_attributes",
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the extended attributes ID", err.Error())
+		return
+	}
+
+	_, err = r.client.DeleteExtendedAttributes(projectID, extendedAttributesID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting the extended attributes", err.Error())
+		return
+	}
+}
+
+func (r *extendedAttributesResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	_, _, err := helper.SplitIDToInts(req.ID, "dbtcloud_extended_attributes")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing the extended attributes ID",
+			fmt.Sprintf("The ID needs to be in the format `project_id%sextended_attributes_id`: %s", dbt_cloud.ID_DELIMITER, err),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// UpgradeState converts the state of the SDKv2 version of the resource, where the extended attributes were a JSON string
+func (r *extendedAttributesResource) UpgradeState(
+	_ context.Context,
+) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"extended_attributes_id": schema.Int64Attribute{
+						Computed: true,
+					},
+					"state": schema.Int64Attribute{
+						Optional: true,
+					},
+					"project_id": schema.Int64Attribute{
+						Required: true,
+					},
+					"extended_attributes": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			StateUpgrader: func(
+				ctx context.Context,
+				req resource.UpgradeStateRequest,
+				resp *resource.UpgradeStateResponse,
+			) {
+				var priorState struct {
+					ID                   types.String `tfsdk:"id"`
+					ExtendedAttributesID types.Int64  `tfsdk:"extended_attributes_id"`
+					State                types.Int64  `tfsdk:"state"`
+					ProjectID            types.Int64  `tfsdk:"project_id"`
+					ExtendedAttributes   types.String `tfsdk:"extended_attributes"`
+				}
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// the SDKv2 version saved the JSON as returned by the API, we save it like jsonencode() does
+				extendedAttributes := types.DynamicValue(priorState.ExtendedAttributes)
+				if decoded, err := decodeJSONObject([]byte(priorState.ExtendedAttributes.ValueString())); err == nil {
+					if normalized, err := normalizeJSON(decoded); err == nil {
+						extendedAttributes = types.DynamicValue(types.StringValue(normalized))
+					}
+				}
+
+				upgradedState := ExtendedAttributesResourceModel{
+					ID:                   priorState.ID,
+					ExtendedAttributesID: priorState.ExtendedAttributesID,
+					State:                priorState.State,
+					ProjectID:            priorState.ProjectID,
+					ConnectionID:         types.Int64Null(),
+					ExtendedAttributes:   extendedAttributes,
+				}
+				if upgradedState.State.IsNull() {
+					upgradedState.State = types.Int64Value(dbt_cloud.STATE_ACTIVE)
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedState)...)
+			},
+		},
+	}
+}
+
+func (r *extendedAttributesResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
+
+// extendedAttributesJSON returns the normalized JSON sent to the API
+func (m *ExtendedAttributesResourceModel) extendedAttributesJSON() (json.RawMessage, error) {
+	extendedAttributes, err := extendedAttributesToMap(m.ExtendedAttributes)
+	if err != nil {
+		return nil, err
+	}
+	normalized, err := normalizeJSON(extendedAttributes)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(normalized), nil
+}
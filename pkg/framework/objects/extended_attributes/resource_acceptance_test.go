@@ -1,7 +1,9 @@
-package resources_test
+package extended_attributes_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -9,17 +11,16 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDbtCloudExtendedAttributesResource(t *testing.T) {
 
-	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	projectName := acctest_helper.RandomName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudExtendedAttributesDestroy,
 		Steps: []resource.TestStep{
@@ -68,10 +69,40 @@ func TestAccDbtCloudExtendedAttributesResource(t *testing.T) {
 						"dbtcloud_extended_attributes.test_extended_attributes",
 						"extended_attributes",
 					),
-					resource.TestCheckResourceAttr(
+					resource.TestCheckResourceAttrWith(
 						"dbtcloud_extended_attributes.test_extended_attributes",
 						"extended_attributes",
-						"{\"catalog\":\"dbt_catalog_new\",\"type\":\"databricks\"}",
+						testAccCheckJSONEquals("{\"catalog\":\"dbt_catalog_new\",\"type\":\"databricks\"}"),
+					),
+				),
+			},
+			// FORMATTING CHANGES ONLY UPDATE THE STRING IN THE STATE
+			{
+				Config: testAccDbtCloudExtendedAttributesResourceConfig(projectName, "step2_reformatted"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith(
+						"dbtcloud_extended_attributes.test_extended_attributes",
+						"extended_attributes",
+						testAccCheckJSONEquals("{\"catalog\":\"dbt_catalog_new\",\"type\":\"databricks\"}"),
+					),
+				),
+			},
+			// OBJECT INSTEAD OF JSON STRING
+			{
+				Config: testAccDbtCloudExtendedAttributesResourceConfig(projectName, "step3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudExtendedAttributesExists(
+						"dbtcloud_extended_attributes.test_extended_attributes",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_extended_attributes.test_extended_attributes",
+						"extended_attributes.catalog",
+						"dbt_catalog_object",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_extended_attributes.test_extended_attributes",
+						"extended_attributes.threads",
+						"8",
 					),
 				),
 			},
@@ -112,6 +143,83 @@ func TestAccDbtCloudExtendedAttributesResource(t *testing.T) {
 	})
 }
 
+func TestAccDbtCloudExtendedAttributesResourceMoveProject(t *testing.T) {
+
+	projectName := acctest_helper.RandomName()
+	projectName2 := acctest_helper.RandomName()
+
+	var extendedAttributesID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudExtendedAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudExtendedAttributesResourceProjects(projectName, projectName2, "test_project"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudExtendedAttributesExists(
+						"dbtcloud_extended_attributes.test_extended_attributes",
+					),
+					resource.TestCheckResourceAttrWith(
+						"dbtcloud_extended_attributes.test_extended_attributes",
+						"extended_attributes_id",
+						func(value string) error {
+							extendedAttributesID = value
+							return nil
+						},
+					),
+				),
+			},
+			// the extended attributes are moved to the other project without being recreated
+			{
+				Config: testAccDbtCloudExtendedAttributesResourceProjects(projectName, projectName2, "test_project_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudExtendedAttributesExists(
+						"dbtcloud_extended_attributes.test_extended_attributes",
+					),
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_extended_attributes.test_extended_attributes",
+						"project_id",
+						"dbtcloud_project.test_project_2",
+						"id",
+					),
+					resource.TestCheckResourceAttrWith(
+						"dbtcloud_extended_attributes.test_extended_attributes",
+						"extended_attributes_id",
+						func(value string) error {
+							if value != extendedAttributesID {
+								return fmt.Errorf("expected the extended attributes %s to be moved, got %s", extendedAttributesID, value)
+							}
+							return nil
+						},
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudExtendedAttributesResourceProjects(projectName, projectName2, project string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name = "%s"
+}
+
+resource "dbtcloud_project" "test_project_2" {
+  name = "%s"
+}
+
+resource "dbtcloud_extended_attributes" "test_extended_attributes" {
+  extended_attributes = {
+    type    = "databricks"
+    catalog = "dbt_catalog"
+  }
+  project_id = dbtcloud_project.%s.id
+}
+`, projectName, projectName2, project)
+}
+
 func testAccDbtCloudExtendedAttributesResourceConfig(projectName, step string) string {
 
 	var extendedAttributes string
@@ -136,6 +244,18 @@ func testAccDbtCloudExtendedAttributesResourceConfig(projectName, step string) s
 		  "type": "databricks"
 		}
 		EOF`
+	} else if step == "step2_reformatted" {
+		// same attributes with a different key order and formatting
+		extendedAttributes = `<<EOF
+		{"type": "databricks",   "catalog": "dbt_catalog_new"}
+		EOF`
+	} else if step == "step3" {
+		// the extended attributes can be provided as an object
+		extendedAttributes = `{
+			type    = "databricks"
+			catalog = "dbt_catalog_object"
+			threads = 8
+		}`
 	}
 
 	return fmt.Sprintf(`
@@ -156,7 +276,7 @@ func testAccDbtCloudExtendedAttributesResourceConfig(projectName, step string) s
         project_id = dbtcloud_project.test_project.id
       }
 
-`, projectName, DBT_CLOUD_VERSION, extendedAttributes)
+`, projectName, acctest_helper.DBT_CLOUD_VERSION, extendedAttributes)
 }
 
 func testAccDbtCloudExtendedAttributesResourceUnlinked(projectName string) string {
@@ -181,7 +301,7 @@ func testAccDbtCloudExtendedAttributesResourceUnlinked(projectName string) strin
 		  )
         project_id = dbtcloud_project.test_project.id
       }
-`, projectName, DBT_CLOUD_VERSION)
+`, projectName, acctest_helper.DBT_CLOUD_VERSION)
 }
 
 func testAccCheckDbtCloudExtendedAttributesExists(resource string) resource.TestCheckFunc {
@@ -251,3 +371,20 @@ func testAccCheckDbtCloudExtendedAttributesDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+// testAccCheckJSONEquals compares JSON strings regardless of the formatting and the key order
+func testAccCheckJSONEquals(expected string) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		var expectedJSON, actualJSON any
+		if err := json.Unmarshal([]byte(expected), &expectedJSON); err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(value), &actualJSON); err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(expectedJSON, actualJSON) {
+			return fmt.Errorf("expected %s, got %s", expected, value)
+		}
+		return nil
+	}
+}
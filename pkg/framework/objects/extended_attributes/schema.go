@@ -0,0 +1,65 @@
+package extended_attributes
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func (r *extendedAttributesResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: helper.DocString(
+			`This resource allows setting extended attributes which can be assigned to a given environment ([see docs](https://docs.getdbt.com/docs/dbt-cloud-environments#extended-attributes)).
+
+			In dbt Cloud those values are provided as YML but in the provider they are provided as an object or as a JSON encoded string (see example below). The key order and the formatting of the JSON returned by dbt Cloud don't create diffs.
+
+			When the extended attributes change, their keys are checked when planning against the adapter of ~~~connection_id~~~, or of the connection of the environments using them when ~~~connection_id~~~ is not set: the keys are known from the connection and credential fields of the adapter, and unknown keys raise a warning, with a suggestion for the keys looking like a typo of a known key (e.g. ~~~treads~~~ instead of ~~~threads~~~), as dbt Cloud accepts any key of the profile. A warning is shown when the adapter can't be found, e.g. when creating the extended attributes without ~~~connection_id~~~.`,
+		),
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the extended attributes, in the format `project_id:extended_attributes_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"extended_attributes_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Extended Attributes ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.Int64Attribute{
+				Optional:           true,
+				Computed:           true,
+				Default:            int64default.StaticInt64(1),
+				Description:        "Extended Attributes state (1 is active, 2 is inactive)",
+				DeprecationMessage: "Remove this attribute's configuration as it's no longer in use and the attribute will be removed in the next major version of the provider.",
+			},
+			"project_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Project ID to create the extended attributes in. Changing it moves the extended attributes to the other project",
+			},
+			"connection_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the global connection used by the environments the extended attributes are assigned to. It is only used to check the keys of the extended attributes against the adapter of the connection when planning. When not set, the connection of the environments using the extended attributes is used, and the check is skipped with a warning if there is none",
+			},
+			"extended_attributes": schema.DynamicAttribute{
+				Required:    true,
+				Description: "The extended attributes mapping, as an object or as a JSON encoded string (e.g. created with `jsonencode()`). The keys are the connections attributes available in the `profiles.yml` for a given adapter. Any fields entered will override connection details or credentials set on the environment or project",
+			},
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package github_installation
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &gitHubInstallationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &gitHubInstallationsDataSource{}
+)
+
+func GitHubInstallationsDataSource() datasource.DataSource {
+	return &gitHubInstallationsDataSource{}
+}
+
+type gitHubInstallationsDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *gitHubInstallationsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_github_installations"
+}
+
+func (d *gitHubInstallationsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state GitHubInstallationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	installations, err := d.client.GetGitHubInstallations()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue when retrieving the GitHub App installations",
+			err.Error(),
+		)
+		return
+	}
+
+	repositoryPath := helper.GitRepositoryPath(state.RemoteURL.ValueString())
+
+	state.Installations = []GitHubInstallationDataSourceModel{}
+	for _, installation := range installations {
+
+		repositories, err := d.client.GetGitHubInstallationRepositories(installation.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Issue when retrieving the repositories of the GitHub App installation",
+				err.Error(),
+			)
+			return
+		}
+
+		hasRepository := false
+		installationRepositories := []GitHubRepositoryDataSourceModel{}
+		for _, repository := range repositories {
+			if strings.EqualFold(repository.FullName, repositoryPath) {
+				hasRepository = true
+			}
+			installationRepositories = append(installationRepositories, GitHubRepositoryDataSourceModel{
+				ID:            types.Int64Value(repository.ID),
+				Name:          types.StringValue(repository.Name),
+				FullName:      types.StringValue(repository.FullName),
+				Private:       types.BoolValue(repository.Private),
+				RemoteURL:     types.StringValue(repository.SSHURL),
+				CloneURL:      types.StringValue(repository.CloneURL),
+				DefaultBranch: types.StringValue(repository.DefaultBranch),
+			})
+		}
+
+		if repositoryPath != "" && !hasRepository {
+			continue
+		}
+
+		state.Installations = append(state.Installations, GitHubInstallationDataSourceModel{
+			ID:                  types.Int64Value(installation.ID),
+			AccountLogin:        types.StringValue(installation.Account.Login),
+			AccountType:         types.StringValue(installation.Account.Type),
+			HTMLURL:             types.StringValue(installation.HTMLURL),
+			RepositorySelection: types.StringValue(installation.RepositorySelection),
+			Repositories:        installationRepositories,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *gitHubInstallationsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	switch c := req.ProviderData.(type) {
+	case nil: // do nothing
+	case *dbt_cloud.Client:
+		d.client = c
+	default:
+		resp.Diagnostics.AddError("Missing client", "A client is required to configure the GitHub installations data source")
+	}
+}
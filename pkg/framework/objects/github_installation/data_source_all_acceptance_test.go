@@ -0,0 +1,75 @@
+package github_installation_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudGitHubInstallationsDataSource(t *testing.T) {
+
+	// the GitHub App needs to be installed for the user of the token, which is not the case in CI
+	gitHubRepository := os.Getenv("DBT_ACCEPTANCE_TEST_GITHUB_REPOSITORY")
+	if acctest_helper.IsFakeAPI() {
+		// the installation is added directly to the fake API, with more repositories than a page of GitHub
+		server, err := acctest_helper.FakeAPI()
+		if err != nil {
+			t.Fatalf("Error starting the fake dbt Cloud API: %s", err)
+		}
+		installationID := server.Seed("github-installations", map[string]any{
+			"account":              map[string]any{"id": 1, "login": "tf-acc", "type": "Organization"},
+			"repository_selection": "selected",
+		})
+		for i := 0; i < 105; i++ {
+			gitHubRepository = fmt.Sprintf("tf-acc/repository-%d", i)
+			server.Seed("github-repositories", map[string]any{
+				"installation_id": installationID,
+				"name":            fmt.Sprintf("repository-%d", i),
+				"full_name":       gitHubRepository,
+				"ssh_url":         fmt.Sprintf("git@github.com:%s.git", gitHubRepository),
+				"default_branch":  "main",
+			})
+		}
+	} else if gitHubRepository == "" {
+		t.Skip("Skipping acceptance tests as DBT_ACCEPTANCE_TEST_GITHUB_REPOSITORY is not set")
+	}
+
+	config := fmt.Sprintf(`
+data "dbtcloud_github_installations" "all" {
+}
+
+data "dbtcloud_github_installations" "repository" {
+  remote_url = "git@github.com:%s.git"
+}
+`, gitHubRepository)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.dbtcloud_github_installations.all",
+						"installations.#",
+					),
+					resource.TestCheckResourceAttrSet(
+						"data.dbtcloud_github_installations.repository",
+						"installations.0.id",
+					),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.dbtcloud_github_installations.repository",
+						"installations.0.repositories.*",
+						map[string]string{
+							"full_name": gitHubRepository,
+						},
+					),
+				),
+			},
+		},
+	})
+}
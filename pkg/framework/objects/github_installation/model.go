@@ -0,0 +1,29 @@
+package github_installation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type GitHubInstallationsDataSourceModel struct {
+	RemoteURL     types.String                        `tfsdk:"remote_url"`
+	Installations []GitHubInstallationDataSourceModel `tfsdk:"installations"`
+}
+
+type GitHubInstallationDataSourceModel struct {
+	ID                  types.Int64                       `tfsdk:"id"`
+	AccountLogin        types.String                      `tfsdk:"account_login"`
+	AccountType         types.String                      `tfsdk:"account_type"`
+	HTMLURL             types.String                      `tfsdk:"html_url"`
+	RepositorySelection types.String                      `tfsdk:"repository_selection"`
+	Repositories        []GitHubRepositoryDataSourceModel `tfsdk:"repositories"`
+}
+
+type GitHubRepositoryDataSourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	FullName      types.String `tfsdk:"full_name"`
+	Private       types.Bool   `tfsdk:"private"`
+	RemoteURL     types.String `tfsdk:"remote_url"`
+	CloneURL      types.String `tfsdk:"clone_url"`
+	DefaultBranch types.String `tfsdk:"default_branch"`
+}
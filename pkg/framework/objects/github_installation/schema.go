@@ -0,0 +1,90 @@
+package github_installation
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *gitHubInstallationsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasource_schema.Schema{
+		Description: `Retrieve the installations of the dbt Cloud GitHub App and the repositories they can access, to get the ` + "`github_installation_id`" + ` of a ` + "`dbtcloud_repository`" + ` using the ` + "`github_app`" + ` clone strategy.
+
+This data source requires connecting with a user token and doesn't work with a service token.`,
+		Attributes: map[string]datasource_schema.Attribute{
+			"remote_url": datasource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the installations with access to this repository. It can be the SSH or HTTPS URL of the repository or its full name (e.g. `my-org/my-repo`)",
+			},
+			"installations": datasource_schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of GitHub App installations",
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the installation, to use in `github_installation_id`",
+						},
+						"account_login": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The login of the GitHub organization or user the App is installed on",
+						},
+						"account_type": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the GitHub account the App is installed on (`Organization` or `User`)",
+						},
+						"html_url": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The URL of the installation settings in GitHub",
+						},
+						"repository_selection": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Whether the installation can access `all` the repositories of the account or only the `selected` ones",
+						},
+						"repositories": datasource_schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The repositories the installation can access",
+							NestedObject: datasource_schema.NestedAttributeObject{
+								Attributes: map[string]datasource_schema.Attribute{
+									"id": datasource_schema.Int64Attribute{
+										Computed:    true,
+										Description: "The GitHub ID of the repository",
+									},
+									"name": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "The name of the repository",
+									},
+									"full_name": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "The full name of the repository, e.g. `my-org/my-repo`",
+									},
+									"private": datasource_schema.BoolAttribute{
+										Computed:    true,
+										Description: "Whether the repository is private",
+									},
+									"remote_url": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "The SSH URL of the repository, to use in the `remote_url` of `dbtcloud_repository`",
+									},
+									"clone_url": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "The HTTPS URL of the repository",
+									},
+									"default_branch": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "The default branch of the repository",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
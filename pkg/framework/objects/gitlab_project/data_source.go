@@ -0,0 +1,74 @@
+package gitlab_project
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &gitlabProjectDataSource{}
+	_ datasource.DataSourceWithConfigure = &gitlabProjectDataSource{}
+)
+
+func GitlabProjectDataSource() datasource.DataSource {
+	return &gitlabProjectDataSource{}
+}
+
+type gitlabProjectDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *gitlabProjectDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_gitlab_project"
+}
+
+func (d *gitlabProjectDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state GitlabProjectDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gitlabProject, err := d.client.GetGitlabProject(
+		helper.GitRepositoryPath(state.Path.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get the GitLab project", err.Error())
+		return
+	}
+
+	state.ID = types.Int64Value(gitlabProject.ID)
+	state.Name = types.StringValue(gitlabProject.Name)
+	state.PathWithNamespace = types.StringValue(gitlabProject.PathWithNamespace)
+	state.WebURL = types.StringValue(gitlabProject.WebURL)
+	state.HTTPURLToRepo = types.StringValue(gitlabProject.HTTPURLToRepo)
+	state.SSHURLToRepo = types.StringValue(gitlabProject.SSHURLToRepo)
+	state.DefaultBranch = types.StringValue(gitlabProject.DefaultBranch)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *gitlabProjectDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,47 @@
+package gitlab_project_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudGitlabProjectDataSource(t *testing.T) {
+
+	// the GitLab integration needs to be set up for the user of the token, which is not the case in CI
+	gitlabProjectPath := os.Getenv("DBT_ACCEPTANCE_TEST_GITLAB_PROJECT_PATH")
+	if gitlabProjectPath == "" {
+		t.Skip("Skipping acceptance tests as DBT_ACCEPTANCE_TEST_GITLAB_PROJECT_PATH is not set")
+	}
+
+	config := fmt.Sprintf(`
+data "dbtcloud_gitlab_project" "test" {
+  path = "%s"
+}
+`, gitlabProjectPath)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dbtcloud_gitlab_project.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_gitlab_project.test",
+						"path_with_namespace",
+						gitlabProjectPath,
+					),
+					resource.TestCheckResourceAttrSet(
+						"data.dbtcloud_gitlab_project.test",
+						"ssh_url_to_repo",
+					),
+				),
+			},
+		},
+	})
+}
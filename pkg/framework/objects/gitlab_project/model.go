@@ -0,0 +1,16 @@
+package gitlab_project
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type GitlabProjectDataSourceModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	Path              types.String `tfsdk:"path"`
+	Name              types.String `tfsdk:"name"`
+	PathWithNamespace types.String `tfsdk:"path_with_namespace"`
+	WebURL            types.String `tfsdk:"web_url"`
+	HTTPURLToRepo     types.String `tfsdk:"http_url_to_repo"`
+	SSHURLToRepo      types.String `tfsdk:"ssh_url_to_repo"`
+	DefaultBranch     types.String `tfsdk:"default_branch"`
+}
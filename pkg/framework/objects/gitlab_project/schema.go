@@ -0,0 +1,54 @@
+package gitlab_project
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *gitlabProjectDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasource_schema.Schema{
+		Description: `Retrieve a GitLab project from its path, to get the ` + "`gitlab_project_id`" + ` of a ` + "`dbtcloud_repository`" + ` using the GitLab integration.
+
+This data source requires connecting with a user token and doesn't work with a service token.`,
+		Attributes: map[string]datasource_schema.Attribute{
+			"path": datasource_schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the project including its groups (e.g. `my-group/my-subgroup/my-project`). The SSH or HTTPS URL of the project can also be used",
+			},
+			"id": datasource_schema.Int64Attribute{
+				Computed:    true,
+				Description: "The GitLab ID of the project, to use in `gitlab_project_id`",
+			},
+			"name": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the project",
+			},
+			"path_with_namespace": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The path of the project including its groups, to use in the `remote_url` of `dbtcloud_repository`",
+			},
+			"web_url": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the project in GitLab",
+			},
+			"http_url_to_repo": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The HTTPS URL of the repository",
+			},
+			"ssh_url_to_repo": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The SSH URL of the repository",
+			},
+			"default_branch": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The default branch of the repository",
+			},
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package global_connection
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// adapterVersionNumber returns the number at the end of an adapter version, e.g. 1 for databricks_v1
+func adapterVersionNumber(adapterVersion string) (int, bool) {
+	lastIndex := strings.LastIndex(adapterVersion, "_v")
+	if lastIndex == -1 {
+		return 0, false
+	}
+	versionNumber, err := strconv.Atoi(adapterVersion[lastIndex+2:])
+	if err != nil {
+		return 0, false
+	}
+	return versionNumber, true
+}
+
+// isAdapterVersionDowngrade returns true when going from one version of an adapter to a lower one
+// dbt Cloud only supports upgrading the adapter version of an existing connection
+func isAdapterVersionDowngrade(from string, to string) bool {
+	fromNumber, okFrom := adapterVersionNumber(from)
+	toNumber, okTo := adapterVersionNumber(to)
+	if !okFrom || !okTo {
+		// we can't compare them, so we recreate the connection to be safe
+		return from != to
+	}
+	return toNumber < fromNumber
+}
+
+// adapterVersionOrDefault returns the adapter version returned by the API or the default one of the adapter
+func adapterVersionOrDefault(
+	common *dbt_cloud.GlobalConnectionCommon,
+	adapter adapterDefinition,
+) string {
+	if common != nil && common.AdapterVersion != nil && *common.AdapterVersion != "" {
+		return *common.AdapterVersion
+	}
+	return adapter.defaultAdapterVersion()
+}
+
+// activeAdapter returns the adapter and the attributes of the config block set in the model
+func activeAdapter(model *GlobalConnectionResourceModel) (adapterDefinition, map[string]attr.Value, bool) {
+	for _, adapter := range adapters {
+		if config, ok := model.Configs[adapter.name]; ok {
+			return adapter, config, true
+		}
+	}
+	return adapterDefinition{}, nil, false
+}
+
+// plannedAdapterVersion returns the adapter version of the config block when it has been set in the config
+func plannedAdapterVersion(model *GlobalConnectionResourceModel) (types.String, bool) {
+	_, config, ok := activeAdapter(model)
+	if !ok {
+		return types.StringNull(), false
+	}
+	adapterVersion := config["adapter_version"].(types.String)
+	if adapterVersion.IsNull() || adapterVersion.IsUnknown() {
+		return adapterVersion, false
+	}
+	return adapterVersion, true
+}
+
+// syncAdapterVersions sets the same value for the adapter version at the root and in the config block
+func syncAdapterVersions(model *GlobalConnectionResourceModel) {
+	_, config, ok := activeAdapter(model)
+	if !ok {
+		return
+	}
+	config["adapter_version"] = model.AdapterVersion
+}
+
+func adapterVersionResourceAttribute(adapter adapterDefinition) resource_schema.StringAttribute {
+	return resource_schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Description: helper.DocString(
+			fmt.Sprintf(
+				`Version of the adapter to use - Possible values are %s. Defaults to ~~~%s~~~ for new connections, and to the current version for existing ones.
+				Upgrading to a newer version is done in place while downgrading to an older one recreates the connection.`,
+				"`"+strings.Join(adapter.adapterVersions(), "`, `")+"`",
+				adapter.defaultAdapterVersion(),
+			),
+		),
+		Validators: []validator.String{
+			stringvalidator.OneOf(adapter.adapterVersions()...),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplaceIf(
+				func(
+					ctx context.Context,
+					req planmodifier.StringRequest,
+					resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+				) {
+					if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+						return
+					}
+					resp.RequiresReplace = isAdapterVersionDowngrade(
+						req.StateValue.ValueString(),
+						req.PlanValue.ValueString(),
+					)
+				},
+				"Downgrading the adapter version requires recreating the connection",
+				"Downgrading the adapter version requires recreating the connection",
+			),
+		},
+	}
+}
+
+func adapterVersionDatasourceAttribute() datasource_schema.StringAttribute {
+	return datasource_schema.StringAttribute{
+		Computed:    true,
+		Description: "Version of the adapter used by the connection",
+	}
+}
@@ -0,0 +1,36 @@
+package global_connection
+
+import "testing"
+
+func TestIsAdapterVersionDowngrade(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		from     string
+		to       string
+		expected bool
+	}{
+		{name: "same version", from: "databricks_v0", to: "databricks_v0", expected: false},
+		{name: "upgrade", from: "databricks_v0", to: "databricks_v1", expected: false},
+		{name: "downgrade", from: "snowflake_v1", to: "snowflake_v0", expected: true},
+		{name: "multi digit", from: "snowflake_v9", to: "snowflake_v10", expected: false},
+		{name: "underscore in adapter", from: "apache_spark_v1", to: "apache_spark_v0", expected: true},
+		{name: "unknown format", from: "custom", to: "databricks_v0", expected: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := isAdapterVersionDowngrade(testCase.from, testCase.to)
+			if got != testCase.expected {
+				t.Errorf(
+					"isAdapterVersionDowngrade(%q, %q) = %v, expected %v",
+					testCase.from,
+					testCase.to,
+					got,
+					testCase.expected,
+				)
+			}
+		})
+	}
+}
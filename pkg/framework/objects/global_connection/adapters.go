@@ -0,0 +1,773 @@
+package global_connection
+
+import (
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/samber/lo"
+)
+
+type fieldKind int
+
+const (
+	stringField fieldKind = iota
+	int64Field
+	boolField
+	stringSetField
+)
+
+// adapterField describes an attribute of the config block of an adapter and how it maps to the API
+type adapterField struct {
+	// name of the attribute in the schema
+	name string
+	// apiName is the key of the field in the API config, when different from the attribute name
+	apiName     string
+	kind        fieldKind
+	description string
+	required    bool
+	// defaultValue makes the attribute Optional and Computed, it is a string, int64, bool or []string depending on the kind
+	defaultValue any
+	sensitive    bool
+	// writeOnly fields are never returned by the API, so we keep the value from the state when reading the connection
+	writeOnly bool
+	// nullable fields are sent as null to the API when they are removed from the config, otherwise they would not be updated
+	nullable bool
+	// nullOnCreate fields are sent as null to the API when creating a connection without them
+	nullOnCreate bool
+	oneOf        []string
+}
+
+func (f adapterField) apiKey() string {
+	if f.apiName != "" {
+		return f.apiName
+	}
+	return f.name
+}
+
+// adapterDefinition declares everything the resource and data sources need to know about an adapter
+type adapterDefinition struct {
+	// name of the config block in the schema
+	name        string
+	description string
+	// config is the typed config of the adapter in pkg/dbt_cloud, which also gives the adapter versions supported
+	config adapterConfig
+	fields []adapterField
+	// createOnlyConfig is sent in addition to the fields when creating a connection and is not part of the schema
+	createOnlyConfig map[string]any
+	// sshTunnelDescription is set for the adapters supporting SSH tunnels and adds the ssh_tunnel block
+	sshTunnelDescription string
+	// privateLinkEndpointTypes are the types of PrivateLink endpoints the adapter can use, the type is not checked when empty
+	privateLinkEndpointTypes []string
+	// profileKeys are other keys of the profile known by the provider, in addition to the connection fields and
+	// the keys of the connection and credential metadata of pkg/dbt_cloud, the list is not exhaustive
+	profileKeys []string
+	// profileKeysComplete is set when the keys above are all the keys accepted by the dbt adapter
+	// an unknown key in the extended attributes is then an error instead of a warning
+	profileKeysComplete bool
+}
+
+// defaultAdapterVersion is used for new connections without a specific version
+func (a adapterDefinition) defaultAdapterVersion() string {
+	return a.config.defaultAdapterVersion()
+}
+
+// adapterVersions returns the versions of the adapter supported by the provider
+func (a adapterDefinition) adapterVersions() []string {
+	return a.config.adapterVersions()
+}
+
+func (a adapterDefinition) supportsSSHTunnel() bool {
+	return a.sshTunnelDescription != ""
+}
+
+// adapterPrefix returns the adapter version without the version number, e.g. apache_spark for apache_spark_v0
+func adapterPrefix(adapterVersion string) string {
+	// we need this logic because sometimes adapter names have _ in them, like apache_spark_v0
+	lastUnderscoreIndex := strings.LastIndex(adapterVersion, "_")
+	if lastUnderscoreIndex == -1 {
+		return adapterVersion
+	}
+	return adapterVersion[:lastUnderscoreIndex]
+}
+
+// adapterForVersion returns the adapter of a connection from its adapter version
+// it is not always the name of the config block, e.g. trino_v0 is stored under starburst
+func adapterForVersion(adapterVersion string) (adapterDefinition, bool) {
+	return lo.Find(adapters, func(adapter adapterDefinition) bool {
+		return adapterPrefix(adapter.defaultAdapterVersion()) == adapterPrefix(adapterVersion)
+	})
+}
+
+// commonProfileKeys can be set in the extended attributes of any adapter
+var commonProfileKeys = []string{"type", "threads"}
+
+// ProfileKeys are the keys that can be set in the extended attributes of an environment
+type ProfileKeys struct {
+	Keys []string
+	// Complete is false when the dbt adapter can accept keys missing from Keys
+	Complete bool
+}
+
+// ExtendedAttributesKeys returns the keys that can be set in the extended attributes of an environment
+// using a connection with the given adapter version, and false if the adapter is not known by the provider
+func ExtendedAttributesKeys(adapterVersion string) (ProfileKeys, bool) {
+	adapter, ok := adapterForVersion(adapterVersion)
+	if !ok {
+		return ProfileKeys{}, false
+	}
+
+	keys := append([]string{}, commonProfileKeys...)
+	for _, field := range adapter.fields {
+		keys = append(keys, field.name, field.apiKey())
+	}
+	if metadataKeys, ok := dbt_cloud.AdapterProfileKeys(adapterVersion); ok {
+		keys = append(keys, metadataKeys...)
+	}
+	keys = append(keys, adapter.profileKeys...)
+	if adapter.supportsSSHTunnel() {
+		keys = append(keys, "ssh_tunnel")
+	}
+
+	return ProfileKeys{Keys: lo.Uniq(keys), Complete: adapter.profileKeysComplete}, true
+}
+
+var supportedGlobalConfigTypes = lo.Map(
+	adapters,
+	func(adapter adapterDefinition, _ int) string { return adapter.name },
+)
+
+// adapters contains all the adapters supported by dbtcloud_global_connection
+// adding an adapter only requires adding it here
+var adapters = []adapterDefinition{
+	{
+		name:        "bigquery",
+		config:      typedConfig[dbt_cloud.BigQueryConfig]{},
+		profileKeys: []string{"project", "dataset", "schema", "database", "method", "keyfile", "keyfile_json", "job_execution_timeout_seconds", "job_retries", "compute_region"},
+		fields: []adapterField{
+			{
+				name:        "gcp_project_id",
+				apiName:     "project_id",
+				kind:        stringField,
+				required:    true,
+				description: "The GCP project ID to use for the connection",
+			},
+			{
+				name:         "timeout_seconds",
+				kind:         int64Field,
+				defaultValue: int64(300),
+				description:  "Timeout in seconds for queries",
+			},
+			{
+				name:        "private_key_id",
+				kind:        stringField,
+				required:    true,
+				description: "Private Key ID for the Service Account",
+			},
+			{
+				name:        "private_key",
+				kind:        stringField,
+				required:    true,
+				sensitive:   true,
+				writeOnly:   true,
+				description: "Private Key for the Service Account",
+			},
+			{
+				name:        "client_email",
+				kind:        stringField,
+				required:    true,
+				description: "Service Account email",
+			},
+			{
+				name:        "client_id",
+				kind:        stringField,
+				required:    true,
+				description: "Client ID of the Service Account",
+			},
+			{
+				name:        "auth_uri",
+				kind:        stringField,
+				required:    true,
+				description: "Auth URI for the Service Account",
+			},
+			{
+				name:        "token_uri",
+				kind:        stringField,
+				required:    true,
+				description: "Token URI for the Service Account",
+			},
+			{
+				name:        "auth_provider_x509_cert_url",
+				kind:        stringField,
+				required:    true,
+				description: "Auth Provider X509 Cert URL for the Service Account",
+			},
+			{
+				name:        "client_x509_cert_url",
+				kind:        stringField,
+				required:    true,
+				description: "Client X509 Cert URL for the Service Account",
+			},
+			{
+				name:        "priority",
+				kind:        stringField,
+				nullable:    true,
+				oneOf:       []string{"batch", "interactive"},
+				description: "The priority with which to execute BigQuery queries (batch or interactive)",
+			},
+			{
+				name:         "retries",
+				kind:         int64Field,
+				defaultValue: int64(1),
+				description:  "Number of retries for queries",
+			},
+			{
+				name:        "location",
+				kind:        stringField,
+				nullable:    true,
+				description: "Location to create new Datasets in",
+			},
+			{
+				name:        "maximum_bytes_billed",
+				kind:        int64Field,
+				nullable:    true,
+				description: "Max number of bytes that can be billed for a given BigQuery query",
+			},
+			{
+				name:        "execution_project",
+				kind:        stringField,
+				nullable:    true,
+				description: "Project to bill for query execution",
+			},
+			{
+				name:        "impersonate_service_account",
+				kind:        stringField,
+				nullable:    true,
+				description: "Service Account to impersonate when running queries",
+			},
+			{
+				name:        "job_retry_deadline_seconds",
+				kind:        int64Field,
+				nullable:    true,
+				description: "Total number of seconds to wait while retrying the same query",
+			},
+			{
+				name:        "job_creation_timeout_seconds",
+				kind:        int64Field,
+				nullable:    true,
+				description: "Maximum timeout for the job creation step",
+			},
+			{
+				name:        "application_id",
+				kind:        stringField,
+				nullable:    true,
+				sensitive:   true,
+				writeOnly:   true,
+				description: "OAuth Client ID",
+			},
+			{
+				name:        "application_secret",
+				kind:        stringField,
+				nullable:    true,
+				sensitive:   true,
+				writeOnly:   true,
+				description: "OAuth Client Secret",
+			},
+			{
+				name:        "gcs_bucket",
+				kind:        stringField,
+				nullable:    true,
+				description: "URI for a Google Cloud Storage bucket to host Python code executed via Datapro",
+			},
+			{
+				name:        "dataproc_region",
+				kind:        stringField,
+				nullable:    true,
+				description: "Google Cloud region for PySpark workloads on Dataproc",
+			},
+			{
+				name:        "dataproc_cluster_name",
+				kind:        stringField,
+				nullable:    true,
+				description: "Dataproc cluster name for PySpark workloads",
+			},
+			{
+				name: "scopes",
+				kind: stringSetField,
+				defaultValue: []string{
+					"https://www.googleapis.com/auth/bigquery",
+					"https://www.googleapis.com/auth/cloud-platform",
+					"https://www.googleapis.com/auth/drive",
+				},
+				description: "OAuth scopes for the BigQuery connection",
+			},
+		},
+	},
+	{
+		name:                     "snowflake",
+		description:              "Snowflake connection configuration",
+		config:                   typedConfig[dbt_cloud.SnowflakeConfig]{},
+		profileKeys:              []string{"user", "password", "private_key", "private_key_passphrase", "private_key_path", "schema", "authenticator", "token", "query_tag", "connect_retries", "connect_timeout", "retry_on_database_errors", "retry_all", "reuse_connections", "insecure_mode"},
+		privateLinkEndpointTypes: []string{"snowflake"},
+		fields: []adapterField{
+			{
+				name:        "account",
+				kind:        stringField,
+				required:    true,
+				description: "The Snowflake account name",
+			},
+			{
+				name:        "database",
+				kind:        stringField,
+				required:    true,
+				description: "The default database for the connection",
+			},
+			{
+				name:        "warehouse",
+				kind:        stringField,
+				required:    true,
+				description: "The default Snowflake Warehouse to use for the connection",
+			},
+			{
+				name:         "allow_sso",
+				kind:         boolField,
+				defaultValue: false,
+				description:  "Whether to allow Snowflake OAuth for the connection. If true, the `oauth_client_id` and `oauth_client_secret` fields must be set",
+			},
+			// TODO: required if allow_sso is true
+			{
+				name:        "oauth_client_id",
+				kind:        stringField,
+				sensitive:   true,
+				writeOnly:   true,
+				description: "OAuth Client ID. Required to allow OAuth between dbt Cloud and Snowflake",
+			},
+			{
+				name:        "oauth_client_secret",
+				kind:        stringField,
+				sensitive:   true,
+				writeOnly:   true,
+				description: "OAuth Client Secret. Required to allow OAuth between dbt Cloud and Snowflake",
+			},
+			{
+				name:        "role",
+				kind:        stringField,
+				nullable:    true,
+				description: "The Snowflake role to use when running queries on the connection",
+			},
+			{
+				name:         "client_session_keep_alive",
+				kind:         boolField,
+				defaultValue: false,
+				description:  "If true, the snowflake client will keep connections for longer than the default 4 hours. This is helpful when particularly long-running queries are executing (> 4 hours)",
+			},
+		},
+	},
+	{
+		name:                     "databricks",
+		description:              "Databricks connection configuration",
+		config:                   typedConfig[dbt_cloud.DatabricksConfig]{},
+		profileKeys:              []string{"schema", "token", "auth_type", "session_properties", "connection_parameters", "connect_retries", "connect_timeout", "retry_all"},
+		privateLinkEndpointTypes: []string{"databricks"},
+		fields: []adapterField{
+			{
+				name:        "host",
+				kind:        stringField,
+				required:    true,
+				description: "The hostname of the Databricks cluster or SQL warehouse.",
+			},
+			{
+				name:        "http_path",
+				kind:        stringField,
+				required:    true,
+				description: "The HTTP path of the Databricks cluster or SQL warehouse.",
+			},
+			{
+				name:        "catalog",
+				kind:        stringField,
+				nullable:    true,
+				description: "Catalog name if Unity Catalog is enabled in your Databricks workspace.",
+			},
+			{
+				name:        "client_id",
+				kind:        stringField,
+				nullable:    true,
+				writeOnly:   true,
+				description: "Required to enable Databricks OAuth authentication for IDE developers.",
+			},
+			{
+				name:        "client_secret",
+				kind:        stringField,
+				nullable:    true,
+				writeOnly:   true,
+				description: "Required to enable Databricks OAuth authentication for IDE developers.",
+			},
+		},
+	},
+	// Redshift and Postgres are the same today but they might diverge in the future to support more authentication methods
+	{
+		name:                     "redshift",
+		description:              "Redshift connection configuration",
+		config:                   typedConfig[dbt_cloud.RedshiftConfig]{},
+		profileKeys:              []string{"host", "user", "password", "schema", "method", "cluster_id", "iam_profile", "region", "autocreate", "db_groups", "ra3_node", "connect_timeout", "role", "sslmode", "sslpassword", "retries", "keepalives_idle", "autocommit"},
+		privateLinkEndpointTypes: []string{"redshift"},
+		sshTunnelDescription:     "Redshift SSH Tunnel configuration. Alternatively, the SSH tunnel can be managed with the resource `dbtcloud_connection_ssh_tunnel`, which also gives access to its public key, but both should not be used for the same connection",
+		fields: []adapterField{
+			{
+				name:        "hostname",
+				kind:        stringField,
+				required:    true,
+				description: "The hostname of the data warehouse.",
+			},
+			{
+				name:         "port",
+				kind:         int64Field,
+				defaultValue: int64(5432),
+				description:  "The port to connect to for this connection. Default=5432",
+			},
+			{
+				name:        "dbname",
+				kind:        stringField,
+				required:    true,
+				nullable:    true,
+				description: "The database name for this connection.",
+			},
+		},
+	},
+	{
+		name:                     "postgres",
+		description:              "PostgreSQL connection configuration.",
+		config:                   typedConfig[dbt_cloud.PostgresConfig]{},
+		profileKeys:              []string{"host", "user", "password", "schema", "search_path", "role", "sslmode", "sslcert", "sslkey", "sslrootcert", "sslpassword", "connect_timeout", "retries", "keepalives_idle"},
+		privateLinkEndpointTypes: []string{"postgres"},
+		sshTunnelDescription:     "PostgreSQL SSH Tunnel configuration. Alternatively, the SSH tunnel can be managed with the resource `dbtcloud_connection_ssh_tunnel`, which also gives access to its public key, but both should not be used for the same connection",
+		fields: []adapterField{
+			{
+				name:        "hostname",
+				kind:        stringField,
+				required:    true,
+				description: "The hostname of the database.",
+			},
+			{
+				name:         "port",
+				kind:         int64Field,
+				defaultValue: int64(5432),
+				description:  "The port to connect to for this connection. Default=5432",
+			},
+			{
+				name:     "dbname",
+				kind:     stringField,
+				required: true,
+				nullable: true,
+				// this is different from Redshift. We need to send null on Create for Postgres
+				nullOnCreate: true,
+				description:  "The database name for this connection.",
+			},
+		},
+	},
+	{
+		name:             "fabric",
+		description:      "Microsoft Fabric connection configuration.",
+		config:           typedConfig[dbt_cloud.FabricConfig]{},
+		profileKeys:      []string{"driver", "schema", "authentication", "user", "password", "tenant_id", "client_id", "client_secret", "encrypt", "trust_cert"},
+		createOnlyConfig: map[string]any{"driver": dbt_cloud.FabricDriver},
+		fields: []adapterField{
+			{
+				name:        "server",
+				kind:        stringField,
+				required:    true,
+				description: "The server hostname.",
+			},
+			{
+				name:         "port",
+				kind:         int64Field,
+				defaultValue: int64(1433),
+				description:  "The port to connect to for this connection. Default=1433",
+			},
+			{
+				name:        "database",
+				kind:        stringField,
+				required:    true,
+				description: "The database to connect to for this connection.",
+			},
+			{
+				name:         "retries",
+				kind:         int64Field,
+				defaultValue: int64(1),
+				description:  "The number of automatic times to retry a query before failing. Defaults to 1. Queries with syntax errors will not be retried. This setting can be used to overcome intermittent network issues.",
+			},
+			{
+				name:         "login_timeout",
+				kind:         int64Field,
+				defaultValue: int64(0),
+				description:  "The number of seconds used to establish a connection before failing. Defaults to 0, which means that the timeout is disabled or uses the default system settings.",
+			},
+			{
+				name:         "query_timeout",
+				kind:         int64Field,
+				defaultValue: int64(0),
+				description:  "The number of seconds used to wait for a query before failing. Defaults to 0, which means that the timeout is disabled or uses the default system settings.",
+			},
+		},
+	},
+	// Right now Synapse and Fabric are the same, except Synapse uses host instead of server
+	{
+		name:             "synapse",
+		description:      "Azure Synapse Analytics connection configuration.",
+		config:           typedConfig[dbt_cloud.SynapseConfig]{},
+		profileKeys:      []string{"driver", "schema", "authentication", "user", "password", "tenant_id", "client_id", "client_secret", "encrypt", "trust_cert"},
+		createOnlyConfig: map[string]any{"driver": dbt_cloud.SynapseDriver},
+		fields: []adapterField{
+			{
+				name:        "host",
+				kind:        stringField,
+				required:    true,
+				description: "The server hostname.",
+			},
+			{
+				name:         "port",
+				kind:         int64Field,
+				defaultValue: int64(1433),
+				description:  "The port to connect to for this connection. Default=1433",
+			},
+			{
+				name:        "database",
+				kind:        stringField,
+				required:    true,
+				description: "The database to connect to for this connection.",
+			},
+			{
+				name:         "retries",
+				kind:         int64Field,
+				defaultValue: int64(1),
+				description:  "The number of automatic times to retry a query before failing. Defaults to 1. Queries with syntax errors will not be retried. This setting can be used to overcome intermittent network issues.",
+			},
+			{
+				name:         "login_timeout",
+				kind:         int64Field,
+				defaultValue: int64(0),
+				description:  "The number of seconds used to establish a connection before failing. Defaults to 0, which means that the timeout is disabled or uses the default system settings.",
+			},
+			{
+				name:         "query_timeout",
+				kind:         int64Field,
+				defaultValue: int64(0),
+				description:  "The number of seconds used to wait for a query before failing. Defaults to 0, which means that the timeout is disabled or uses the default system settings.",
+			},
+		},
+	},
+	// this is the exception where we store the connection details under starburst instead of Trino
+	{
+		name:        "starburst",
+		description: "Starburst/Trino connection configuration.",
+		config:      typedConfig[dbt_cloud.StarburstConfig]{},
+		profileKeys: []string{"user", "password", "database", "schema", "catalog", "http_scheme", "session_properties", "prepared_statements_enabled", "retries", "timezone"},
+		fields: []adapterField{
+			// not too useful now, but should be easy to modify if we support for authentication methods
+			{
+				name:         "method",
+				kind:         stringField,
+				defaultValue: "ldap",
+				oneOf:        []string{"ldap"},
+				description:  "The authentication method. Only LDAP for now.",
+			},
+			{
+				name:        "host",
+				kind:        stringField,
+				required:    true,
+				description: "The hostname of the account to connect to.",
+			},
+			{
+				name:         "port",
+				kind:         int64Field,
+				defaultValue: int64(443),
+				description:  "The port to connect to for this connection. Default=443",
+			},
+		},
+	},
+	{
+		name:        "athena",
+		description: "Athena connection configuration.",
+		config:      typedConfig[dbt_cloud.AthenaConfig]{},
+		profileKeys: []string{"schema", "aws_access_key_id", "aws_secret_access_key", "aws_session_token", "aws_profile_name", "seed_s3_upload_args"},
+		fields: []adapterField{
+			{
+				name:        "region_name",
+				kind:        stringField,
+				required:    true,
+				description: "AWS region of your Athena instance.",
+			},
+			{
+				name:        "database",
+				kind:        stringField,
+				required:    true,
+				description: "Specify the database (data catalog) to build models into (lowercase only).",
+			},
+			{
+				name:        "s3_staging_dir",
+				kind:        stringField,
+				required:    true,
+				description: "S3 location to store Athena query results and metadata.",
+			},
+			{
+				name:        "work_group",
+				kind:        stringField,
+				nullable:    true,
+				description: "Identifier of Athena workgroup.",
+			},
+			{
+				name:        "spark_work_group",
+				kind:        stringField,
+				nullable:    true,
+				description: "Identifier of Athena Spark workgroup for running Python models.",
+			},
+			{
+				name:        "s3_data_dir",
+				kind:        stringField,
+				nullable:    true,
+				description: "Prefix for storing tables, if different from the connection's S3 staging directory.",
+			},
+			{
+				name:        "s3_data_naming",
+				kind:        stringField,
+				nullable:    true,
+				description: "How to generate table paths in the S3 data directory.",
+			},
+			{
+				name:        "s3_tmp_table_dir",
+				kind:        stringField,
+				nullable:    true,
+				description: "Prefix for storing temporary tables, if different from the connection's S3 data directory.",
+			},
+			{
+				name:        "poll_interval",
+				kind:        int64Field,
+				nullable:    true,
+				description: "Interval in seconds to use for polling the status of query results in Athena.",
+			},
+			{
+				name:        "num_retries",
+				kind:        int64Field,
+				nullable:    true,
+				description: "Number of times to retry a failing query.",
+			},
+			{
+				name:        "num_boto3_retries",
+				kind:        int64Field,
+				nullable:    true,
+				description: "Number of times to retry boto3 requests (e.g. deleting S3 files for materialized tables).",
+			},
+			{
+				name:        "num_iceberg_retries",
+				kind:        int64Field,
+				nullable:    true,
+				description: "Number of times to retry iceberg commit queries to fix ICEBERG_COMMIT_ERROR.",
+			},
+		},
+	},
+	// Careful, the nullable fields are handled differently for Spark vs all the other DWs. We need to send them as null on Create
+	{
+		name:        "apache_spark",
+		description: "Apache Spark connection configuration.",
+		config:      typedConfig[dbt_cloud.ApacheSparkConfig]{},
+		profileKeys: []string{"schema", "token", "server_side_parameters", "retry_all"},
+		fields: []adapterField{
+			{
+				name:        "method",
+				kind:        stringField,
+				required:    true,
+				oneOf:       []string{"http", "thrift"},
+				description: "Authentication method for the connection (http or thrift).",
+			},
+			{
+				name:        "host",
+				kind:        stringField,
+				required:    true,
+				description: "Hostname of the connection",
+			},
+			{
+				name:         "port",
+				kind:         int64Field,
+				defaultValue: int64(443),
+				description:  "Port for the connection. Default=443",
+			},
+			{
+				name:        "cluster",
+				kind:        stringField,
+				required:    true,
+				description: "Spark cluster for the connection",
+			},
+			{
+				name:         "connect_timeout",
+				kind:         int64Field,
+				defaultValue: int64(10),
+				description:  "Connection time out in seconds. Default=10",
+			},
+			{
+				name:         "connect_retries",
+				kind:         int64Field,
+				defaultValue: int64(0),
+				description:  "Connection retries. Default=0",
+			},
+			{
+				name:         "organization",
+				kind:         stringField,
+				nullable:     true,
+				nullOnCreate: true,
+				description:  "Organization ID",
+			},
+			{
+				name:         "user",
+				kind:         stringField,
+				nullable:     true,
+				nullOnCreate: true,
+				description:  "User",
+			},
+			{
+				name:         "auth",
+				kind:         stringField,
+				nullable:     true,
+				nullOnCreate: true,
+				description:  "Auth",
+			},
+		},
+	},
+	{
+		name:        "teradata",
+		description: "Teradata connection configuration.",
+		config:      typedConfig[dbt_cloud.TeradataConfig]{},
+		profileKeys: []string{"user", "password", "schema", "logmech", "database", "browser", "logdata"},
+		fields: []adapterField{
+			{
+				name:        "host",
+				kind:        stringField,
+				required:    true,
+				description: "The hostname of the Teradata server.",
+			},
+			{
+				name:         "port",
+				kind:         int64Field,
+				defaultValue: int64(1025),
+				description:  "The port to connect to for this connection. Default=1025",
+			},
+			{
+				name:         "tmode",
+				kind:         stringField,
+				defaultValue: "ANSI",
+				oneOf:        []string{"ANSI", "TERA"},
+				description:  "The transaction mode to use for the connection. Possible values are `ANSI` and `TERA`. Default=ANSI",
+			},
+			{
+				name:         "retries",
+				kind:         int64Field,
+				defaultValue: int64(1),
+				description:  "The number of automatic times to retry a query before failing. Defaults to 1.",
+			},
+			{
+				name:         "request_timeout",
+				kind:         int64Field,
+				defaultValue: int64(0),
+				description:  "The number of seconds used to wait for a query before failing. Defaults to 0, which means that the timeout is disabled or uses the default system settings.",
+			},
+		},
+	},
+}
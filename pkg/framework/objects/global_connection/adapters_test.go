@@ -0,0 +1,731 @@
+package global_connection
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/samber/lo"
+)
+
+func TestAdaptersRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, adapter := range adapters {
+		t.Run(adapter.name, func(t *testing.T) {
+			api := newFakeConnectionsAPI(t)
+			client := api.client()
+
+			// create with all the fields set
+			plan := GlobalConnectionResourceModel{
+				ID:                    types.Int64Unknown(),
+				AdapterVersion:        types.StringUnknown(),
+				Name:                  types.StringValue("connection"),
+				IsSshTunnelEnabled:    types.BoolUnknown(),
+				PrivateLinkEndpointId: types.StringNull(),
+				OauthConfigurationId:  types.Int64Null(),
+				Configs: map[string]map[string]attr.Value{
+					adapter.name: sampleConfig(adapter),
+				},
+			}
+
+			created, err := createGeneric(client, &plan)
+			if err != nil {
+				t.Fatalf("create: %s", err)
+			}
+			if created.AdapterVersion.ValueString() != adapter.defaultAdapterVersion() {
+				t.Errorf("adapter version: expected %s, got %s", adapter.defaultAdapterVersion(), created.AdapterVersion)
+			}
+
+			expectedCreateConfig := map[string]any{}
+			for key, value := range adapter.createOnlyConfig {
+				expectedCreateConfig[key] = value
+			}
+			for _, field := range adapter.fields {
+				expectedCreateConfig[field.apiKey()] = field.toAPI(sampleValue(field, 0))
+			}
+			assertJSONEqual(t, "create payload", expectedCreateConfig, api.lastRequest("POST", "/connections/").Body["config"])
+
+			// reading the connection after creating it must not show any drift
+			refreshed, action, err := readGeneric(client, copyModel(t, created), "")
+			if err != nil || action != "" {
+				t.Fatalf("read after create: %s %s", action, err)
+			}
+			assertModelsEqual(t, "read after create", created, refreshed)
+
+			// the data source reads the same config, except for the write only fields
+			dataSource, _, err := readGeneric(
+				client,
+				&GlobalConnectionResourceModel{ID: created.ID},
+				created.AdapterVersion.ValueString(),
+			)
+			if err != nil {
+				t.Fatalf("data source read: %s", err)
+			}
+			for _, field := range adapter.fields {
+				expected := created.Configs[adapter.name][field.name]
+				if field.writeOnly {
+					expected = field.kind.nullValue()
+				}
+				actual := dataSource.Configs[adapter.name][field.name]
+				if !expected.Equal(actual) {
+					t.Errorf("data source field %s: expected %s, got %s", field.name, expected, actual)
+				}
+			}
+
+			// update all the fields, removing the nullable ones
+			updatePlan := copyModel(t, created)
+			updatePlan.Name = types.StringValue("connection updated")
+			expectedPatchConfig := map[string]any{}
+			for _, field := range adapter.fields {
+				if field.nullable && !field.required {
+					updatePlan.Configs[adapter.name][field.name] = field.kind.nullValue()
+					expectedPatchConfig[field.apiKey()] = nil
+					continue
+				}
+				updatePlan.Configs[adapter.name][field.name] = sampleValue(field, 1)
+				// only the values that changed are sent
+				if !sampleValue(field, 1).Equal(sampleValue(field, 0)) {
+					expectedPatchConfig[field.apiKey()] = field.toAPI(sampleValue(field, 1))
+				}
+			}
+
+			updated, err := updateGeneric(client, updatePlan, copyModel(t, created))
+			if err != nil {
+				t.Fatalf("update: %s", err)
+			}
+			patch := api.lastRequest("PATCH", fmt.Sprintf("/connections/%d/", created.ID.ValueInt64()))
+			assertJSONEqual(t, "update payload", expectedPatchConfig, patch.Body["config"])
+			if patch.Body["name"] != "connection updated" {
+				t.Errorf("update payload: expected the new name, got %v", patch.Body["name"])
+			}
+
+			refreshed, _, err = readGeneric(client, copyModel(t, updated), "")
+			if err != nil {
+				t.Fatalf("read after update: %s", err)
+			}
+			assertModelsEqual(t, "read after update", updated, refreshed)
+
+			// delete
+			err = deleteGeneric(client, copyModel(t, updated))
+			if err != nil {
+				t.Fatalf("delete: %s", err)
+			}
+			_, action, err = readGeneric(client, copyModel(t, updated), "")
+			if err != nil || action != "removeFromState" {
+				t.Errorf("read after delete: expected removeFromState, got %q %v", action, err)
+			}
+			if adapter.supportsSSHTunnel() && len(api.activeEncryptions(created.ID.ValueInt64())) > 0 {
+				t.Errorf("the SSH tunnel was not deleted")
+			}
+		})
+	}
+}
+
+func TestAdaptersCreateWithRequiredFieldsOnly(t *testing.T) {
+	t.Parallel()
+
+	for _, adapter := range adapters {
+		t.Run(adapter.name, func(t *testing.T) {
+			api := newFakeConnectionsAPI(t)
+
+			config := adapter.emptyConfig()
+			config["adapter_version"] = types.StringUnknown()
+			expectedCreateConfig := map[string]any{}
+			for key, value := range adapter.createOnlyConfig {
+				expectedCreateConfig[key] = value
+			}
+			for _, field := range adapter.fields {
+				switch {
+				case field.required || field.defaultValue != nil:
+					config[field.name] = sampleValue(field, 0)
+					expectedCreateConfig[field.apiKey()] = field.toAPI(sampleValue(field, 0))
+				case field.nullOnCreate:
+					expectedCreateConfig[field.apiKey()] = nil
+				}
+			}
+
+			plan := GlobalConnectionResourceModel{
+				ID:                    types.Int64Unknown(),
+				AdapterVersion:        types.StringUnknown(),
+				Name:                  types.StringValue("connection"),
+				IsSshTunnelEnabled:    types.BoolUnknown(),
+				PrivateLinkEndpointId: types.StringNull(),
+				OauthConfigurationId:  types.Int64Null(),
+				Configs:               map[string]map[string]attr.Value{adapter.name: config},
+			}
+
+			created, err := createGeneric(api.client(), &plan)
+			if err != nil {
+				t.Fatalf("create: %s", err)
+			}
+			assertJSONEqual(t, "create payload", expectedCreateConfig, api.lastRequest("POST", "/connections/").Body["config"])
+
+			refreshed, _, err := readGeneric(api.client(), copyModel(t, created), "")
+			if err != nil {
+				t.Fatalf("read after create: %s", err)
+			}
+			assertModelsEqual(t, "read after create", created, refreshed)
+		})
+	}
+}
+
+func TestAdaptersMatchTypedConfigs(t *testing.T) {
+	t.Parallel()
+
+	for _, adapter := range adapters {
+		t.Run(adapter.name, func(t *testing.T) {
+			if adapter.adapterVersions()[0] != adapter.defaultAdapterVersion() {
+				t.Errorf("the default version %s is not the first one of %v", adapter.defaultAdapterVersion(), adapter.adapterVersions())
+			}
+
+			// all the fields of the registry exist in the typed config and keep their values
+			client := newFakeConnectionsAPI(t).client()
+			fields := adapter.createConfig(sampleConfig(adapter))
+			created, err := adapter.config.create(
+				client,
+				dbt_cloud.GlobalConnectionCommon{Name: lo.ToPtr("connection")},
+				fields,
+			)
+			if err != nil {
+				t.Fatalf("create: %s", err)
+			}
+			_, apiFields, err := adapter.config.get(client, *created.ID)
+			if err != nil {
+				t.Fatalf("get: %s", err)
+			}
+			// the write only fields are not returned by the API
+			expectedFields := lo.OmitBy(fields, func(key string, _ any) bool {
+				return lo.ContainsBy(adapter.fields, func(field adapterField) bool {
+					return field.writeOnly && field.apiKey() == key
+				})
+			})
+			assertJSONEqual(t, "typed config", expectedFields, apiFields)
+		})
+	}
+
+	_, err := configFromFields[dbt_cloud.TeradataConfig](map[string]any{"host": "teradata.example.com", "hostname": "x"})
+	if err == nil {
+		t.Errorf("expected an error for a field missing from the typed config")
+	}
+}
+
+func TestSSHTunnelUpdates(t *testing.T) {
+	t.Parallel()
+
+	adapter := lo.Must(lo.Find(adapters, func(adapter adapterDefinition) bool {
+		return adapter.name == "redshift"
+	}))
+	api := newFakeConnectionsAPI(t)
+	client := api.client()
+
+	plan := GlobalConnectionResourceModel{
+		ID:                    types.Int64Unknown(),
+		AdapterVersion:        types.StringUnknown(),
+		Name:                  types.StringValue("connection"),
+		IsSshTunnelEnabled:    types.BoolUnknown(),
+		PrivateLinkEndpointId: types.StringNull(),
+		OauthConfigurationId:  types.Int64Null(),
+		Configs:               map[string]map[string]attr.Value{adapter.name: sampleConfig(adapter)},
+	}
+	state, err := createGeneric(client, &plan)
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	sshTunnel := getSSHTunnel(state.Configs[adapter.name])
+
+	// only the name changes, the computed values of the tunnel are unknown in the plan
+	updatePlan := copyModel(t, state)
+	updatePlan.Name = types.StringValue("connection renamed")
+	updatePlan.Configs[adapter.name]["ssh_tunnel"] = sshTunnelValue(&SSHTunnelConfig{
+		ID:        types.Int64Unknown(),
+		Username:  sshTunnel.Username,
+		Port:      sshTunnel.Port,
+		HostName:  sshTunnel.HostName,
+		PublicKey: types.StringUnknown(),
+	})
+	encryptionRequests := api.countRequests("POST", "/encryptions/")
+
+	state, err = updateGeneric(client, updatePlan, copyModel(t, state))
+	if err != nil {
+		t.Fatalf("update name: %s", err)
+	}
+	if api.countRequests("POST", "/encryptions/") != encryptionRequests {
+		t.Errorf("the SSH tunnel was updated while it didn't change")
+	}
+	if !reflect.DeepEqual(getSSHTunnel(state.Configs[adapter.name]), sshTunnel) {
+		t.Errorf("the SSH tunnel was not kept from the state: %+v", getSSHTunnel(state.Configs[adapter.name]))
+	}
+	if api.lastRequest("PATCH", "/connections/").Body["name"] != "connection renamed" {
+		t.Errorf("the new name was not sent to the API")
+	}
+
+	// the port of the tunnel changes
+	updatePlan = copyModel(t, state)
+	updatePlan.Configs[adapter.name]["ssh_tunnel"] = sshTunnelValue(&SSHTunnelConfig{
+		ID:        types.Int64Unknown(),
+		Username:  sshTunnel.Username,
+		Port:      types.Int64Value(2222),
+		HostName:  sshTunnel.HostName,
+		PublicKey: types.StringUnknown(),
+	})
+	state, err = updateGeneric(client, updatePlan, copyModel(t, state))
+	if err != nil {
+		t.Fatalf("update SSH tunnel: %s", err)
+	}
+	updatedSSHTunnel := getSSHTunnel(state.Configs[adapter.name])
+	if updatedSSHTunnel.Port.ValueInt64() != 2222 || !updatedSSHTunnel.ID.Equal(sshTunnel.ID) {
+		t.Errorf("the SSH tunnel was not updated in place: %+v", updatedSSHTunnel)
+	}
+
+	// the tunnel is removed
+	updatePlan = copyModel(t, state)
+	updatePlan.Configs[adapter.name]["ssh_tunnel"] = sshTunnelValue(nil)
+	state, err = updateGeneric(client, updatePlan, copyModel(t, state))
+	if err != nil {
+		t.Fatalf("remove SSH tunnel: %s", err)
+	}
+	if len(api.activeEncryptions(state.ID.ValueInt64())) > 0 {
+		t.Errorf("the SSH tunnel was not deleted")
+	}
+
+	refreshed, _, err := readGeneric(client, copyModel(t, state), "")
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	assertModelsEqual(t, "read after removing the SSH tunnel", state, refreshed)
+}
+
+func TestValidateOnApply(t *testing.T) {
+	t.Parallel()
+
+	adapter := lo.Must(lo.Find(adapters, func(adapter adapterDefinition) bool {
+		return adapter.name == "snowflake"
+	}))
+	api := newFakeConnectionsAPI(t)
+	r := &globalConnectionResource{client: api.client()}
+
+	plan := GlobalConnectionResourceModel{
+		ID:                    types.Int64Unknown(),
+		AdapterVersion:        types.StringUnknown(),
+		Name:                  types.StringValue("connection"),
+		IsSshTunnelEnabled:    types.BoolUnknown(),
+		PrivateLinkEndpointId: types.StringNull(),
+		OauthConfigurationId:  types.Int64Null(),
+		ValidateOnApply:       types.BoolValue(true),
+		Configs:               map[string]map[string]attr.Value{adapter.name: sampleConfig(adapter)},
+	}
+	state, err := createGeneric(r.client, &plan)
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	connectionID := state.ID.ValueInt64()
+
+	diags := r.testConnection(connectionID)
+	if diags.HasError() {
+		t.Fatalf("successful test: unexpected diagnostics %v", diags)
+	}
+	testRequest := api.lastRequest("POST", "/test/")
+	if !strings.Contains(testRequest.Path, fmt.Sprintf("/connections/%d/test/", connectionID)) {
+		t.Errorf("the test was sent to %s", testRequest.Path)
+	}
+	assertJSONEqual(t, "test without credentials", map[string]any{}, testRequest.Body)
+
+	api.connectionTestFailures[connectionID] = "250001: Could not connect to Snowflake backend"
+	diags = r.testConnection(connectionID)
+	if !diags.HasError() {
+		t.Fatalf("failed test: expected an error")
+	}
+	if !strings.Contains(diags.Errors()[0].Detail(), "Could not connect to Snowflake backend") {
+		t.Errorf("failed test: the adapter error is missing from %q", diags.Errors()[0].Detail())
+	}
+}
+
+func TestGlobalConnectionModelMatchesSchemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	resourceSchema := resource.SchemaResponse{}
+	(&globalConnectionResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	dataSourceSchema := datasource.SchemaResponse{}
+	(&globalConnectionDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &dataSourceSchema)
+
+	for _, adapter := range adapters {
+		model := &GlobalConnectionResourceModel{
+			ID:                    types.Int64Value(1),
+			AdapterVersion:        types.StringValue(adapter.defaultAdapterVersion()),
+			Name:                  types.StringValue("connection"),
+			IsSshTunnelEnabled:    types.BoolValue(false),
+			PrivateLinkEndpointId: types.StringNull(),
+			OauthConfigurationId:  types.Int64Null(),
+			Configs:               map[string]map[string]attr.Value{adapter.name: adapter.emptyConfig()},
+		}
+
+		states := map[string]tfsdk.State{
+			"resource": {
+				Schema: resourceSchema.Schema,
+				Raw:    tftypes.NewValue(resourceSchema.Schema.Type().TerraformType(ctx), nil),
+			},
+			"data source": {
+				Schema: dataSourceSchema.Schema,
+				Raw:    tftypes.NewValue(dataSourceSchema.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		for schemaName, state := range states {
+			diags := diag.Diagnostics{}
+			setGlobalConnectionState(ctx, &state, model, &diags)
+			if diags.HasError() {
+				t.Fatalf("%s %s: setting the state: %v", adapter.name, schemaName, diags)
+			}
+			readModel, diags := getGlobalConnectionModel(ctx, state)
+			if diags.HasError() {
+				t.Fatalf("%s %s: getting the state: %v", adapter.name, schemaName, diags)
+			}
+			assertModelsEqual(t, adapter.name+" "+schemaName, model, &readModel)
+		}
+	}
+}
+
+// sampleValue returns a value for the field, different for each variant
+func sampleValue(field adapterField, variant int) attr.Value {
+	switch field.kind {
+	case int64Field:
+		return types.Int64Value(int64(10 + variant))
+	case boolField:
+		return types.BoolValue(variant%2 == 0)
+	case stringSetField:
+		return types.SetValueMust(types.StringType, stringSetElements([]string{
+			fmt.Sprintf("%s-%d-a", field.name, variant),
+			fmt.Sprintf("%s-%d-b", field.name, variant),
+		}))
+	default:
+		if len(field.oneOf) > 0 {
+			return types.StringValue(field.oneOf[variant%len(field.oneOf)])
+		}
+		return types.StringValue(fmt.Sprintf("%s-%d", field.name, variant))
+	}
+}
+
+// sampleConfig returns a planned config block with all the fields set
+func sampleConfig(adapter adapterDefinition) map[string]attr.Value {
+	config := adapter.emptyConfig()
+	config["adapter_version"] = types.StringUnknown()
+	for _, field := range adapter.fields {
+		config[field.name] = sampleValue(field, 0)
+	}
+	if adapter.supportsSSHTunnel() {
+		config["ssh_tunnel"] = sshTunnelValue(&SSHTunnelConfig{
+			ID:        types.Int64Unknown(),
+			Username:  types.StringValue("ssh-user"),
+			Port:      types.Int64Value(22),
+			HostName:  types.StringValue("ssh.example.com"),
+			PublicKey: types.StringUnknown(),
+		})
+	}
+	return config
+}
+
+func copyModel(t *testing.T, model *GlobalConnectionResourceModel) *GlobalConnectionResourceModel {
+	t.Helper()
+	object, diags := model.toObject()
+	if diags.HasError() {
+		t.Fatalf("converting the model: %v", diags)
+	}
+	modelCopy := globalConnectionModelFromObject(object)
+	return &modelCopy
+}
+
+func assertModelsEqual(t *testing.T, step string, expected, actual *GlobalConnectionResourceModel) {
+	t.Helper()
+	expectedObject, diags := expected.toObject()
+	if diags.HasError() {
+		t.Fatalf("%s: converting the expected model: %v", step, diags)
+	}
+	actualObject, diags := actual.toObject()
+	if diags.HasError() {
+		t.Fatalf("%s: converting the actual model: %v", step, diags)
+	}
+	if !expectedObject.Equal(actualObject) {
+		t.Errorf("%s:\nexpected %s\ngot      %s", step, expectedObject, actualObject)
+	}
+}
+
+// assertJSONEqual compares values after a JSON round trip, like the fake API receives them
+func assertJSONEqual(t *testing.T, step string, expected any, actual any) {
+	t.Helper()
+	if !reflect.DeepEqual(jsonRoundTrip(t, expected), jsonRoundTrip(t, actual)) {
+		t.Errorf("%s:\nexpected %v\ngot      %v", step, jsonRoundTrip(t, expected), jsonRoundTrip(t, actual))
+	}
+}
+
+func jsonRoundTrip(t *testing.T, value any) any {
+	t.Helper()
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("marshalling %v: %s", value, err)
+	}
+	var result any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		t.Fatalf("unmarshalling %s: %s", data, err)
+	}
+	return result
+}
+
+type fakeRequest struct {
+	Method string
+	Path   string
+	Body   map[string]any
+}
+
+// fakeConnectionsAPI implements the connections and encryptions endpoints of dbt Cloud in memory
+// like the real API, it never returns the write only fields of the connections
+type fakeConnectionsAPI struct {
+	t           *testing.T
+	server      *httptest.Server
+	mu          sync.Mutex
+	lastID      int64
+	connections map[int64]map[string]any
+	encryptions map[int64]map[string]any
+	// connectionTestFailures contains the adapter error returned when testing a connection
+	connectionTestFailures map[int64]string
+	requests               []fakeRequest
+}
+
+const fakeAccountID = 1
+
+func newFakeConnectionsAPI(t *testing.T) *fakeConnectionsAPI {
+	api := &fakeConnectionsAPI{
+		t:           t,
+		connections: map[int64]map[string]any{},
+		encryptions: map[int64]map[string]any{},
+
+		connectionTestFailures: map[int64]string{},
+	}
+	api.server = httptest.NewServer(http.HandlerFunc(api.handle))
+	t.Cleanup(api.server.Close)
+	return api
+}
+
+func (api *fakeConnectionsAPI) client() *dbt_cloud.Client {
+	return &dbt_cloud.Client{
+		HostURL:    api.server.URL,
+		HTTPClient: api.server.Client(),
+		Token:      "token",
+		AccountID:  fakeAccountID,
+	}
+}
+
+func (api *fakeConnectionsAPI) lastRequest(method string, pathPart string) fakeRequest {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	for i := len(api.requests) - 1; i >= 0; i-- {
+		if api.requests[i].Method == method && strings.Contains(api.requests[i].Path, pathPart) {
+			return api.requests[i]
+		}
+	}
+	api.t.Fatalf("no %s request to %s", method, pathPart)
+	return fakeRequest{}
+}
+
+func (api *fakeConnectionsAPI) countRequests(method string, pathPart string) int {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return lo.CountBy(api.requests, func(request fakeRequest) bool {
+		return request.Method == method && strings.Contains(request.Path, pathPart)
+	})
+}
+
+func (api *fakeConnectionsAPI) activeEncryptions(connectionID int64) []map[string]any {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return api.encryptionsForConnection(connectionID)
+}
+
+func (api *fakeConnectionsAPI) encryptionsForConnection(connectionID int64) []map[string]any {
+	result := []map[string]any{}
+	for _, id := range lo.Keys(api.encryptions) {
+		encryption := api.encryptions[id]
+		if jsonInt(encryption["connection_id"]) == connectionID &&
+			jsonInt(encryption["state"]) != dbt_cloud.STATE_DELETED {
+			result = append(result, encryption)
+		}
+	}
+	return result
+}
+
+func (api *fakeConnectionsAPI) handle(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	var body map[string]any
+	data, _ := io.ReadAll(r.Body)
+	if len(data) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	api.requests = append(api.requests, fakeRequest{Method: r.Method, Path: r.URL.Path, Body: body})
+
+	connectionsPath := fmt.Sprintf("/v3/accounts/%d/connections/", fakeAccountID)
+	encryptionsPath := fmt.Sprintf("/v2/accounts/%d/encryptions/", fakeAccountID)
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, connectionsPath) && strings.HasSuffix(r.URL.Path, "/test/"):
+		id, _ := strconv.ParseInt(strings.Trim(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, connectionsPath), "/test/"), "/"), 10, 64)
+		if _, ok := api.connections[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		task := map[string]any{"id": fmt.Sprintf("task-%d", id), "connection_id": id, "state": dbt_cloud.CONNECTION_TEST_STATE_SUCCESS}
+		if message, ok := api.connectionTestFailures[id]; ok {
+			task["state"] = dbt_cloud.CONNECTION_TEST_STATE_FAILURE
+			task["message"] = message
+		}
+		api.respond(w, http.StatusOK, task)
+
+	case r.URL.Path == connectionsPath && r.Method == "POST":
+		api.lastID++
+		connection := map[string]any{
+			"private_link_endpoint_id": nil,
+			"oauth_configuration_id":   nil,
+			"is_ssh_tunnel_enabled":    false,
+		}
+		for key, value := range body {
+			connection[key] = value
+		}
+		connection["id"] = api.lastID
+		api.connections[api.lastID] = connection
+		api.respond(w, http.StatusCreated, api.visibleConnection(connection))
+
+	case strings.HasPrefix(r.URL.Path, connectionsPath):
+		id, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(r.URL.Path, connectionsPath), "/"), 10, 64)
+		connection, ok := api.connections[id]
+		if err != nil || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status": {"code": 404, "is_success": false}}`))
+			return
+		}
+		switch r.Method {
+		case "GET":
+			api.respond(w, http.StatusOK, api.visibleConnection(connection))
+		case "PATCH":
+			for key, value := range body {
+				if key != "config" {
+					connection[key] = value
+					continue
+				}
+				config := connection["config"].(map[string]any)
+				for configKey, configValue := range value.(map[string]any) {
+					config[configKey] = configValue
+				}
+			}
+			api.respond(w, http.StatusOK, api.visibleConnection(connection))
+		case "DELETE":
+			delete(api.connections, id)
+			api.respond(w, http.StatusOK, connection)
+		}
+
+	case r.URL.Path == encryptionsPath && r.Method == "GET":
+		connectionID, _ := strconv.ParseInt(r.URL.Query().Get("connection_id"), 10, 64)
+		api.respond(w, http.StatusOK, api.encryptionsForConnection(connectionID))
+
+	case r.URL.Path == encryptionsPath && r.Method == "POST":
+		api.lastID++
+		body["id"] = api.lastID
+		body["state"] = dbt_cloud.STATE_ACTIVE
+		body["public_key"] = fmt.Sprintf("ssh-rsa key-%d", api.lastID)
+		api.encryptions[api.lastID] = body
+		api.respond(w, http.StatusCreated, body)
+
+	case strings.HasPrefix(r.URL.Path, encryptionsPath) && r.Method == "POST":
+		id, _ := strconv.ParseInt(strings.Trim(strings.TrimPrefix(r.URL.Path, encryptionsPath), "/"), 10, 64)
+		encryption, ok := api.encryptions[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for key, value := range body {
+			encryption[key] = value
+		}
+		if jsonInt(encryption["state"]) == 0 {
+			encryption["state"] = dbt_cloud.STATE_ACTIVE
+		}
+		api.respond(w, http.StatusOK, encryption)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// visibleConnection removes the write only fields, as the API never returns them
+func (api *fakeConnectionsAPI) visibleConnection(connection map[string]any) map[string]any {
+	adapter, ok := adapterForVersion(fmt.Sprint(connection["adapter_version"]))
+	if !ok {
+		api.t.Errorf("unknown adapter version %v", connection["adapter_version"])
+		return connection
+	}
+	writeOnlyKeys := lo.FilterMap(adapter.fields, func(field adapterField, _ int) (string, bool) {
+		return field.apiKey(), field.writeOnly
+	})
+
+	visible := map[string]any{}
+	for key, value := range connection {
+		visible[key] = value
+	}
+	visibleConfig := map[string]any{}
+	for key, value := range connection["config"].(map[string]any) {
+		if !lo.Contains(writeOnlyKeys, key) {
+			visibleConfig[key] = value
+		}
+	}
+	visible["config"] = visibleConfig
+	return visible
+}
+
+func (api *fakeConnectionsAPI) respond(w http.ResponseWriter, status int, data any) {
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(map[string]any{
+		"status": map[string]any{"code": status, "is_success": true},
+		"data":   data,
+	})
+	if err != nil {
+		api.t.Errorf("encoding the response: %s", err)
+	}
+}
+
+func jsonInt(value any) int64 {
+	switch typedValue := value.(type) {
+	case json.Number:
+		intValue, _ := typedValue.Int64()
+		return intValue
+	case int64:
+		return typedValue
+	case int:
+		return int64(typedValue)
+	default:
+		return 0
+	}
+}
@@ -1,678 +1,617 @@
 package global_connection
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
 )
 
-func readGeneric(
-	client *dbt_cloud.Client,
-	state *GlobalConnectionResourceModel,
-	adapter string,
-) (*GlobalConnectionResourceModel, string, error) {
-
-	connectionID := state.ID.ValueInt64()
-
-	switch {
-	case state.SnowflakeConfig != nil || strings.HasPrefix(adapter, "snowflake_"):
-		// in case we use it for a datasource, we need to set the Config to not be nil
-		if state.SnowflakeConfig == nil {
-			state.SnowflakeConfig = &SnowflakeConfig{}
-		}
-
-		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.SnowflakeConfig](client)
-
-		common, snowflakeCfg, err := c.Get(connectionID)
-		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
-				return nil, "removeFromState", nil
-			}
-			return nil, "", err
-		}
-
-		// global settings
-		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(snowflakeCfg.AdapterVersion())
-		state.Name = types.StringPointerValue(common.Name)
-		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
-
-		// nullable common fields
-		if !common.PrivateLinkEndpointId.IsNull() {
-			state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
-		} else {
-			state.PrivateLinkEndpointId = types.StringNull()
-		}
-		if !common.OauthConfigurationId.IsNull() {
-			state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
-		} else {
-			state.OauthConfigurationId = types.Int64Null()
-		}
-
-		// snowflake settings
-		state.SnowflakeConfig.Account = types.StringPointerValue(snowflakeCfg.Account)
-		state.SnowflakeConfig.Database = types.StringPointerValue(snowflakeCfg.Database)
-		state.SnowflakeConfig.Warehouse = types.StringPointerValue(snowflakeCfg.Warehouse)
-		state.SnowflakeConfig.ClientSessionKeepAlive = types.BoolPointerValue(
-			snowflakeCfg.ClientSessionKeepAlive,
-		)
-		state.SnowflakeConfig.AllowSso = types.BoolPointerValue(snowflakeCfg.AllowSso)
-
-		// nullable optional fields
-		// TODO: decide if it is better to read it as string, *string or nullable.Nullable[string] on the dbt_cloud side
-		// in this case role can never be empty so this works but we might have cases where null and empty are different
-		if !snowflakeCfg.Role.IsNull() {
-			state.SnowflakeConfig.Role = types.StringValue(snowflakeCfg.Role.MustGet())
-		} else {
-			state.SnowflakeConfig.Role = types.StringNull()
-		}
+// adapterConfig sends the fields of an adapter to the API using the typed config of the adapter from pkg/dbt_cloud
+type adapterConfig interface {
+	adapterVersions() []string
+	defaultAdapterVersion() string
+	get(client *dbt_cloud.Client, connectionID int64) (*dbt_cloud.GlobalConnectionCommon, map[string]any, error)
+	create(
+		client *dbt_cloud.Client,
+		common dbt_cloud.GlobalConnectionCommon,
+		fields map[string]any,
+	) (*dbt_cloud.GlobalConnectionCommon, error)
+	update(
+		client *dbt_cloud.Client,
+		connectionID int64,
+		common dbt_cloud.GlobalConnectionCommon,
+		fields map[string]any,
+	) (*dbt_cloud.GlobalConnectionCommon, error)
+}
 
-		// We don't set the sensitive fields when we read because those are secret and never returned by the API
-		// sensitive fields: OauthClientID, OauthClientSecret
+// typedConfig maps the fields of the registry, keyed by their API name, onto the config T
+type typedConfig[T dbt_cloud.GlobalConnectionConfig] struct{}
 
-	case state.BigQueryConfig != nil || strings.HasPrefix(adapter, "bigquery_"):
-		// in case we use it for a datasource, we need to set the Config to not be nil
-		if state.BigQueryConfig == nil {
-			state.BigQueryConfig = &BigQueryConfig{}
-		}
+func (typedConfig[T]) adapterVersions() []string {
+	var config T
+	return config.AdapterVersions()
+}
 
-		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.BigQueryConfig](client)
+func (typedConfig[T]) defaultAdapterVersion() string {
+	var config T
+	return config.AdapterVersion()
+}
 
-		common, bigqueryCfg, err := c.Get(connectionID)
-		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
-				return nil, "removeFromState", nil
-			}
-			return nil, "", err
-		}
+func (typedConfig[T]) get(
+	client *dbt_cloud.Client,
+	connectionID int64,
+) (*dbt_cloud.GlobalConnectionCommon, map[string]any, error) {
+	c := dbt_cloud.NewGlobalConnectionClient[T](client)
+	common, config, err := c.Get(connectionID)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		// global settings
-		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(bigqueryCfg.AdapterVersion())
-		state.Name = types.StringPointerValue(common.Name)
-		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
+	fields, err := fieldsFromConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return common, fields, nil
+}
 
-		// nullable common fields
-		if !common.PrivateLinkEndpointId.IsNull() {
-			state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
-		} else {
-			state.PrivateLinkEndpointId = types.StringNull()
-		}
-		if !common.OauthConfigurationId.IsNull() {
-			state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
-		} else {
-			state.OauthConfigurationId = types.Int64Null()
-		}
+func (typedConfig[T]) create(
+	client *dbt_cloud.Client,
+	common dbt_cloud.GlobalConnectionCommon,
+	fields map[string]any,
+) (*dbt_cloud.GlobalConnectionCommon, error) {
+	config, err := configFromFields[T](fields)
+	if err != nil {
+		return nil, err
+	}
 
-		// BigQuery settings
-		state.BigQueryConfig.GCPProjectID = types.StringPointerValue(bigqueryCfg.ProjectID)
-		state.BigQueryConfig.TimeoutSeconds = types.Int64PointerValue(bigqueryCfg.TimeoutSeconds)
-		state.BigQueryConfig.PrivateKeyID = types.StringPointerValue(bigqueryCfg.PrivateKeyID)
-		state.BigQueryConfig.ClientEmail = types.StringPointerValue(bigqueryCfg.ClientEmail)
-		state.BigQueryConfig.ClientID = types.StringPointerValue(bigqueryCfg.ClientID)
-		state.BigQueryConfig.AuthURI = types.StringPointerValue(bigqueryCfg.AuthURI)
-		state.BigQueryConfig.TokenURI = types.StringPointerValue(bigqueryCfg.TokenURI)
-		state.BigQueryConfig.AuthProviderX509CertURL = types.StringPointerValue(
-			bigqueryCfg.AuthProviderX509CertURL,
-		)
-		state.BigQueryConfig.ClientX509CertURL = types.StringPointerValue(
-			bigqueryCfg.ClientX509CertURL,
-		)
-		state.BigQueryConfig.Retries = types.Int64PointerValue(bigqueryCfg.Retries)
-		state.BigQueryConfig.Scopes = helper.SliceStringToSliceTypesString(bigqueryCfg.Scopes)
+	c := dbt_cloud.NewGlobalConnectionClient[T](client)
+	commonResp, _, err := c.Create(common, config)
+	return commonResp, err
+}
 
-		// nullable optional fields
-		if !bigqueryCfg.Priority.IsNull() {
-			state.BigQueryConfig.Priority = types.StringValue(bigqueryCfg.Priority.MustGet())
-		} else {
-			state.BigQueryConfig.Priority = types.StringNull()
-		}
+func (typedConfig[T]) update(
+	client *dbt_cloud.Client,
+	connectionID int64,
+	common dbt_cloud.GlobalConnectionCommon,
+	fields map[string]any,
+) (*dbt_cloud.GlobalConnectionCommon, error) {
+	config, err := configFromFields[T](fields)
+	if err != nil {
+		return nil, err
+	}
 
-		if !bigqueryCfg.Location.IsNull() {
-			state.BigQueryConfig.Location = types.StringValue(bigqueryCfg.Location.MustGet())
-		} else {
-			state.BigQueryConfig.Location = types.StringNull()
-		}
+	c := dbt_cloud.NewGlobalConnectionClient[T](client)
+	commonResp, _, err := c.Update(connectionID, common, config)
+	return commonResp, err
+}
 
-		if !bigqueryCfg.MaximumBytesBilled.IsNull() {
-			state.BigQueryConfig.MaximumBytesBilled = types.Int64Value(
-				bigqueryCfg.MaximumBytesBilled.MustGet(),
-			)
-		} else {
-			state.BigQueryConfig.MaximumBytesBilled = types.Int64Null()
-		}
+// configFromFields returns the typed config with the fields set, a field set to nil is sent as null if it is nullable
+// in the typed config, and a field missing from the typed config is an error
+func configFromFields[T dbt_cloud.GlobalConnectionConfig](fields map[string]any) (T, error) {
+	var config T
 
-		if !bigqueryCfg.ExecutionProject.IsNull() {
-			state.BigQueryConfig.ExecutionProject = types.StringValue(
-				bigqueryCfg.ExecutionProject.MustGet(),
-			)
-		} else {
-			state.BigQueryConfig.ExecutionProject = types.StringNull()
-		}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return config, err
+	}
 
-		if !bigqueryCfg.ImpersonateServiceAccount.IsNull() {
-			state.BigQueryConfig.ImpersonateServiceAccount = types.StringValue(
-				bigqueryCfg.ImpersonateServiceAccount.MustGet(),
-			)
-		} else {
-			state.BigQueryConfig.ImpersonateServiceAccount = types.StringNull()
-		}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return config, fmt.Errorf("the config of %s doesn't match the adapter: %w", config.AdapterVersion(), err)
+	}
+	return config, nil
+}
 
-		if !bigqueryCfg.JobRetryDeadlineSeconds.IsNull() {
-			state.BigQueryConfig.JobRetryDeadlineSeconds = types.Int64Value(
-				bigqueryCfg.JobRetryDeadlineSeconds.MustGet(),
-			)
-		} else {
-			state.BigQueryConfig.JobRetryDeadlineSeconds = types.Int64Null()
-		}
+// fieldsFromConfig returns the fields of the typed config, with the numbers as json.Number
+func fieldsFromConfig[T dbt_cloud.GlobalConnectionConfig](config *T) (map[string]any, error) {
+	fields := map[string]any{}
+	if config == nil {
+		return fields, nil
+	}
 
-		if !bigqueryCfg.JobCreationTimeoutSeconds.IsNull() {
-			state.BigQueryConfig.JobCreationTimeoutSeconds = types.Int64Value(
-				bigqueryCfg.JobCreationTimeoutSeconds.MustGet(),
-			)
-		} else {
-			state.BigQueryConfig.JobCreationTimeoutSeconds = types.Int64Null()
-		}
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
 
-		if !bigqueryCfg.GcsBucket.IsNull() {
-			state.BigQueryConfig.GcsBucket = types.StringValue(bigqueryCfg.GcsBucket.MustGet())
-		} else {
-			state.BigQueryConfig.GcsBucket = types.StringNull()
-		}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
 
-		if !bigqueryCfg.DataprocRegion.IsNull() {
-			state.BigQueryConfig.DataprocRegion = types.StringValue(
-				bigqueryCfg.DataprocRegion.MustGet(),
-			)
-		} else {
-			state.BigQueryConfig.DataprocRegion = types.StringNull()
-		}
+func stringSetElements(values []string) []attr.Value {
+	return lo.Map(values, func(value string, _ int) attr.Value {
+		return types.StringValue(value)
+	})
+}
 
-		if !bigqueryCfg.DataprocClusterName.IsNull() {
-			state.BigQueryConfig.DataprocClusterName = types.StringValue(
-				bigqueryCfg.DataprocClusterName.MustGet(),
-			)
-		} else {
-			state.BigQueryConfig.DataprocClusterName = types.StringNull()
-		}
+// toAPI converts a known Terraform value to the value sent in the API config
+func (f adapterField) toAPI(value attr.Value) any {
+	switch f.kind {
+	case int64Field:
+		return value.(types.Int64).ValueInt64()
+	case boolField:
+		return value.(types.Bool).ValueBool()
+	case stringSetField:
+		return helper.StringSetToStringSlice(value.(types.Set))
+	default:
+		return value.(types.String).ValueString()
+	}
+}
 
-		// We don't set the sensitive fields when we read because those are secret and never returned by the API
-		// sensitive fields: ApplicationID, ApplicationSecret, PrivateKey
+// fromAPI converts a value of the API config to a Terraform value, missing and null values are returned as null
+func (f adapterField) fromAPI(value any) (attr.Value, error) {
+	if value == nil {
+		return f.kind.nullValue(), nil
+	}
 
-	case state.DatabricksConfig != nil || strings.HasPrefix(adapter, "databricks_"):
-		// in case we use it for a datasource, we need to set the Config to not be nil
-		if state.DatabricksConfig == nil {
-			state.DatabricksConfig = &DatabricksConfig{}
+	switch f.kind {
+	case int64Field:
+		number, ok := value.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("the field %s is not a number: %v", f.apiKey(), value)
 		}
-
-		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.DatabricksConfig](client)
-
-		common, databricksCfg, err := c.Get(connectionID)
+		intValue, err := number.Int64()
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
-				return nil, "removeFromState", nil
+			return nil, fmt.Errorf("the field %s is not an integer: %v", f.apiKey(), value)
+		}
+		return types.Int64Value(intValue), nil
+	case boolField:
+		boolValue, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("the field %s is not a boolean: %v", f.apiKey(), value)
+		}
+		return types.BoolValue(boolValue), nil
+	case stringSetField:
+		list, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("the field %s is not a list: %v", f.apiKey(), value)
+		}
+		values := []string{}
+		for _, element := range list {
+			stringValue, ok := element.(string)
+			if !ok {
+				return nil, fmt.Errorf("the field %s is not a list of strings: %v", f.apiKey(), value)
 			}
-			return nil, "", err
-		}
-
-		// global settings
-		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(databricksCfg.AdapterVersion())
-		state.Name = types.StringPointerValue(common.Name)
-		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
-
-		// nullable common fields
-		if !common.PrivateLinkEndpointId.IsNull() {
-			state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
-		} else {
-			state.PrivateLinkEndpointId = types.StringNull()
+			values = append(values, stringValue)
 		}
-		if !common.OauthConfigurationId.IsNull() {
-			state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
-		} else {
-			state.OauthConfigurationId = types.Int64Null()
+		return types.SetValueMust(types.StringType, stringSetElements(values)), nil
+	default:
+		stringValue, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("the field %s is not a string: %v", f.apiKey(), value)
 		}
+		return types.StringValue(stringValue), nil
+	}
+}
 
-		// Databricks settings
-		state.DatabricksConfig.Host = types.StringPointerValue(databricksCfg.Host)
-		state.DatabricksConfig.HTTPPath = types.StringPointerValue(databricksCfg.HTTPPath)
+// createConfig returns the API config to create a connection from the planned config block
+func (a adapterDefinition) createConfig(config map[string]attr.Value) map[string]any {
+	fields := map[string]any{}
+	for key, value := range a.createOnlyConfig {
+		fields[key] = value
+	}
 
-		// nullable optional fields
-		if !databricksCfg.Catalog.IsNull() {
-			state.DatabricksConfig.Catalog = types.StringValue(databricksCfg.Catalog.MustGet())
-		} else {
-			state.DatabricksConfig.Catalog = types.StringNull()
+	for _, field := range a.fields {
+		value := config[field.name]
+		if value.IsNull() || value.IsUnknown() {
+			if field.nullOnCreate {
+				fields[field.apiKey()] = nil
+			}
+			continue
 		}
+		fields[field.apiKey()] = field.toAPI(value)
+	}
 
-		// We don't set the sensitive fields when we read because those are secret and never returned by the API
-		// sensitive fields: ClientID, ClientSecret
+	return fields
+}
 
-	case state.RedshiftConfig != nil || strings.HasPrefix(adapter, "redshift_"):
-		// in case we use it for a datasource, we need to set the Config to not be nil
-		if state.RedshiftConfig == nil {
-			state.RedshiftConfig = &RedshiftConfig{}
+// patchConfig returns the API config with only the fields that changed between the state and the plan
+func (a adapterDefinition) patchConfig(
+	planConfig map[string]attr.Value,
+	stateConfig map[string]attr.Value,
+) map[string]any {
+	fields := map[string]any{}
+
+	for _, field := range a.fields {
+		planValue := planConfig[field.name]
+		if planValue.Equal(stateConfig[field.name]) || planValue.IsUnknown() {
+			continue
+		}
+		if planValue.IsNull() {
+			// when the values are Null, we still want to send it as null to the PATCH payload, to remove it, otherwise the omitempty doesn't add it to the payload and it doesn't get updated
+			if field.nullable {
+				fields[field.apiKey()] = nil
+			}
+			continue
 		}
+		fields[field.apiKey()] = field.toAPI(planValue)
+	}
 
-		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.RedshiftConfig](client)
+	return fields
+}
 
-		common, redshiftCfg, err := c.Get(connectionID)
-		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
-				return nil, "removeFromState", nil
+// configFromAPI returns the attributes of the config block from the API config
+// the write only fields are taken from the previous config, as they are never returned by the API
+func (a adapterDefinition) configFromAPI(
+	apiConfig map[string]any,
+	previousConfig map[string]attr.Value,
+) (map[string]attr.Value, error) {
+	config := a.emptyConfig()
+
+	for _, field := range a.fields {
+		if field.writeOnly {
+			if previousValue, ok := previousConfig[field.name]; ok {
+				config[field.name] = previousValue
 			}
-			return nil, "", err
+			continue
 		}
 
-		sshTunnel, err := c.GetEncryptionsForConnection(connectionID)
+		value, err := field.fromAPI(apiConfig[field.apiKey()])
 		if err != nil {
-			return nil, "", err
+			return nil, err
 		}
+		config[field.name] = value
+	}
 
-		// global settings
-		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(redshiftCfg.AdapterVersion())
-		state.Name = types.StringPointerValue(common.Name)
-		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
-
-		// nullable common fields
-		if !common.PrivateLinkEndpointId.IsNull() {
-			state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
-		} else {
-			state.PrivateLinkEndpointId = types.StringNull()
-		}
-		if !common.OauthConfigurationId.IsNull() {
-			state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
-		} else {
-			state.OauthConfigurationId = types.Int64Null()
-		}
+	return config, nil
+}
 
-		// Redshift settings
-		state.RedshiftConfig.HostName = types.StringPointerValue(redshiftCfg.HostName)
-		state.RedshiftConfig.Port = types.Int64PointerValue(redshiftCfg.Port)
+// setCommonFromAPI sets the fields shared by all the adapters
+func setCommonFromAPI(
+	state *GlobalConnectionResourceModel,
+	common *dbt_cloud.GlobalConnectionCommon,
+	adapter adapterDefinition,
+) {
+	state.ID = types.Int64PointerValue(common.ID)
+	state.AdapterVersion = types.StringValue(adapterVersionOrDefault(common, adapter))
+	state.Name = types.StringPointerValue(common.Name)
+	state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
+
+	// nullable common fields
+	if !common.PrivateLinkEndpointId.IsNull() {
+		state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
+	} else {
+		state.PrivateLinkEndpointId = types.StringNull()
+	}
+	if !common.OauthConfigurationId.IsNull() {
+		state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
+	} else {
+		state.OauthConfigurationId = types.Int64Null()
+	}
+}
 
-		// nullable optional fields
-		if !redshiftCfg.DBName.IsNull() {
-			state.RedshiftConfig.DBName = types.StringValue(redshiftCfg.DBName.MustGet())
-		} else {
-			state.RedshiftConfig.DBName = types.StringNull()
-		}
+// readGeneric reads a connection of any adapter
+// the adapter is the one of the config block in the state or, for the data source, the one from the adapter version provided
+func readGeneric(
+	client *dbt_cloud.Client,
+	state *GlobalConnectionResourceModel,
+	adapterVersion string,
+) (*GlobalConnectionResourceModel, string, error) {
 
-		// SSH tunnel settings
-		if len(*sshTunnel) > 0 {
+	connectionID := state.ID.ValueInt64()
 
-			state.RedshiftConfig.SSHTunnel = &SSHTunnelConfig{
-				ID:        types.Int64PointerValue((*sshTunnel)[0].ID),
-				HostName:  types.StringValue((*sshTunnel)[0].HostName),
-				Port:      types.Int64Value((*sshTunnel)[0].Port),
-				Username:  types.StringValue((*sshTunnel)[0].Username),
-				PublicKey: types.StringValue((*sshTunnel)[0].PublicKey),
-			}
+	adapter, previousConfig, ok := activeAdapter(state)
+	if !ok {
+		adapter, ok = adapterForVersion(adapterVersion)
+		if !ok {
+			return nil, "", fmt.Errorf("the adapter %s is not supported", adapterVersion)
 		}
+	}
 
-		// We don't set the sensitive fields when we read because those are secret and never returned by the API
-		// sensitive fields: N/A for Redshift
-
-	case state.PostgresConfig != nil || strings.HasPrefix(adapter, "postgres_"):
-		// in case we use it for a datasource, we need to set the Config to not be nil
-		if state.PostgresConfig == nil {
-			state.PostgresConfig = &PostgresConfig{}
+	common, apiConfig, err := adapter.config.get(client, connectionID)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			return nil, "removeFromState", nil
 		}
+		return nil, "", err
+	}
 
-		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.PostgresConfig](client)
+	setCommonFromAPI(state, common, adapter)
 
-		common, postgresCfg, err := c.Get(connectionID)
-		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
-				return nil, "removeFromState", nil
-			}
-			return nil, "", err
-		}
+	config, err := adapter.configFromAPI(apiConfig, previousConfig)
+	if err != nil {
+		return nil, "", err
+	}
 
-		sshTunnel, err := c.GetEncryptionsForConnection(connectionID)
+	if adapter.supportsSSHTunnel() {
+		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](client)
+		sshTunnels, err := c.GetEncryptionsForConnection(connectionID)
 		if err != nil {
 			return nil, "", err
 		}
 
-		// global settings
-		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(postgresCfg.AdapterVersion())
-		state.Name = types.StringPointerValue(common.Name)
-		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
-
-		// nullable common fields
-		if !common.PrivateLinkEndpointId.IsNull() {
-			state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
-		} else {
-			state.PrivateLinkEndpointId = types.StringNull()
-		}
-		if !common.OauthConfigurationId.IsNull() {
-			state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
-		} else {
-			state.OauthConfigurationId = types.Int64Null()
-		}
-
-		// Postgres settings
-		state.PostgresConfig.HostName = types.StringPointerValue(postgresCfg.HostName)
-		state.PostgresConfig.Port = types.Int64PointerValue(postgresCfg.Port)
-
-		// nullable optional fields
-		if !postgresCfg.DBName.IsNull() {
-			state.PostgresConfig.DBName = types.StringValue(postgresCfg.DBName.MustGet())
-		} else {
-			state.PostgresConfig.DBName = types.StringNull()
-		}
-
-		// SSH tunnel settings
-		if len(*sshTunnel) > 0 {
-
-			state.PostgresConfig.SSHTunnel = &SSHTunnelConfig{
-				ID:        types.Int64PointerValue((*sshTunnel)[0].ID),
-				HostName:  types.StringValue((*sshTunnel)[0].HostName),
-				Port:      types.Int64Value((*sshTunnel)[0].Port),
-				Username:  types.StringValue((*sshTunnel)[0].Username),
-				PublicKey: types.StringValue((*sshTunnel)[0].PublicKey),
-			}
+		var sshTunnel *SSHTunnelConfig
+		if len(*sshTunnels) > 0 {
+			sshTunnel = sshTunnelFromAPI(&(*sshTunnels)[0])
 		}
+		config["ssh_tunnel"] = sshTunnelValue(sshTunnel)
+	}
 
-		// We don't set the sensitive fields when we read because those are secret and never returned by the API
-		// sensitive fields: N/A for Postgres
+	state.Configs = map[string]map[string]attr.Value{adapter.name: config}
+	syncAdapterVersions(state)
 
-	case state.FabricConfig != nil || strings.HasPrefix(adapter, "fabric_"):
-		// in case we use it for a datasource, we need to set the Config to not be nil
-		if state.FabricConfig == nil {
-			state.FabricConfig = &FabricConfig{}
-		}
+	return state, "", nil
+}
 
-		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.FabricConfig](client)
+// createGeneric creates the connection and its SSH tunnel from the plan and returns the new state
+func createGeneric(
+	client *dbt_cloud.Client,
+	plan *GlobalConnectionResourceModel,
+) (*GlobalConnectionResourceModel, error) {
 
-		common, fabricCfg, err := c.Get(connectionID)
-		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
-				return nil, "removeFromState", nil
-			}
-			return nil, "", err
-		}
+	adapter, config, ok := activeAdapter(plan)
+	if !ok {
+		return nil, fmt.Errorf("one of %s must be set", strings.Join(supportedGlobalConfigTypes, ", "))
+	}
 
-		// global settings
-		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(fabricCfg.AdapterVersion())
-		state.Name = types.StringPointerValue(common.Name)
-		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
+	commonCfg := dbt_cloud.GlobalConnectionCommon{
+		Name: plan.Name.ValueStringPointer(),
+	}
 
-		// nullable common fields
-		if !common.PrivateLinkEndpointId.IsNull() {
-			state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
-		} else {
-			state.PrivateLinkEndpointId = types.StringNull()
-		}
-		if !common.OauthConfigurationId.IsNull() {
-			state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
-		} else {
-			state.OauthConfigurationId = types.Int64Null()
-		}
+	// nullable common fields
+	if !plan.PrivateLinkEndpointId.IsNull() {
+		commonCfg.PrivateLinkEndpointId.Set(plan.PrivateLinkEndpointId.ValueString())
+	}
+	if !plan.OauthConfigurationId.IsNull() {
+		commonCfg.OauthConfigurationId.Set(plan.OauthConfigurationId.ValueInt64())
+	}
 
-		// Fabric settings
-		state.FabricConfig.Server = types.StringPointerValue(fabricCfg.Server)
-		state.FabricConfig.Port = types.Int64PointerValue(fabricCfg.Port)
-		state.FabricConfig.Database = types.StringPointerValue(fabricCfg.Database)
-		state.FabricConfig.Retries = types.Int64PointerValue(fabricCfg.Retries)
-		state.FabricConfig.LoginTimeout = types.Int64PointerValue(fabricCfg.LoginTimeout)
-		state.FabricConfig.QueryTimeout = types.Int64PointerValue(fabricCfg.QueryTimeout)
-
-		// We don't set the sensitive fields when we read because those are secret and never returned by the API
-		// sensitive fields: N/A for Fabric
-
-	case state.SynapseConfig != nil || strings.HasPrefix(adapter, "synapse_"):
-		// in case we use it for a datasource, we need to set the Config to not be nil
-		if state.SynapseConfig == nil {
-			state.SynapseConfig = &SynapseConfig{}
-		}
+	// a specific version of the adapter can be requested, otherwise dbt Cloud uses the default one
+	if adapterVersion, ok := plannedAdapterVersion(plan); ok {
+		commonCfg.AdapterVersion = adapterVersion.ValueStringPointer()
+	}
 
-		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.SynapseConfig](client)
+	commonResp, err := adapter.config.create(client, commonCfg, adapter.createConfig(config))
+	if err != nil {
+		return nil, err
+	}
 
-		common, synapseCfg, err := c.Get(connectionID)
-		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
-				return nil, "removeFromState", nil
+	// SSH tunnel settings
+	if adapter.supportsSSHTunnel() {
+		if sshTunnel := getSSHTunnel(config); sshTunnel != nil {
+			c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](client)
+			sshTunnelResp, err := c.CreateUpdateEncryption(
+				dbt_cloud.GlobalConnectionEncryptionPayload{
+					AccountID:    int64(client.AccountID),
+					ConnectionID: *commonResp.ID,
+					Username:     sshTunnel.Username.ValueString(),
+					Port:         sshTunnel.Port.ValueInt64(),
+					HostName:     sshTunnel.HostName.ValueString(),
+				},
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error creating the SSH Tunnel: %w", err)
 			}
-			return nil, "", err
+			config["ssh_tunnel"] = sshTunnelValue(sshTunnelFromAPI(sshTunnelResp))
 		}
+	}
 
-		// global settings
-		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(synapseCfg.AdapterVersion())
-		state.Name = types.StringPointerValue(common.Name)
-		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
+	// we set the computed values that don't have any default
+	plan.ID = types.Int64PointerValue(commonResp.ID)
+	plan.AdapterVersion = types.StringValue(adapterVersionOrDefault(commonResp, adapter))
+	plan.IsSshTunnelEnabled = types.BoolPointerValue(commonResp.IsSshTunnelEnabled)
 
-		// nullable common fields
-		if !common.PrivateLinkEndpointId.IsNull() {
-			state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
-		} else {
-			state.PrivateLinkEndpointId = types.StringNull()
-		}
-		if !common.OauthConfigurationId.IsNull() {
-			state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
-		} else {
-			state.OauthConfigurationId = types.Int64Null()
-		}
+	if adapterVersion, ok := plannedAdapterVersion(plan); ok {
+		plan.AdapterVersion = adapterVersion
+	}
+	syncAdapterVersions(plan)
 
-		// Synapse settings
-		state.SynapseConfig.Host = types.StringPointerValue(synapseCfg.Host)
-		state.SynapseConfig.Port = types.Int64PointerValue(synapseCfg.Port)
-		state.SynapseConfig.Database = types.StringPointerValue(synapseCfg.Database)
-		state.SynapseConfig.Retries = types.Int64PointerValue(synapseCfg.Retries)
-		state.SynapseConfig.LoginTimeout = types.Int64PointerValue(synapseCfg.LoginTimeout)
-		state.SynapseConfig.QueryTimeout = types.Int64PointerValue(synapseCfg.QueryTimeout)
-
-		// We don't set the sensitive fields when we read because those are secret and never returned by the API
-		// sensitive fields: N/A for Synapse
-
-	case state.StarburstConfig != nil || strings.HasPrefix(adapter, "trino_"):
-		// in case we use it for a datasource, we need to set the Config to not be nil
-		if state.StarburstConfig == nil {
-			state.StarburstConfig = &StarburstConfig{}
-		}
+	return plan, nil
+}
 
-		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.StarburstConfig](client)
+// updateGeneric sends the changes between the state and the plan and returns the new state
+func updateGeneric(
+	client *dbt_cloud.Client,
+	plan *GlobalConnectionResourceModel,
+	state *GlobalConnectionResourceModel,
+) (*GlobalConnectionResourceModel, error) {
 
-		common, starburstCfg, err := c.Get(connectionID)
-		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
-				return nil, "removeFromState", nil
-			}
-			return nil, "", err
-		}
+	adapter, planConfig, ok := activeAdapter(plan)
+	if !ok {
+		return nil, fmt.Errorf("one of %s must be set", strings.Join(supportedGlobalConfigTypes, ", "))
+	}
+	stateConfig := state.Configs[adapter.name]
 
-		// global settings
-		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(starburstCfg.AdapterVersion())
-		state.Name = types.StringPointerValue(common.Name)
-		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
+	globalConfigChanges := dbt_cloud.GlobalConnectionCommon{}
+	globalConfigChanged := false
 
-		// nullable common fields
-		if !common.PrivateLinkEndpointId.IsNull() {
-			state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
+	if plan.Name != state.Name {
+		globalConfigChanges.Name = plan.Name.ValueStringPointer()
+		globalConfigChanged = true
+	}
+	// nullable common fields
+	if plan.PrivateLinkEndpointId != state.PrivateLinkEndpointId {
+		globalConfigChanged = true
+		if plan.PrivateLinkEndpointId.IsNull() {
+			globalConfigChanges.PrivateLinkEndpointId.SetNull()
 		} else {
-			state.PrivateLinkEndpointId = types.StringNull()
+			globalConfigChanges.PrivateLinkEndpointId.Set(plan.PrivateLinkEndpointId.ValueString())
 		}
-		if !common.OauthConfigurationId.IsNull() {
-			state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
+	}
+	if plan.OauthConfigurationId != state.OauthConfigurationId {
+		globalConfigChanged = true
+		if plan.OauthConfigurationId.IsNull() {
+			globalConfigChanges.OauthConfigurationId.SetNull()
 		} else {
-			state.OauthConfigurationId = types.Int64Null()
+			globalConfigChanges.OauthConfigurationId.Set(plan.OauthConfigurationId.ValueInt64())
 		}
+	}
 
-		// Starburst settings
-		state.StarburstConfig.Method = types.StringPointerValue(starburstCfg.Method)
-		state.StarburstConfig.Host = types.StringPointerValue(starburstCfg.Host)
-		state.StarburstConfig.Port = types.Int64PointerValue(starburstCfg.Port)
-
-		// We don't set the sensitive fields when we read because those are secret and never returned by the API
-		// sensitive fields: N/A for Starburst
+	// upgrading the adapter version is done in place, downgrading it recreates the connection
+	if adapterVersion, ok := plannedAdapterVersion(plan); ok &&
+		adapterVersion.ValueString() != state.AdapterVersion.ValueString() {
+		globalConfigChanges.AdapterVersion = adapterVersion.ValueStringPointer()
+		globalConfigChanged = true
+	}
 
-	case state.AthenaConfig != nil || strings.HasPrefix(adapter, "athena_"):
-		// in case we use it for a datasource, we need to set the Config to not be nil
-		if state.AthenaConfig == nil {
-			state.AthenaConfig = &AthenaConfig{}
-		}
+	warehouseConfigChanges := adapter.patchConfig(planConfig, stateConfig)
 
-		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.AthenaConfig](client)
+	if globalConfigChanged || len(warehouseConfigChanges) > 0 {
+		updateCommon, err := adapter.config.update(
+			client,
+			state.ID.ValueInt64(),
+			globalConfigChanges,
+			warehouseConfigChanges,
+		)
+		if err != nil {
+			return nil, err
+		}
+		// we set the computed values, no need to do it for ID as we use a PlanModifier with UseStateForUnknown()
+		plan.IsSshTunnelEnabled = types.BoolPointerValue(updateCommon.IsSshTunnelEnabled)
+		plan.AdapterVersion = types.StringValue(adapterVersionOrDefault(updateCommon, adapter))
+	} else {
+		// if only the SSH tunnel changed, we keep the existing state values
+		plan.IsSshTunnelEnabled = state.IsSshTunnelEnabled
+		plan.AdapterVersion = state.AdapterVersion
+	}
 
-		common, athenaCfg, err := c.Get(connectionID)
+	// SSH tunnel settings
+	if adapter.supportsSSHTunnel() {
+		sshTunnel, err := handleSSHTunnelUpdates(
+			dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](client),
+			getSSHTunnel(planConfig),
+			getSSHTunnel(stateConfig),
+			int64(client.AccountID),
+			state.ID.ValueInt64(),
+		)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
-				return nil, "removeFromState", nil
-			}
-			return nil, "", err
+			return nil, fmt.Errorf("error with the SSH Tunnel: %w", err)
 		}
+		planConfig["ssh_tunnel"] = sshTunnelValue(sshTunnel)
+	}
 
-		// global settings
-		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(athenaCfg.AdapterVersion())
-		state.Name = types.StringPointerValue(common.Name)
-		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
-
-		// nullable common fields
-		if !common.PrivateLinkEndpointId.IsNull() {
-			state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
-		} else {
-			state.PrivateLinkEndpointId = types.StringNull()
-		}
-		if !common.OauthConfigurationId.IsNull() {
-			state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
-		} else {
-			state.OauthConfigurationId = types.Int64Null()
-		}
+	if adapterVersion, ok := plannedAdapterVersion(plan); ok {
+		plan.AdapterVersion = adapterVersion
+	}
+	syncAdapterVersions(plan)
 
-		// Athena settings
-		state.AthenaConfig.RegionName = types.StringPointerValue(athenaCfg.RegionName)
-		state.AthenaConfig.Database = types.StringPointerValue(athenaCfg.Database)
-		state.AthenaConfig.S3StagingDir = types.StringPointerValue(athenaCfg.S3StagingDir)
+	return plan, nil
+}
 
-		// nullable optional fields
-		if !athenaCfg.WorkGroup.IsNull() {
-			state.AthenaConfig.WorkGroup = types.StringValue(athenaCfg.WorkGroup.MustGet())
-		} else {
-			state.AthenaConfig.WorkGroup = types.StringNull()
-		}
-		if !athenaCfg.SparkWorkGroup.IsNull() {
-			state.AthenaConfig.SparkWorkGroup = types.StringValue(
-				athenaCfg.SparkWorkGroup.MustGet(),
-			)
-		} else {
-			state.AthenaConfig.SparkWorkGroup = types.StringNull()
-		}
-		if !athenaCfg.S3DataDir.IsNull() {
-			state.AthenaConfig.S3DataDir = types.StringValue(athenaCfg.S3DataDir.MustGet())
-		} else {
-			state.AthenaConfig.S3DataDir = types.StringNull()
-		}
-		if !athenaCfg.S3DataNaming.IsNull() {
-			state.AthenaConfig.S3DataNaming = types.StringValue(athenaCfg.S3DataNaming.MustGet())
-		} else {
-			state.AthenaConfig.S3DataNaming = types.StringNull()
-		}
-		if !athenaCfg.S3TmpTableDir.IsNull() {
-			state.AthenaConfig.S3TmpTableDir = types.StringValue(athenaCfg.S3TmpTableDir.MustGet())
-		} else {
-			state.AthenaConfig.S3TmpTableDir = types.StringNull()
-		}
-		if !athenaCfg.PollInterval.IsNull() {
-			state.AthenaConfig.PollInterval = types.Int64Value(athenaCfg.PollInterval.MustGet())
-		} else {
-			state.AthenaConfig.PollInterval = types.Int64Null()
-		}
-		if !athenaCfg.NumRetries.IsNull() {
-			state.AthenaConfig.NumRetries = types.Int64Value(athenaCfg.NumRetries.MustGet())
-		} else {
-			state.AthenaConfig.NumRetries = types.Int64Null()
-		}
-		if !athenaCfg.NumBoto3Retries.IsNull() {
-			state.AthenaConfig.NumBoto3Retries = types.Int64Value(
-				athenaCfg.NumBoto3Retries.MustGet(),
-			)
-		} else {
-			state.AthenaConfig.NumBoto3Retries = types.Int64Null()
-		}
-		if !athenaCfg.NumIcebergRetries.IsNull() {
-			state.AthenaConfig.NumIcebergRetries = types.Int64Value(
-				athenaCfg.NumIcebergRetries.MustGet(),
-			)
-		} else {
-			state.AthenaConfig.NumIcebergRetries = types.Int64Null()
-		}
+// deleteGeneric deletes the connection and its SSH tunnel
+func deleteGeneric(
+	client *dbt_cloud.Client,
+	state *GlobalConnectionResourceModel,
+) error {
+	connectionID := state.ID.ValueInt64()
 
-		// We don't set the sensitive fields when we read because those are secret and never returned by the API
-		// sensitive fields: N/A for Athena
+	_, err := client.DeleteGlobalConnection(connectionID)
+	if err != nil {
+		return err
+	}
 
-	case state.ApacheSparkConfig != nil || strings.HasPrefix(adapter, "apache_spark_"):
-		// in case we use it for a datasource, we need to set the Config to not be nil
-		if state.ApacheSparkConfig == nil {
-			state.ApacheSparkConfig = &ApacheSparkConfig{}
-		}
+	// delete the SSH Tunnel if it exists
+	_, config, ok := activeAdapter(state)
+	if !ok {
+		return nil
+	}
+	sshTunnel := getSSHTunnel(config)
+	if sshTunnel == nil {
+		return nil
+	}
 
-		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.ApacheSparkConfig](client)
+	c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](client)
+	_, err = c.CreateUpdateEncryption(
+		sshTunnelDeletePayload(sshTunnel, int64(client.AccountID), connectionID),
+	)
+	if err != nil {
+		return fmt.Errorf("error deleting the SSH Tunnel: %w", err)
+	}
+	return nil
+}
 
-		common, sparkCfg, err := c.Get(connectionID)
-		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
-				return nil, "removeFromState", nil
-			}
-			return nil, "", err
-		}
+func sshTunnelFromAPI(sshTunnel *dbt_cloud.GlobalConnectionEncryptionPayload) *SSHTunnelConfig {
+	return &SSHTunnelConfig{
+		ID:        types.Int64PointerValue(sshTunnel.ID),
+		Username:  types.StringValue(sshTunnel.Username),
+		Port:      types.Int64Value(sshTunnel.Port),
+		HostName:  types.StringValue(sshTunnel.HostName),
+		PublicKey: types.StringValue(sshTunnel.PublicKey),
+	}
+}
 
-		// global settings
-		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(sparkCfg.AdapterVersion())
-		state.Name = types.StringPointerValue(common.Name)
-		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
+// to delete the encryption we update it with state=2
+func sshTunnelDeletePayload(
+	sshTunnel *SSHTunnelConfig,
+	accountID int64,
+	connectionID int64,
+) dbt_cloud.GlobalConnectionEncryptionPayload {
+	valueID := sshTunnel.ID.ValueInt64()
+	return dbt_cloud.GlobalConnectionEncryptionPayload{
+		ID:           &valueID,
+		AccountID:    accountID,
+		ConnectionID: connectionID,
+		Username:     sshTunnel.Username.ValueString(),
+		Port:         sshTunnel.Port.ValueInt64(),
+		HostName:     sshTunnel.HostName.ValueString(),
+		State:        dbt_cloud.STATE_DELETED,
+	}
+}
 
-		// nullable common fields
-		if !common.PrivateLinkEndpointId.IsNull() {
-			state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
-		} else {
-			state.PrivateLinkEndpointId = types.StringNull()
-		}
-		if !common.OauthConfigurationId.IsNull() {
-			state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
-		} else {
-			state.OauthConfigurationId = types.Int64Null()
-		}
+func handleSSHTunnelUpdates(
+	c dbt_cloud.GlobalConnectionClient[dbt_cloud.EmptyConfig],
+	sshTunnelPlan *SSHTunnelConfig,
+	sshTunnelState *SSHTunnelConfig,
+	accountID int64,
+	connectionID int64,
+) (*SSHTunnelConfig, error) {
+	switch {
+	case sshTunnelPlan == nil && sshTunnelState == nil:
+		return nil, nil
 
-		// Spark settings
-		state.ApacheSparkConfig.Method = types.StringPointerValue(sparkCfg.Method)
-		state.ApacheSparkConfig.Host = types.StringPointerValue(sparkCfg.Host)
-		state.ApacheSparkConfig.Port = types.Int64PointerValue(sparkCfg.Port)
-		state.ApacheSparkConfig.Cluster = types.StringPointerValue(sparkCfg.Cluster)
-		state.ApacheSparkConfig.ConnectTimeout = types.Int64PointerValue(sparkCfg.ConnectTimeout)
-		state.ApacheSparkConfig.ConnectRetries = types.Int64PointerValue(sparkCfg.ConnectRetries)
-
-		// nullable optional fields
-		if !sparkCfg.Organization.IsNull() {
-			state.ApacheSparkConfig.Organization = types.StringValue(
-				sparkCfg.Organization.MustGet(),
-			)
-		} else {
-			state.ApacheSparkConfig.Organization = types.StringNull()
-		}
-		if !sparkCfg.User.IsNull() {
-			state.ApacheSparkConfig.User = types.StringValue(sparkCfg.User.MustGet())
-		} else {
-			state.ApacheSparkConfig.User = types.StringNull()
-		}
-		if !sparkCfg.Auth.IsNull() {
-			state.ApacheSparkConfig.Auth = types.StringValue(sparkCfg.Auth.MustGet())
-		} else {
-			state.ApacheSparkConfig.Auth = types.StringNull()
+	case sshTunnelPlan == nil:
+		// delete the encryption
+		_, err := c.CreateUpdateEncryption(
+			sshTunnelDeletePayload(sshTunnelState, accountID, connectionID),
+		)
+		return nil, err
+
+	case sshTunnelState == nil:
+		// create the encryption
+		sshTunnel, err := c.CreateUpdateEncryption(dbt_cloud.GlobalConnectionEncryptionPayload{
+			AccountID:    accountID,
+			ConnectionID: connectionID,
+			Username:     sshTunnelPlan.Username.ValueString(),
+			Port:         sshTunnelPlan.Port.ValueInt64(),
+			HostName:     sshTunnelPlan.HostName.ValueString(),
+		})
+		if err != nil {
+			return nil, err
 		}
+		return sshTunnelFromAPI(sshTunnel), nil
 
-		// We don't set the sensitive fields when we read because those are secret and never returned by the API
-		// sensitive fields: N/A for Spark
+	case sshTunnelPlan.Username.Equal(sshTunnelState.Username) &&
+		sshTunnelPlan.Port.Equal(sshTunnelState.Port) &&
+		sshTunnelPlan.HostName.Equal(sshTunnelState.HostName):
+		// nothing changed, the computed values are kept from the state
+		return sshTunnelState, nil
 
 	default:
-		panic("Unknown connection type")
+		// update the encryption
+		valueID := sshTunnelState.ID.ValueInt64()
+		sshTunnel, err := c.CreateUpdateEncryption(dbt_cloud.GlobalConnectionEncryptionPayload{
+			ID:           &valueID,
+			AccountID:    accountID,
+			ConnectionID: connectionID,
+			Username:     sshTunnelPlan.Username.ValueString(),
+			Port:         sshTunnelPlan.Port.ValueInt64(),
+			HostName:     sshTunnelPlan.HostName.ValueString(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return sshTunnelFromAPI(sshTunnel), nil
 	}
-
-	return state, "", nil
 }
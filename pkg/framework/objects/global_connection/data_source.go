@@ -33,9 +33,11 @@ func (d *globalConnectionDataSource) Read(
 	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	var state GlobalConnectionResourceModel
-
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	state, diags := getGlobalConnectionModel(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	connectionID := state.ID.ValueInt64()
 
@@ -64,7 +66,7 @@ func (d *globalConnectionDataSource) Read(
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	setGlobalConnectionState(ctx, &resp.State, newState, &resp.Diagnostics)
 }
 
 func (d *globalConnectionDataSource) Configure(
@@ -11,7 +11,7 @@ import (
 )
 
 func TestAccDbtCloudGlobalConnectionDatasource(t *testing.T) {
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
 	oAuthClientID := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	oAuthClientSecret := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccDbtCloudGlobalConnectionsDatasource(t *testing.T) {
 
-	connectionName := acctest.RandStringFromCharSet(19, acctest.CharSetAlphaNum)
+	connectionName := acctest_helper.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
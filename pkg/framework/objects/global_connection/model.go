@@ -1,136 +1,25 @@
 package global_connection
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/samber/lo"
 )
 
-type ConfigDetails struct {
-	EmptyConfigName    interface{}
-	IsEmptyConfig      func(*GlobalConnectionResourceModel) bool
-	GetSSHTunnelConfig func(*GlobalConnectionResourceModel) *SSHTunnelConfig
-}
-
-var mappingAdapterDetails = map[string]ConfigDetails{
-	"bigquery": {
-		EmptyConfigName: BigQueryConfig{},
-		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
-			return model.BigQueryConfig == nil
-		},
-		GetSSHTunnelConfig: func(model *GlobalConnectionResourceModel) *SSHTunnelConfig {
-			return nil
-		},
-	},
-	"snowflake": {
-		EmptyConfigName: SnowflakeConfig{},
-		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
-			return model.SnowflakeConfig == nil
-		},
-		GetSSHTunnelConfig: func(model *GlobalConnectionResourceModel) *SSHTunnelConfig {
-			return nil
-		},
-	},
-	"databricks": {
-		EmptyConfigName: DatabricksConfig{},
-		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
-			return model.DatabricksConfig == nil
-		},
-		GetSSHTunnelConfig: func(model *GlobalConnectionResourceModel) *SSHTunnelConfig {
-			return nil
-		},
-	},
-	"redshift": {
-		EmptyConfigName: RedshiftConfig{},
-		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
-			return model.RedshiftConfig == nil
-		},
-		GetSSHTunnelConfig: func(model *GlobalConnectionResourceModel) *SSHTunnelConfig {
-			if model.RedshiftConfig != nil {
-				return model.RedshiftConfig.SSHTunnel
-			} else {
-				return nil
-			}
-		},
-	},
-	"postgres": {
-		EmptyConfigName: PostgresConfig{},
-		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
-			return model.PostgresConfig == nil
-		},
-		GetSSHTunnelConfig: func(model *GlobalConnectionResourceModel) *SSHTunnelConfig {
-			if model.PostgresConfig != nil {
-				return model.PostgresConfig.SSHTunnel
-			} else {
-				return nil
-			}
-		},
-	},
-	"fabric": {
-		EmptyConfigName: FabricConfig{},
-		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
-			return model.FabricConfig == nil
-		},
-		GetSSHTunnelConfig: func(model *GlobalConnectionResourceModel) *SSHTunnelConfig {
-			return nil
-		},
-	},
-	"synapse": {
-		EmptyConfigName: SynapseConfig{},
-		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
-			return model.SynapseConfig == nil
-		},
-		GetSSHTunnelConfig: func(model *GlobalConnectionResourceModel) *SSHTunnelConfig {
-			return nil
-		},
-	},
-	"starburst": {
-		EmptyConfigName: StarburstConfig{},
-		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
-			return model.StarburstConfig == nil
-		},
-		GetSSHTunnelConfig: func(model *GlobalConnectionResourceModel) *SSHTunnelConfig {
-			return nil
-		},
-	},
-	"athena": {
-		EmptyConfigName: AthenaConfig{},
-		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
-			return model.AthenaConfig == nil
-		},
-		GetSSHTunnelConfig: func(model *GlobalConnectionResourceModel) *SSHTunnelConfig {
-			return nil
-		},
-	},
-	"apache_spark": {
-		EmptyConfigName: ApacheSparkConfig{},
-		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
-			return model.ApacheSparkConfig == nil
-		},
-		GetSSHTunnelConfig: func(model *GlobalConnectionResourceModel) *SSHTunnelConfig {
-			return nil
-		},
-	},
-}
-
-var supportedGlobalConfigTypes = lo.Keys(mappingAdapterDetails)
-
 type GlobalConnectionResourceModel struct {
-	ID                    types.Int64        `tfsdk:"id"`
-	AdapterVersion        types.String       `tfsdk:"adapter_version"`
-	Name                  types.String       `tfsdk:"name"`
-	IsSshTunnelEnabled    types.Bool         `tfsdk:"is_ssh_tunnel_enabled"` //TODO: check if we can deprecate this
-	PrivateLinkEndpointId types.String       `tfsdk:"private_link_endpoint_id"`
-	OauthConfigurationId  types.Int64        `tfsdk:"oauth_configuration_id"`
-	SnowflakeConfig       *SnowflakeConfig   `tfsdk:"snowflake"`
-	BigQueryConfig        *BigQueryConfig    `tfsdk:"bigquery"`
-	DatabricksConfig      *DatabricksConfig  `tfsdk:"databricks"`
-	RedshiftConfig        *RedshiftConfig    `tfsdk:"redshift"`
-	PostgresConfig        *PostgresConfig    `tfsdk:"postgres"`
-	FabricConfig          *FabricConfig      `tfsdk:"fabric"`
-	SynapseConfig         *SynapseConfig     `tfsdk:"synapse"`
-	StarburstConfig       *StarburstConfig   `tfsdk:"starburst"`
-	AthenaConfig          *AthenaConfig      `tfsdk:"athena"`
-	ApacheSparkConfig     *ApacheSparkConfig `tfsdk:"apache_spark"`
+	ID                    types.Int64
+	AdapterVersion        types.String
+	Name                  types.String
+	IsSshTunnelEnabled    types.Bool //TODO: check if we can deprecate this
+	PrivateLinkEndpointId types.String
+	OauthConfigurationId  types.Int64
+	// ValidateOnApply is only part of the resource schema
+	ValidateOnApply types.Bool
+	// Configs contains the attributes of the config blocks set, keyed by the name of the adapter
+	// the config blocks are driven by the adapters registry, this is why they are not typed
+	Configs map[string]map[string]attr.Value
 }
 
 type SSHTunnelConfig struct {
@@ -141,123 +30,185 @@ type SSHTunnelConfig struct {
 	PublicKey types.String `tfsdk:"public_key"`
 }
 
-type BigQueryConfig struct {
-	GCPProjectID            types.String   `tfsdk:"gcp_project_id"`
-	TimeoutSeconds          types.Int64    `tfsdk:"timeout_seconds"`
-	PrivateKeyID            types.String   `tfsdk:"private_key_id"`
-	PrivateKey              types.String   `tfsdk:"private_key"`
-	ClientEmail             types.String   `tfsdk:"client_email"`
-	ClientID                types.String   `tfsdk:"client_id"`
-	AuthURI                 types.String   `tfsdk:"auth_uri"`
-	TokenURI                types.String   `tfsdk:"token_uri"`
-	AuthProviderX509CertURL types.String   `tfsdk:"auth_provider_x509_cert_url"`
-	ClientX509CertURL       types.String   `tfsdk:"client_x509_cert_url"`
-	Retries                 types.Int64    `tfsdk:"retries"`
-	Scopes                  []types.String `tfsdk:"scopes"`
-	// nullable
-	Priority                  types.String `tfsdk:"priority"`
-	Location                  types.String `tfsdk:"location"`
-	MaximumBytesBilled        types.Int64  `tfsdk:"maximum_bytes_billed"`
-	ExecutionProject          types.String `tfsdk:"execution_project"`
-	ImpersonateServiceAccount types.String `tfsdk:"impersonate_service_account"`
-	JobRetryDeadlineSeconds   types.Int64  `tfsdk:"job_retry_deadline_seconds"`
-	JobCreationTimeoutSeconds types.Int64  `tfsdk:"job_creation_timeout_seconds"`
-	ApplicationID             types.String `tfsdk:"application_id"`
-	ApplicationSecret         types.String `tfsdk:"application_secret"`
-	GcsBucket                 types.String `tfsdk:"gcs_bucket"`
-	DataprocRegion            types.String `tfsdk:"dataproc_region"`
-	DataprocClusterName       types.String `tfsdk:"dataproc_cluster_name"`
-}
-
-type SnowflakeConfig struct {
-	Account                types.String `tfsdk:"account"`
-	Database               types.String `tfsdk:"database"`
-	Warehouse              types.String `tfsdk:"warehouse"`
-	ClientSessionKeepAlive types.Bool   `tfsdk:"client_session_keep_alive"`
-	AllowSso               types.Bool   `tfsdk:"allow_sso"`
-	OauthClientID          types.String `tfsdk:"oauth_client_id"`
-	OauthClientSecret      types.String `tfsdk:"oauth_client_secret"`
-	// nullable
-	Role types.String `tfsdk:"role"`
-}
-
-type DatabricksConfig struct {
-	Host     types.String `tfsdk:"host"`
-	HTTPPath types.String `tfsdk:"http_path"`
-	// nullable
-	Catalog      types.String `tfsdk:"catalog"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-}
-
-type RedshiftConfig struct {
-	HostName types.String `tfsdk:"hostname"`
-	Port     types.Int64  `tfsdk:"port"`
-	// nullable
-	DBName    types.String     `tfsdk:"dbname"`
-	SSHTunnel *SSHTunnelConfig `tfsdk:"ssh_tunnel"`
-}
-
-type PostgresConfig struct {
-	HostName types.String `tfsdk:"hostname"`
-	Port     types.Int64  `tfsdk:"port"`
-	// nullable
-	DBName    types.String     `tfsdk:"dbname"`
-	SSHTunnel *SSHTunnelConfig `tfsdk:"ssh_tunnel"`
-}
-
-type FabricConfig struct {
-	Server       types.String `tfsdk:"server"`
-	Port         types.Int64  `tfsdk:"port"`
-	Database     types.String `tfsdk:"database"`
-	Retries      types.Int64  `tfsdk:"retries"`
-	LoginTimeout types.Int64  `tfsdk:"login_timeout"`
-	QueryTimeout types.Int64  `tfsdk:"query_timeout"`
-}
-
-// Fabric and Synapse are very similar, except Synapse uses Host instead of Server
-type SynapseConfig struct {
-	Host         types.String `tfsdk:"host"`
-	Port         types.Int64  `tfsdk:"port"`
-	Database     types.String `tfsdk:"database"`
-	Retries      types.Int64  `tfsdk:"retries"`
-	LoginTimeout types.Int64  `tfsdk:"login_timeout"`
-	QueryTimeout types.Int64  `tfsdk:"query_timeout"`
-}
-
-type StarburstConfig struct {
-	Method types.String `tfsdk:"method"`
-	Host   types.String `tfsdk:"host"`
-	Port   types.Int64  `tfsdk:"port"`
-}
-
-type AthenaConfig struct {
-	RegionName   types.String `tfsdk:"region_name"`
-	Database     types.String `tfsdk:"database"`
-	S3StagingDir types.String `tfsdk:"s3_staging_dir"`
-	// nullable
-	WorkGroup         types.String `tfsdk:"work_group"`
-	SparkWorkGroup    types.String `tfsdk:"spark_work_group"`
-	S3DataDir         types.String `tfsdk:"s3_data_dir"`
-	S3DataNaming      types.String `tfsdk:"s3_data_naming"`
-	S3TmpTableDir     types.String `tfsdk:"s3_tmp_table_dir"`
-	PollInterval      types.Int64  `tfsdk:"poll_interval"`
-	NumRetries        types.Int64  `tfsdk:"num_retries"`
-	NumBoto3Retries   types.Int64  `tfsdk:"num_boto3_retries"`
-	NumIcebergRetries types.Int64  `tfsdk:"num_iceberg_retries"`
-}
-
-type ApacheSparkConfig struct {
-	Method         types.String `tfsdk:"method"`
-	Host           types.String `tfsdk:"host"`
-	Port           types.Int64  `tfsdk:"port"`
-	Cluster        types.String `tfsdk:"cluster"`
-	ConnectTimeout types.Int64  `tfsdk:"connect_timeout"`
-	ConnectRetries types.Int64  `tfsdk:"connect_retries"`
-	// nullable
-	Organization types.String `tfsdk:"organization"`
-	User         types.String `tfsdk:"user"`
-	Auth         types.String `tfsdk:"auth"`
+var sshTunnelAttrTypes = map[string]attr.Type{
+	"id":         types.Int64Type,
+	"username":   types.StringType,
+	"port":       types.Int64Type,
+	"hostname":   types.StringType,
+	"public_key": types.StringType,
+}
+
+func (k fieldKind) attrType() attr.Type {
+	switch k {
+	case int64Field:
+		return types.Int64Type
+	case boolField:
+		return types.BoolType
+	case stringSetField:
+		return types.SetType{ElemType: types.StringType}
+	default:
+		return types.StringType
+	}
+}
+
+func (k fieldKind) nullValue() attr.Value {
+	switch k {
+	case int64Field:
+		return types.Int64Null()
+	case boolField:
+		return types.BoolNull()
+	case stringSetField:
+		return types.SetNull(types.StringType)
+	default:
+		return types.StringNull()
+	}
+}
+
+func (a adapterDefinition) attrTypes() map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		"adapter_version": types.StringType,
+	}
+	for _, field := range a.fields {
+		attrTypes[field.name] = field.kind.attrType()
+	}
+	if a.supportsSSHTunnel() {
+		attrTypes["ssh_tunnel"] = types.ObjectType{AttrTypes: sshTunnelAttrTypes}
+	}
+	return attrTypes
+}
+
+// emptyConfig returns the attributes of a config block with all the values set to null
+func (a adapterDefinition) emptyConfig() map[string]attr.Value {
+	config := map[string]attr.Value{
+		"adapter_version": types.StringNull(),
+	}
+	for _, field := range a.fields {
+		config[field.name] = field.kind.nullValue()
+	}
+	if a.supportsSSHTunnel() {
+		config["ssh_tunnel"] = types.ObjectNull(sshTunnelAttrTypes)
+	}
+	return config
+}
+
+func globalConnectionAttrTypes() map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		"id":                       types.Int64Type,
+		"adapter_version":          types.StringType,
+		"name":                     types.StringType,
+		"is_ssh_tunnel_enabled":    types.BoolType,
+		"private_link_endpoint_id": types.StringType,
+		"oauth_configuration_id":   types.Int64Type,
+		"validate_on_apply":        types.BoolType,
+	}
+	for _, adapter := range adapters {
+		attrTypes[adapter.name] = types.ObjectType{AttrTypes: adapter.attrTypes()}
+	}
+	return attrTypes
+}
+
+// tfsdkGetter is implemented by the plan, the state and the config
+type tfsdkGetter interface {
+	Get(ctx context.Context, target interface{}) diag.Diagnostics
+}
+
+// getGlobalConnectionModel reads the plan, state or config into the model
+func getGlobalConnectionModel(
+	ctx context.Context,
+	getter tfsdkGetter,
+) (GlobalConnectionResourceModel, diag.Diagnostics) {
+	var object types.Object
+	diags := getter.Get(ctx, &object)
+	if diags.HasError() {
+		return GlobalConnectionResourceModel{}, diags
+	}
+	return globalConnectionModelFromObject(object), diags
+}
+
+func globalConnectionModelFromObject(object types.Object) GlobalConnectionResourceModel {
+	attributes := object.Attributes()
+	model := GlobalConnectionResourceModel{
+		ID:                    attributes["id"].(types.Int64),
+		AdapterVersion:        attributes["adapter_version"].(types.String),
+		Name:                  attributes["name"].(types.String),
+		IsSshTunnelEnabled:    attributes["is_ssh_tunnel_enabled"].(types.Bool),
+		PrivateLinkEndpointId: attributes["private_link_endpoint_id"].(types.String),
+		OauthConfigurationId:  attributes["oauth_configuration_id"].(types.Int64),
+		ValidateOnApply:       types.BoolNull(),
+		Configs:               map[string]map[string]attr.Value{},
+	}
+	if validateOnApply, ok := attributes["validate_on_apply"].(types.Bool); ok {
+		model.ValidateOnApply = validateOnApply
+	}
+	for _, adapter := range adapters {
+		config := attributes[adapter.name].(types.Object)
+		if config.IsNull() || config.IsUnknown() {
+			continue
+		}
+		model.Configs[adapter.name] = config.Attributes()
+	}
+	return model
+}
+
+// toObject returns the model as an object that can be set in the state
+func (m GlobalConnectionResourceModel) toObject() (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := map[string]attr.Value{
+		"id":                       m.ID,
+		"adapter_version":          m.AdapterVersion,
+		"name":                     m.Name,
+		"is_ssh_tunnel_enabled":    m.IsSshTunnelEnabled,
+		"private_link_endpoint_id": m.PrivateLinkEndpointId,
+		"oauth_configuration_id":   m.OauthConfigurationId,
+		"validate_on_apply":        m.ValidateOnApply,
+	}
+	for _, adapter := range adapters {
+		config, ok := m.Configs[adapter.name]
+		if !ok {
+			attributes[adapter.name] = types.ObjectNull(adapter.attrTypes())
+			continue
+		}
+		configObject, configDiags := types.ObjectValue(adapter.attrTypes(), config)
+		diags.Append(configDiags...)
+		attributes[adapter.name] = configObject
+	}
+	if diags.HasError() {
+		return types.ObjectNull(globalConnectionAttrTypes()), diags
+	}
+
+	object, objectDiags := types.ObjectValue(globalConnectionAttrTypes(), attributes)
+	diags.Append(objectDiags...)
+	return object, diags
+}
+
+// getSSHTunnel returns the SSH tunnel of a config block, or nil if it is not set
+func getSSHTunnel(config map[string]attr.Value) *SSHTunnelConfig {
+	sshTunnelObject, ok := config["ssh_tunnel"].(types.Object)
+	if !ok || sshTunnelObject.IsNull() || sshTunnelObject.IsUnknown() {
+		return nil
+	}
+	attributes := sshTunnelObject.Attributes()
+	return &SSHTunnelConfig{
+		ID:        attributes["id"].(types.Int64),
+		Username:  attributes["username"].(types.String),
+		Port:      attributes["port"].(types.Int64),
+		HostName:  attributes["hostname"].(types.String),
+		PublicKey: attributes["public_key"].(types.String),
+	}
+}
+
+func sshTunnelValue(sshTunnel *SSHTunnelConfig) types.Object {
+	if sshTunnel == nil {
+		return types.ObjectNull(sshTunnelAttrTypes)
+	}
+	return types.ObjectValueMust(sshTunnelAttrTypes, map[string]attr.Value{
+		"id":         sshTunnel.ID,
+		"username":   sshTunnel.Username,
+		"port":       sshTunnel.Port,
+		"hostname":   sshTunnel.HostName,
+		"public_key": sshTunnel.PublicKey,
+	})
 }
 
 type GlobalConnectionsDatasourceModel struct {
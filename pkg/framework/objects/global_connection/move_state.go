@@ -0,0 +1,267 @@
+package global_connection
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithMoveState = &globalConnectionResource{}
+
+// legacyConnection describes how the state of a deprecated connection resource maps to a config block of the global connection
+type legacyConnection struct {
+	typeName string
+	// adapter returns the name of the adapter and the mapping between the attributes of the global connection and the legacy ones
+	adapter func(legacyState map[string]any) (string, map[string]string, error)
+}
+
+var legacyConnections = []legacyConnection{
+	{
+		typeName: "dbtcloud_connection",
+		adapter:  legacyConnectionAdapter,
+	},
+	{
+		typeName: "dbtcloud_bigquery_connection",
+		adapter: func(_ map[string]any) (string, map[string]string, error) {
+			return "bigquery", map[string]string{
+				"gcp_project_id":              "gcp_project_id",
+				"timeout_seconds":             "timeout_seconds",
+				"private_key_id":              "private_key_id",
+				"private_key":                 "private_key",
+				"client_email":                "client_email",
+				"client_id":                   "client_id",
+				"auth_uri":                    "auth_uri",
+				"token_uri":                   "token_uri",
+				"auth_provider_x509_cert_url": "auth_provider_x509_cert_url",
+				"client_x509_cert_url":        "client_x509_cert_url",
+				"retries":                     "retries",
+				"location":                    "location",
+				"maximum_bytes_billed":        "maximum_bytes_billed",
+				"execution_project":           "execution_project",
+				"priority":                    "priority",
+				"gcs_bucket":                  "gcs_bucket",
+				"dataproc_region":             "dataproc_region",
+				"dataproc_cluster_name":       "dataproc_cluster_name",
+				"application_id":              "application_id",
+				"application_secret":          "application_secret",
+			}, nil
+		},
+	},
+	{
+		typeName: "dbtcloud_fabric_connection",
+		adapter: func(_ map[string]any) (string, map[string]string, error) {
+			return "fabric", map[string]string{
+				"server":        "server",
+				"port":          "port",
+				"database":      "database",
+				"retries":       "retries",
+				"login_timeout": "login_timeout",
+				"query_timeout": "query_timeout",
+			}, nil
+		},
+	},
+}
+
+// legacyConnectionAdapter returns the adapter for the different types of the resource dbtcloud_connection
+func legacyConnectionAdapter(legacyState map[string]any) (string, map[string]string, error) {
+	connectionType, _ := legacyState["type"].(string)
+
+	switch connectionType {
+	case "snowflake":
+		return "snowflake", map[string]string{
+			"account":                   "account",
+			"database":                  "database",
+			"warehouse":                 "warehouse",
+			"role":                      "role",
+			"allow_sso":                 "allow_sso",
+			"client_session_keep_alive": "allow_keep_alive",
+			"oauth_client_id":           "oauth_client_id",
+			"oauth_client_secret":       "oauth_client_secret",
+		}, nil
+	case "redshift", "postgres", "alloydb":
+		adapterName := "postgres"
+		if connectionType == "redshift" {
+			adapterName = "redshift"
+		}
+		return adapterName, map[string]string{
+			"hostname": "host_name",
+			"port":     "port",
+			"dbname":   "database",
+		}, nil
+	case "adapter":
+		return "databricks", map[string]string{
+			"host":          "host_name",
+			"http_path":     "http_path",
+			"catalog":       "catalog",
+			"client_id":     "oauth_client_id",
+			"client_secret": "oauth_client_secret",
+		}, nil
+	default:
+		return "", nil, fmt.Errorf(
+			"the connection type %q can't be moved to a dbtcloud_global_connection",
+			connectionType,
+		)
+	}
+}
+
+func (r *globalConnectionResource) MoveState(
+	_ context.Context,
+) []resource.StateMover {
+
+	stateMovers := []resource.StateMover{}
+	for _, legacy := range legacyConnections {
+		stateMovers = append(stateMovers, resource.StateMover{
+			StateMover: legacy.moveState,
+		})
+	}
+	return stateMovers
+}
+
+func (l legacyConnection) moveState(
+	ctx context.Context,
+	req resource.MoveStateRequest,
+	resp *resource.MoveStateResponse,
+) {
+	// the movers are called for any source resource, we only handle the one we know about
+	if req.SourceTypeName != l.typeName {
+		return
+	}
+
+	legacyState, err := decodeLegacyState(req.SourceRawState.JSON)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read the source state",
+			fmt.Sprintf("The state of %s could not be read: %s", l.typeName, err),
+		)
+		return
+	}
+
+	model, err := l.globalConnectionModel(legacyState)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to move the connection",
+			fmt.Sprintf("The state of %s could not be moved: %s", l.typeName, err),
+		)
+		return
+	}
+
+	setGlobalConnectionState(ctx, &resp.TargetState, model, &resp.Diagnostics)
+	if model.IsSshTunnelEnabled.ValueBool() && resp.TargetPrivate != nil {
+		resp.Diagnostics.Append(resp.TargetPrivate.SetKey(ctx, readSSHTunnelKey, []byte("true"))...)
+	}
+}
+
+func decodeLegacyState(rawState []byte) (map[string]any, error) {
+	legacyState := map[string]any{}
+
+	decoder := json.NewDecoder(bytes.NewReader(rawState))
+	decoder.UseNumber()
+	err := decoder.Decode(&legacyState)
+	return legacyState, err
+}
+
+// globalConnectionModel converts the state of a legacy connection to the state of a global connection
+// the SSH tunnel details are not stored in the legacy resources, they are read from dbt Cloud at the next refresh
+func (l legacyConnection) globalConnectionModel(
+	legacyState map[string]any,
+) (*GlobalConnectionResourceModel, error) {
+
+	adapterName, mapping, err := l.adapter(legacyState)
+	if err != nil {
+		return nil, err
+	}
+	adapter, ok := adapterForVersion(adapterName + "_v0")
+	if !ok {
+		return nil, fmt.Errorf("the adapter %s is not supported", adapterName)
+	}
+
+	connectionID, err := legacyConnectionID(legacyState)
+	if err != nil {
+		return nil, err
+	}
+
+	name, _ := legacyState["name"].(string)
+	privateLinkEndpointID := types.StringNull()
+	if value, _ := legacyState["private_link_endpoint_id"].(string); value != "" {
+		privateLinkEndpointID = types.StringValue(value)
+	}
+	tunnelEnabled, _ := legacyState["tunnel_enabled"].(bool)
+
+	config := adapter.emptyConfig()
+	for _, field := range adapter.fields {
+		value, err := field.fromLegacy(legacyState[mapping[field.name]])
+		if err != nil {
+			return nil, err
+		}
+		config[field.name] = value
+	}
+
+	model := &GlobalConnectionResourceModel{
+		ID:                    types.Int64Value(connectionID),
+		AdapterVersion:        types.StringValue(adapter.defaultAdapterVersion()),
+		Name:                  types.StringValue(name),
+		IsSshTunnelEnabled:    types.BoolValue(tunnelEnabled),
+		PrivateLinkEndpointId: privateLinkEndpointID,
+		OauthConfigurationId:  types.Int64Null(),
+		ValidateOnApply:       types.BoolValue(false),
+		Configs:               map[string]map[string]attr.Value{adapter.name: config},
+	}
+	syncAdapterVersions(model)
+
+	return model, nil
+}
+
+// legacyConnectionID returns the ID of the connection from the legacy ID formatted as <project_id>:<connection_id>
+func legacyConnectionID(legacyState map[string]any) (int64, error) {
+	legacyID, _ := legacyState["id"].(string)
+
+	idParts := strings.Split(legacyID, dbt_cloud.ID_DELIMITER)
+	connectionID, err := strconv.ParseInt(idParts[len(idParts)-1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("the ID %q is not a valid connection ID", legacyID)
+	}
+	return connectionID, nil
+}
+
+// fromLegacy converts a value of the legacy state, the empty values of the SDKv2 resources are replaced by the default or by null
+func (f adapterField) fromLegacy(value any) (attr.Value, error) {
+	switch typedValue := value.(type) {
+	case nil:
+		return f.defaultAttrValue(), nil
+	case string:
+		if typedValue == "" {
+			return f.defaultAttrValue(), nil
+		}
+	case json.Number:
+		if typedValue.String() == "0" {
+			return f.defaultAttrValue(), nil
+		}
+	}
+	return f.fromAPI(value)
+}
+
+// defaultAttrValue returns the default value of the field as set by the schema, or null when there is none
+func (f adapterField) defaultAttrValue() attr.Value {
+	if f.defaultValue == nil {
+		return f.kind.nullValue()
+	}
+
+	switch f.kind {
+	case int64Field:
+		return types.Int64Value(f.defaultValue.(int64))
+	case boolField:
+		return types.BoolValue(f.defaultValue.(bool))
+	case stringSetField:
+		return types.SetValueMust(types.StringType, stringSetElements(f.defaultValue.([]string)))
+	default:
+		return types.StringValue(f.defaultValue.(string))
+	}
+}
@@ -0,0 +1,130 @@
+package global_connection_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDbtCloudGlobalConnectionMoveStateFromConnection(t *testing.T) {
+	projectName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// moved blocks between resource types were added in Terraform 1.8
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudGlobalConnectionLegacyConnectionConfig(
+					projectName,
+					connectionName,
+				),
+			},
+			// the connection is moved without being updated or recreated
+			{
+				Config: testAccDbtCloudGlobalConnectionMovedConnectionConfig(
+					projectName,
+					connectionName,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_global_connection.test",
+							plancheck.ResourceActionNoop,
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_global_connection.test",
+						"id",
+						"dbtcloud_environment.test",
+						"connection_id",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"snowflake.oauth_client_secret",
+						"secret",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudGlobalConnectionLegacyConnectionConfig(
+	projectName, connectionName string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test" {
+  name = "%s"
+}
+
+resource "dbtcloud_connection" "test" {
+  name                = "%s"
+  type                = "snowflake"
+  project_id          = dbtcloud_project.test.id
+  account             = "test"
+  database            = "db"
+  warehouse           = "wh"
+  role                = "user"
+  allow_sso           = true
+  oauth_client_id     = "client"
+  oauth_client_secret = "secret"
+}
+
+resource "dbtcloud_environment" "test" {
+  name          = "Deployment"
+  type          = "deployment"
+  dbt_version   = "%s"
+  project_id    = dbtcloud_project.test.id
+  connection_id = dbtcloud_connection.test.connection_id
+}
+`, projectName, connectionName, acctest_helper.DBT_CLOUD_VERSION)
+}
+
+func testAccDbtCloudGlobalConnectionMovedConnectionConfig(
+	projectName, connectionName string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test" {
+  name = "%s"
+}
+
+moved {
+  from = dbtcloud_connection.test
+  to   = dbtcloud_global_connection.test
+}
+
+resource "dbtcloud_global_connection" "test" {
+  name = "%s"
+
+  snowflake = {
+    account             = "test"
+    database            = "db"
+    warehouse           = "wh"
+    role                = "user"
+    allow_sso           = true
+    oauth_client_id     = "client"
+    oauth_client_secret = "secret"
+  }
+}
+
+resource "dbtcloud_environment" "test" {
+  name          = "Deployment"
+  type          = "deployment"
+  dbt_version   = "%s"
+  project_id    = dbtcloud_project.test.id
+  connection_id = dbtcloud_global_connection.test.id
+}
+`, projectName, connectionName, acctest_helper.DBT_CLOUD_VERSION)
+}
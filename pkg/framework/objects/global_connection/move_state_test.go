@@ -0,0 +1,244 @@
+package global_connection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMoveState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	resourceSchema := resource.SchemaResponse{}
+	(&globalConnectionResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+	type testCase struct {
+		name           string
+		sourceTypeName string
+		rawState       string
+		expectMoved    bool
+		expectError    bool
+		expectAdapter  string
+		expectConfig   map[string]attr.Value
+		expectCommon   GlobalConnectionResourceModel
+	}
+
+	testCases := []testCase{
+		{
+			name:           "snowflake connection",
+			sourceTypeName: "dbtcloud_connection",
+			rawState: `{
+				"id": "100:200", "connection_id": 200, "project_id": 100, "name": "snowflake", "type": "snowflake",
+				"is_active": true, "private_link_endpoint_id": "", "account": "acme", "database": "analytics",
+				"warehouse": "transforming", "role": "", "allow_sso": true, "allow_keep_alive": false,
+				"oauth_client_id": "client", "oauth_client_secret": "secret", "tunnel_enabled": false,
+				"host_name": "", "port": 0, "http_path": "", "catalog": "", "adapter_id": 0
+			}`,
+			expectMoved:   true,
+			expectAdapter: "snowflake",
+			expectConfig: map[string]attr.Value{
+				"adapter_version":           types.StringValue("snowflake_v0"),
+				"account":                   types.StringValue("acme"),
+				"database":                  types.StringValue("analytics"),
+				"warehouse":                 types.StringValue("transforming"),
+				"role":                      types.StringNull(),
+				"allow_sso":                 types.BoolValue(true),
+				"client_session_keep_alive": types.BoolValue(false),
+				"oauth_client_id":           types.StringValue("client"),
+				"oauth_client_secret":       types.StringValue("secret"),
+			},
+			expectCommon: GlobalConnectionResourceModel{
+				ID:                    types.Int64Value(200),
+				Name:                  types.StringValue("snowflake"),
+				IsSshTunnelEnabled:    types.BoolValue(false),
+				PrivateLinkEndpointId: types.StringNull(),
+			},
+		},
+		{
+			name:           "alloydb connection with SSH tunnel",
+			sourceTypeName: "dbtcloud_connection",
+			rawState: `{
+				"id": "100:201", "connection_id": 201, "project_id": 100, "name": "alloydb", "type": "alloydb",
+				"private_link_endpoint_id": "ple", "host_name": "alloydb.example.com", "port": 5433,
+				"database": "warehouse", "tunnel_enabled": true
+			}`,
+			expectMoved:   true,
+			expectAdapter: "postgres",
+			expectConfig: map[string]attr.Value{
+				"adapter_version": types.StringValue("postgres_v0"),
+				"hostname":        types.StringValue("alloydb.example.com"),
+				"port":            types.Int64Value(5433),
+				"dbname":          types.StringValue("warehouse"),
+				"ssh_tunnel":      types.ObjectNull(sshTunnelAttrTypes),
+			},
+			expectCommon: GlobalConnectionResourceModel{
+				ID:                    types.Int64Value(201),
+				Name:                  types.StringValue("alloydb"),
+				IsSshTunnelEnabled:    types.BoolValue(true),
+				PrivateLinkEndpointId: types.StringValue("ple"),
+			},
+		},
+		{
+			name:           "databricks connection",
+			sourceTypeName: "dbtcloud_connection",
+			rawState: `{
+				"id": "100:202", "connection_id": 202, "name": "databricks", "type": "adapter",
+				"host_name": "databricks.example.com", "http_path": "/sql/1.0/warehouses/abc", "catalog": "",
+				"oauth_client_id": "", "oauth_client_secret": "", "port": 0
+			}`,
+			expectMoved:   true,
+			expectAdapter: "databricks",
+			expectConfig: map[string]attr.Value{
+				"adapter_version": types.StringValue("databricks_v0"),
+				"host":            types.StringValue("databricks.example.com"),
+				"http_path":       types.StringValue("/sql/1.0/warehouses/abc"),
+				"catalog":         types.StringNull(),
+				"client_id":       types.StringNull(),
+				"client_secret":   types.StringNull(),
+			},
+			expectCommon: GlobalConnectionResourceModel{
+				ID:                    types.Int64Value(202),
+				Name:                  types.StringValue("databricks"),
+				IsSshTunnelEnabled:    types.BoolValue(false),
+				PrivateLinkEndpointId: types.StringNull(),
+			},
+		},
+		{
+			name:           "bigquery connection",
+			sourceTypeName: "dbtcloud_bigquery_connection",
+			rawState: `{
+				"id": "100:203", "connection_id": 203, "name": "bigquery", "type": "bigquery",
+				"gcp_project_id": "gcp-project", "timeout_seconds": 100, "private_key_id": "key-id",
+				"private_key": "key", "client_email": "sa@example.com", "client_id": "123",
+				"auth_uri": "auth", "token_uri": "token", "auth_provider_x509_cert_url": "provider-cert",
+				"client_x509_cert_url": "client-cert", "retries": 3, "location": "", "maximum_bytes_billed": 0,
+				"priority": "batch", "application_id": "", "application_secret": ""
+			}`,
+			expectMoved:   true,
+			expectAdapter: "bigquery",
+			expectConfig: map[string]attr.Value{
+				"adapter_version":      types.StringValue("bigquery_v0"),
+				"gcp_project_id":       types.StringValue("gcp-project"),
+				"timeout_seconds":      types.Int64Value(100),
+				"private_key":          types.StringValue("key"),
+				"retries":              types.Int64Value(3),
+				"location":             types.StringNull(),
+				"maximum_bytes_billed": types.Int64Null(),
+				"priority":             types.StringValue("batch"),
+				"application_id":       types.StringNull(),
+				"scopes": types.SetValueMust(
+					types.StringType,
+					stringSetElements([]string{
+						"https://www.googleapis.com/auth/bigquery",
+						"https://www.googleapis.com/auth/cloud-platform",
+						"https://www.googleapis.com/auth/drive",
+					}),
+				),
+			},
+			expectCommon: GlobalConnectionResourceModel{
+				ID:                    types.Int64Value(203),
+				Name:                  types.StringValue("bigquery"),
+				IsSshTunnelEnabled:    types.BoolValue(false),
+				PrivateLinkEndpointId: types.StringNull(),
+			},
+		},
+		{
+			name:           "fabric connection",
+			sourceTypeName: "dbtcloud_fabric_connection",
+			rawState: `{
+				"id": "100:204", "connection_id": 204, "name": "fabric", "server": "fabric.example.com",
+				"port": 1234, "database": "db", "retries": 1, "login_timeout": 0, "query_timeout": 60
+			}`,
+			expectMoved:   true,
+			expectAdapter: "fabric",
+			expectConfig: map[string]attr.Value{
+				"adapter_version": types.StringValue("fabric_v0"),
+				"server":          types.StringValue("fabric.example.com"),
+				"port":            types.Int64Value(1234),
+				"database":        types.StringValue("db"),
+				"retries":         types.Int64Value(1),
+				"login_timeout":   types.Int64Value(0),
+				"query_timeout":   types.Int64Value(60),
+			},
+			expectCommon: GlobalConnectionResourceModel{
+				ID:                    types.Int64Value(204),
+				Name:                  types.StringValue("fabric"),
+				IsSshTunnelEnabled:    types.BoolValue(false),
+				PrivateLinkEndpointId: types.StringNull(),
+			},
+		},
+		{
+			name:           "bigquery type of dbtcloud_connection",
+			sourceTypeName: "dbtcloud_connection",
+			rawState:       `{"id": "100:205", "connection_id": 205, "name": "bigquery", "type": "bigquery"}`,
+			expectError:    true,
+		},
+		{
+			name:           "other resource",
+			sourceTypeName: "dbtcloud_project",
+			rawState:       `{"id": "100", "name": "project"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		resp := resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: resourceSchema.Schema,
+				Raw:    tftypes.NewValue(resourceSchema.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		req := resource.MoveStateRequest{
+			SourceProviderAddress: "registry.terraform.io/dbt-labs/dbtcloud",
+			SourceTypeName:        testCase.sourceTypeName,
+			SourceRawState:        &tfprotov6.RawState{JSON: []byte(testCase.rawState)},
+		}
+
+		for _, stateMover := range (&globalConnectionResource{}).MoveState(ctx) {
+			stateMover.StateMover(ctx, req, &resp)
+		}
+
+		if resp.Diagnostics.HasError() != testCase.expectError {
+			t.Fatalf("%s: unexpected diagnostics: %v", testCase.name, resp.Diagnostics)
+		}
+		if resp.TargetState.Raw.IsNull() == testCase.expectMoved {
+			t.Fatalf("%s: expected the state to be moved: %t", testCase.name, testCase.expectMoved)
+		}
+		if !testCase.expectMoved {
+			continue
+		}
+
+		model, diags := getGlobalConnectionModel(ctx, resp.TargetState)
+		if diags.HasError() {
+			t.Fatalf("%s: getting the moved state: %v", testCase.name, diags)
+		}
+
+		if !model.ID.Equal(testCase.expectCommon.ID) ||
+			!model.Name.Equal(testCase.expectCommon.Name) ||
+			!model.IsSshTunnelEnabled.Equal(testCase.expectCommon.IsSshTunnelEnabled) ||
+			!model.PrivateLinkEndpointId.Equal(testCase.expectCommon.PrivateLinkEndpointId) ||
+			!model.OauthConfigurationId.IsNull() {
+			t.Errorf("%s: unexpected common attributes: %+v", testCase.name, model)
+		}
+		if !model.AdapterVersion.Equal(testCase.expectConfig["adapter_version"]) {
+			t.Errorf("%s: unexpected adapter version: %s", testCase.name, model.AdapterVersion)
+		}
+
+		config, ok := model.Configs[testCase.expectAdapter]
+		if !ok || len(model.Configs) != 1 {
+			t.Fatalf("%s: expected only the %s config block, got %v", testCase.name, testCase.expectAdapter, model.Configs)
+		}
+		for name, expectedValue := range testCase.expectConfig {
+			if !config[name].Equal(expectedValue) {
+				t.Errorf("%s: %s is %s, expected %s", testCase.name, name, config[name], expectedValue)
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package global_connection
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/samber/lo"
+)
+
+// validatePrivateLinkEndpoint checks that the PrivateLink endpoint exists and can be used by the adapter of the connection
+func (r globalConnectionResource) validatePrivateLinkEndpoint(
+	plan *GlobalConnectionResourceModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.client == nil ||
+		plan.PrivateLinkEndpointId.IsNull() ||
+		plan.PrivateLinkEndpointId.IsUnknown() {
+		return diags
+	}
+
+	adapter, _, ok := activeAdapter(plan)
+	if !ok || len(adapter.privateLinkEndpointTypes) == 0 {
+		return diags
+	}
+
+	endpoints, err := r.client.GetPrivatelinkEndpoints()
+	if err != nil {
+		diags.AddError("Unable to retrieve the PrivateLink endpoints", "Error: "+err.Error())
+		return diags
+	}
+
+	endpointID := plan.PrivateLinkEndpointId.ValueString()
+	endpoint, found := lo.Find(endpoints, func(endpoint dbt_cloud.PrivatelinkEndpoint) bool {
+		return endpoint.ID == endpointID
+	})
+	if !found {
+		diags.AddAttributeError(
+			path.Root("private_link_endpoint_id"),
+			"PrivateLink endpoint not found",
+			fmt.Sprintf(
+				"The PrivateLink endpoint %s doesn't exist in the account, the data source `dbtcloud_privatelink_endpoints` lists the available endpoints",
+				endpointID,
+			),
+		)
+		return diags
+	}
+
+	if !lo.ContainsBy(adapter.privateLinkEndpointTypes, func(endpointType string) bool {
+		return strings.EqualFold(endpointType, endpoint.Type)
+	}) {
+		diags.AddAttributeError(
+			path.Root("private_link_endpoint_id"),
+			"Invalid PrivateLink endpoint type",
+			fmt.Sprintf(
+				"The PrivateLink endpoint %s (%s) is of type %s and can't be used with a %s connection, the expected type is %s",
+				endpointID,
+				endpoint.Name,
+				endpoint.Type,
+				adapter.name,
+				strings.Join(adapter.privateLinkEndpointTypes, " or "),
+			),
+		)
+	}
+
+	return diags
+}
@@ -8,17 +8,23 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccDbtCloudGlobalConnectionSnowflakeResource(t *testing.T) {
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
 	oAuthClientID := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	oAuthClientSecret := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		// the client secret of dbtcloud_oauth_configuration is write-only
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
 		Steps: []resource.TestStep{
 			// create with just mandatory fields
 			{
@@ -180,8 +186,8 @@ resource dbtcloud_global_connection test {
 }
 
 func TestAccDbtCloudGlobalConnectionBigQueryResource(t *testing.T) {
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -341,8 +347,8 @@ resource dbtcloud_global_connection test {
 }
 
 func TestAccDbtCloudGlobalConnectionDatabricksResource(t *testing.T) {
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
 	oAuthClientID := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	oAuthClientSecret := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
@@ -476,8 +482,8 @@ resource dbtcloud_global_connection test {
 
 func TestAccDbtCloudGlobalConnectionRedshiftResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -617,8 +623,8 @@ resource dbtcloud_global_connection test {
 
 func TestAccDbtCloudGlobalConnectionPostgresResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -758,8 +764,8 @@ resource dbtcloud_global_connection test {
 
 func TestAccDbtCloudGlobalConnectionFabricResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -876,8 +882,8 @@ resource dbtcloud_global_connection test {
 
 func TestAccDbtCloudGlobalConnectionSynapseResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -994,8 +1000,8 @@ resource dbtcloud_global_connection test {
 
 func TestAccDbtCloudGlobalConnectionStarburstResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -1106,8 +1112,8 @@ resource dbtcloud_global_connection test {
 
 func TestAccDbtCloudGlobalConnectionAthenaResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -1229,8 +1235,8 @@ resource dbtcloud_global_connection test {
 
 func TestAccDbtCloudGlobalConnectionApacheSparkResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -1347,3 +1353,243 @@ resource dbtcloud_global_connection test {
 }
 `, connectionName)
 }
+
+func TestAccDbtCloudGlobalConnectionTeradataResource(t *testing.T) {
+
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create with just mandatory fields
+			{
+				Config: testAccDbtCloudSGlobalConnectionTeradataResourceBasicConfig(
+					connectionName,
+				),
+				// we check the computed values, for the other ones the test suite already checks that the plan and state are the same
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_global_connection.test",
+						"id",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"adapter_version",
+						"teradata_v0",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"teradata.port",
+						"1025",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"teradata.tmode",
+						"ANSI",
+					),
+				),
+			},
+			// modify, adding optional fields
+			{
+				Config: testAccDbtCloudSGlobalConnectionTeradataResourceFullConfig(
+					connectionName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_global_connection.test",
+						"id",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"adapter_version",
+						"teradata_v0",
+					),
+				),
+			},
+			// IMPORT WITH ALL FIELDS
+			{
+				ResourceName:            "dbtcloud_global_connection.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+			// modify, removing optional fields to check PATCH when we remove fields
+			{
+				Config: testAccDbtCloudSGlobalConnectionTeradataResourceBasicConfig(
+					connectionName2,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_global_connection.test",
+						"id",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"adapter_version",
+						"teradata_v0",
+					),
+				),
+			},
+			// IMPORT SUBSET
+			{
+				ResourceName:            "dbtcloud_global_connection.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+
+}
+
+func testAccDbtCloudSGlobalConnectionTeradataResourceBasicConfig(
+	connectionName string,
+) string {
+	return fmt.Sprintf(`
+
+resource dbtcloud_global_connection test {
+  name = "%s"
+
+  teradata = {
+    host = "teradata.com"
+  }
+}
+
+`, connectionName)
+}
+
+func testAccDbtCloudSGlobalConnectionTeradataResourceFullConfig(
+	connectionName string,
+) string {
+	return fmt.Sprintf(`
+resource dbtcloud_global_connection test {
+  name = "%s"
+
+  teradata = {
+    host = "teradata.com"
+	// optional fields
+    port = 1026
+    tmode = "TERA"
+    retries = 3
+    request_timeout = 100
+  }
+}
+`, connectionName)
+}
+
+func TestAccDbtCloudGlobalConnectionAdapterVersion(t *testing.T) {
+
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create with the default version
+			{
+				Config: testAccDbtCloudSGlobalConnectionAdapterVersionConfig(connectionName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"adapter_version",
+						"databricks_v0",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"databricks.adapter_version",
+						"databricks_v0",
+					),
+				),
+			},
+			// upgrade in place
+			{
+				Config: testAccDbtCloudSGlobalConnectionAdapterVersionConfig(
+					connectionName,
+					"databricks_v1",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_global_connection.test",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"adapter_version",
+						"databricks_v1",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"databricks.adapter_version",
+						"databricks_v1",
+					),
+				),
+			},
+			// removing the version from the config keeps the current one
+			{
+				Config: testAccDbtCloudSGlobalConnectionAdapterVersionConfig(connectionName, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbtcloud_global_connection.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+			// downgrade recreates the connection
+			{
+				Config: testAccDbtCloudSGlobalConnectionAdapterVersionConfig(
+					connectionName,
+					"databricks_v0",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_global_connection.test",
+							plancheck.ResourceActionReplace,
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"adapter_version",
+						"databricks_v0",
+					),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccDbtCloudSGlobalConnectionAdapterVersionConfig(
+	connectionName, adapterVersion string,
+) string {
+	adapterVersionConfig := ""
+	if adapterVersion != "" {
+		adapterVersionConfig = fmt.Sprintf(`adapter_version = "%s"`, adapterVersion)
+	}
+
+	return fmt.Sprintf(`
+resource dbtcloud_global_connection test {
+  name = "%s"
+
+  databricks = {
+    host = "databricks.com"
+    http_path = "/sql/your/http/path"
+    %s
+  }
+}
+`, connectionName, adapterVersionConfig)
+}
@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccDbtCloudGroupDataSource(t *testing.T) {
 
-	groupName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	groupName := acctest_helper.RandomName()
 
 	config := group(groupName)
 
@@ -24,6 +23,7 @@ func TestAccDbtCloudGroupDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -0,0 +1,90 @@
+package ip_restrictions_rule
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ipRestrictionsRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ipRestrictionsRulesDataSource{}
+)
+
+func IPRestrictionsRulesDataSource() datasource.DataSource {
+	return &ipRestrictionsRulesDataSource{}
+}
+
+type ipRestrictionsRulesDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *ipRestrictionsRulesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_ip_restrictions_rules"
+}
+
+func (d *ipRestrictionsRulesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state IPRestrictionsRulesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	rules, err := d.client.GetIPRestrictions()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading IP Restrictions Rules",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Rules = []IPRestrictionsRuleDataSourceModel{}
+	for _, rule := range *rules {
+		cidrs := make([]CidrModel, 0, len(rule.Cidrs))
+		for _, cidr := range rule.Cidrs {
+			cidrs = append(cidrs, CidrModel{
+				Cidr:                types.StringValue(cidr.Cidr),
+				CidrIpv6:            types.StringValue(cidr.CidrIpv6),
+				ID:                  types.Int64Value(cidr.ID),
+				IPRestrictionRuleID: types.Int64Value(cidr.IPRestrictionRuleID),
+			})
+		}
+
+		state.Rules = append(state.Rules, IPRestrictionsRuleDataSourceModel{
+			ID:                      types.Int64Value(rule.ID),
+			Name:                    types.StringValue(rule.Name),
+			Type:                    types.StringValue(ipRestrictionTypeIDToNameMapping[rule.Type]),
+			Description:             types.StringValue(rule.Description),
+			RuleSetEnabled:          types.BoolValue(rule.RuleSetEnabled),
+			EnabledForServiceTokens: types.BoolPointerValue(rule.EnabledForServiceTokens),
+			Cidrs:                   cidrs,
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *ipRestrictionsRulesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,58 @@
+package ip_restrictions_rule_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudIPRestrictionsRulesDataSource(t *testing.T) {
+	ruleName := strings.ToUpper(acctest_helper.RandomName())
+
+	config := fmt.Sprintf(`
+resource "dbtcloud_ip_restrictions_rule" "test" {
+	name             = "%s"
+	type             = "allow"
+	description      = "Test IP restriction rule for the data source"
+	rule_set_enabled = false
+	cidrs = [
+		{
+			cidr = "10.1.0.0/24"
+		}
+	]
+}
+
+data "dbtcloud_ip_restrictions_rules" "all" {
+	depends_on = [dbtcloud_ip_restrictions_rule.test]
+}
+`, ruleName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.dbtcloud_ip_restrictions_rules.all",
+						"rules.#",
+					),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.dbtcloud_ip_restrictions_rules.all",
+						"rules.*",
+						map[string]string{
+							"name":             ruleName,
+							"type":             "allow",
+							"rule_set_enabled": "false",
+							"cidrs.#":          "1",
+						},
+					),
+				),
+			},
+		},
+	})
+}
@@ -14,6 +14,20 @@ type IPRestrictionsRuleResourceModel struct {
 	Cidrs          []CidrModel  `tfsdk:"cidrs"`
 }
 
+type IPRestrictionsRulesDataSourceModel struct {
+	Rules []IPRestrictionsRuleDataSourceModel `tfsdk:"rules"`
+}
+
+type IPRestrictionsRuleDataSourceModel struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Type                    types.String `tfsdk:"type"`
+	Description             types.String `tfsdk:"description"`
+	RuleSetEnabled          types.Bool   `tfsdk:"rule_set_enabled"`
+	EnabledForServiceTokens types.Bool   `tfsdk:"enabled_for_service_tokens"`
+	Cidrs                   []CidrModel  `tfsdk:"cidrs"`
+}
+
 type CidrModel struct {
 	Cidr                types.String `tfsdk:"cidr"`
 	CidrIpv6            types.String `tfsdk:"cidr_ipv6"`
@@ -101,11 +101,20 @@ func (r *ipRestrictionsRuleResource) Create(
 		return
 	}
 
+	ruleSetEnabled, err := r.ruleSetEnabled(plan.RuleSetEnabled)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading IP Restrictions Settings",
+			err.Error(),
+		)
+		return
+	}
+
 	ipRestriction := dbt_cloud.IPRestrictionsRule{
 		Name:           plan.Name.ValueString(),
 		Type:           ipRestrictionTypeNameToIDMapping[plan.Type.ValueString()],
 		Description:    plan.Description.ValueString(),
-		RuleSetEnabled: plan.RuleSetEnabled.ValueBool(),
+		RuleSetEnabled: ruleSetEnabled,
 		Cidrs:          make([]dbt_cloud.Cidrs, 0, len(plan.Cidrs)),
 	}
 
@@ -128,6 +137,7 @@ func (r *ipRestrictionsRuleResource) Create(
 	}
 
 	plan.ID = types.Int64Value(created.ID)
+	plan.RuleSetEnabled = types.BoolValue(created.RuleSetEnabled)
 	plan.Cidrs = make([]CidrModel, 0, len(created.Cidrs))
 
 	for _, cidr := range created.Cidrs {
@@ -162,12 +172,21 @@ func (r *ipRestrictionsRuleResource) Update(
 		return
 	}
 
+	ruleSetEnabled, err := r.ruleSetEnabled(plan.RuleSetEnabled)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading IP Restrictions Settings",
+			err.Error(),
+		)
+		return
+	}
+
 	ipRestrictionsRule := dbt_cloud.IPRestrictionsRule{
 		ID:             plan.ID.ValueInt64(),
 		Name:           plan.Name.ValueString(),
 		Type:           ipRestrictionTypeNameToIDMapping[plan.Type.ValueString()],
 		Description:    plan.Description.ValueString(),
-		RuleSetEnabled: plan.RuleSetEnabled.ValueBool(),
+		RuleSetEnabled: ruleSetEnabled,
 		Cidrs:          []dbt_cloud.Cidrs{},
 	}
 
@@ -208,6 +227,7 @@ func (r *ipRestrictionsRuleResource) Update(
 		)
 		return
 	}
+	plan.RuleSetEnabled = types.BoolValue(created.RuleSetEnabled)
 	plan.Cidrs = make([]CidrModel, 0, len(created.Cidrs))
 
 	for _, cidr := range created.Cidrs {
@@ -223,6 +243,20 @@ func (r *ipRestrictionsRuleResource) Update(
 	resp.Diagnostics.Append(diags...)
 }
 
+// ruleSetEnabled returns the configured rule_set_enabled or, when it is not set, the current value of the rule set
+// so that the rules don't change the enforcement managed by dbtcloud_ip_restrictions_settings
+func (r *ipRestrictionsRuleResource) ruleSetEnabled(value types.Bool) (bool, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool(), nil
+	}
+
+	settings, err := r.client.GetIPRestrictionsSettings()
+	if err != nil {
+		return false, err
+	}
+	return settings.RuleSetEnabled, nil
+}
+
 func (r *ipRestrictionsRuleResource) Delete(
 	ctx context.Context,
 	req resource.DeleteRequest,
@@ -5,8 +5,11 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -48,8 +51,13 @@ func (r *ipRestrictionsRuleResource) Schema(
 				Description: "A description of the IP restriction rule",
 			},
 			"rule_set_enabled": schema.BoolAttribute{
-				Required:    true,
-				Description: "Whether the IP restriction rule set is enabled or not. Important!: This value needs to be the same for all rules if multiple rules are defined. All rules must be active or inactive at the same time.",
+				Optional:           true,
+				Computed:           true,
+				Description:        "Whether the IP restriction rule set is enabled or not. Important!: This value needs to be the same for all rules if multiple rules are defined. All rules must be active or inactive at the same time. When not set, the rule keeps the current value of the rule set",
+				DeprecationMessage: "Use `enabled` in the `dbtcloud_ip_restrictions_settings` resource to enforce the rules, this attribute should not be set when using it",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"cidrs": schema.SetNestedAttribute{
 				Required:    true,
@@ -79,3 +87,71 @@ func (r *ipRestrictionsRuleResource) Schema(
 		},
 	}
 }
+
+func (d *ipRestrictionsRulesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasource_schema.Schema{
+		Description: "Retrieve all the IP restriction rules of the account",
+		Attributes: map[string]datasource_schema.Attribute{
+			"rules": datasource_schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "Set of IP restriction rules",
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the IP restriction rule",
+						},
+						"name": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the IP restriction rule",
+						},
+						"type": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the IP restriction rule (allow or deny)",
+						},
+						"description": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "A description of the IP restriction rule",
+						},
+						"rule_set_enabled": datasource_schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the IP restriction rule set is enabled or not",
+						},
+						"enabled_for_service_tokens": datasource_schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the IP restriction rule set also applies to service tokens",
+						},
+						"cidrs": datasource_schema.SetNestedAttribute{
+							Computed:    true,
+							Description: "Set of CIDR ranges for this rule",
+							NestedObject: datasource_schema.NestedAttributeObject{
+								Attributes: map[string]datasource_schema.Attribute{
+									"cidr": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "IP CIDR range (can be IPv4 or IPv6)",
+									},
+									"cidr_ipv6": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "IPv6 CIDR range",
+									},
+									"id": datasource_schema.Int64Attribute{
+										Computed:    true,
+										Description: "ID of the CIDR range",
+									},
+									"ip_restriction_rule_id": datasource_schema.Int64Attribute{
+										Computed:    true,
+										Description: "ID of the IP restriction rule",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,138 @@
+package ip_restrictions_settings
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+)
+
+const (
+	defaultRunnerIPLookupURL = "https://checkip.amazonaws.com"
+
+	ipRestrictionTypeAllow = 1
+	ipRestrictionTypeDeny  = 2
+)
+
+// lookupRunnerIP returns the public IP address of the machine running Terraform
+func lookupRunnerIP(lookupURL string) (string, error) {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	resp, err := httpClient.Get(lookupURL)
+	if err != nil {
+		return "", fmt.Errorf("unable to get the IP address of the Terraform runner from %s: %w", lookupURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf(
+			"unable to get the IP address of the Terraform runner from %s: HTTP status %d",
+			lookupURL,
+			resp.StatusCode,
+		)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 256))
+	if err != nil {
+		return "", err
+	}
+
+	runnerIP := strings.TrimSpace(string(body))
+	if net.ParseIP(runnerIP) == nil {
+		return "", fmt.Errorf("the value returned by %s is not a valid IP address: %q", lookupURL, runnerIP)
+	}
+	return runnerIP, nil
+}
+
+// parseCidr parses a CIDR range, single IP addresses are converted to a range containing only them
+func parseCidr(value string) (*net.IPNet, error) {
+	if !strings.Contains(value, "/") {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("%q is not a valid IP address or CIDR range", value)
+		}
+		if ip.To4() != nil {
+			value = value + "/32"
+		} else {
+			value = value + "/128"
+		}
+	}
+
+	_, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid IP address or CIDR range", value)
+	}
+	return ipNet, nil
+}
+
+// cidrContains returns true if all the addresses of inner are part of outer
+func cidrContains(outer, inner *net.IPNet) bool {
+	outerOnes, outerBits := outer.Mask.Size()
+	innerOnes, innerBits := inner.Mask.Size()
+	return outerBits == innerBits && outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+// cidrOverlaps returns true if some addresses are part of both ranges
+func cidrOverlaps(a, b *net.IPNet) bool {
+	return cidrContains(a, b) || cidrContains(b, a)
+}
+
+// ruleCidrs returns the IPv4 and IPv6 ranges of a rule, ignoring the values that can't be parsed
+func ruleCidrs(rule dbt_cloud.IPRestrictionsRule) []*net.IPNet {
+	cidrs := []*net.IPNet{}
+	for _, cidr := range rule.Cidrs {
+		for _, value := range []string{cidr.Cidr, cidr.CidrIpv6} {
+			if value == "" {
+				continue
+			}
+			ipNet, err := parseCidr(value)
+			if err == nil {
+				cidrs = append(cidrs, ipNet)
+			}
+		}
+	}
+	return cidrs
+}
+
+// checkRunnerAllowed returns an error if one of the runner ranges would be blocked once the rules are enforced
+func checkRunnerAllowed(rules dbt_cloud.IPRestrictions, runnerCidrs []string) error {
+	for _, runnerCidr := range runnerCidrs {
+		runnerNet, err := parseCidr(runnerCidr)
+		if err != nil {
+			return err
+		}
+
+		allowed := false
+		for _, rule := range rules {
+			for _, ruleNet := range ruleCidrs(rule) {
+				switch rule.Type {
+				case ipRestrictionTypeDeny:
+					if cidrOverlaps(ruleNet, runnerNet) {
+						return fmt.Errorf(
+							"the Terraform runner address %s is denied by the rule %q (%s)",
+							runnerCidr,
+							rule.Name,
+							ruleNet.String(),
+						)
+					}
+				case ipRestrictionTypeAllow:
+					if cidrContains(ruleNet, runnerNet) {
+						allowed = true
+					}
+				}
+			}
+		}
+
+		if !allowed {
+			return fmt.Errorf(
+				"the Terraform runner address %s is not allowed by any rule",
+				runnerCidr,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package ip_restrictions_settings
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckRunnerAllowed(t *testing.T) {
+	t.Parallel()
+
+	rules := dbt_cloud.IPRestrictions{
+		{
+			Name: "office",
+			Type: ipRestrictionTypeAllow,
+			Cidrs: []dbt_cloud.Cidrs{
+				{Cidr: "10.0.0.0/16"},
+				{CidrIpv6: "2001:db8::/32"},
+			},
+		},
+		{
+			Name: "guest wifi",
+			Type: ipRestrictionTypeDeny,
+			Cidrs: []dbt_cloud.Cidrs{
+				{Cidr: "10.0.99.0/24"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		runnerCidrs []string
+		wantErr     bool
+	}{
+		{"single allowed IP", []string{"10.0.1.12"}, false},
+		{"allowed range", []string{"10.0.1.0/24"}, false},
+		{"allowed IPv6", []string{"2001:db8::1"}, false},
+		{"range larger than the rule", []string{"10.0.0.0/8"}, true},
+		{"denied IP", []string{"10.0.99.5"}, true},
+		{"range overlapping a deny rule", []string{"10.0.0.0/16"}, true},
+		{"unknown IP", []string{"192.168.1.1"}, true},
+		{"one of the runners not allowed", []string{"10.0.1.12", "192.168.1.1"}, true},
+		{"invalid value", []string{"not-an-ip"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkRunnerAllowed(rules, tc.runnerCidrs)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("checkRunnerAllowed() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckLockoutWithoutNetwork(t *testing.T) {
+	t.Parallel()
+
+	r := &ipRestrictionsSettingsResource{}
+	plan := IPRestrictionsSettingsResourceModel{
+		Enabled:           types.BoolValue(true),
+		RunnerCidrs:       types.SetNull(types.StringType),
+		RunnerIPLookupURL: types.StringValue("http://127.0.0.1:1"),
+		SkipLockoutCheck:  types.BoolValue(false),
+	}
+
+	diags := r.checkLockout(plan)
+	if diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Summary(), "Unable to detect") {
+		t.Errorf("expected an error when the runner IP address can't be detected, got %v", diags)
+	}
+}
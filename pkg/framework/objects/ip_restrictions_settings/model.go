@@ -0,0 +1,14 @@
+package ip_restrictions_settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IPRestrictionsSettingsResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Enabled                 types.Bool   `tfsdk:"enabled"`
+	EnabledForServiceTokens types.Bool   `tfsdk:"enabled_for_service_tokens"`
+	RunnerCidrs             types.Set    `tfsdk:"runner_cidrs"`
+	RunnerIPLookupURL       types.String `tfsdk:"runner_ip_lookup_url"`
+	SkipLockoutCheck        types.Bool   `tfsdk:"skip_lockout_check"`
+}
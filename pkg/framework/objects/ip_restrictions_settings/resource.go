@@ -0,0 +1,238 @@
+package ip_restrictions_settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &ipRestrictionsSettingsResource{}
+	_ resource.ResourceWithConfigure   = &ipRestrictionsSettingsResource{}
+	_ resource.ResourceWithImportState = &ipRestrictionsSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &ipRestrictionsSettingsResource{}
+)
+
+func IPRestrictionsSettingsResource() resource.Resource {
+	return &ipRestrictionsSettingsResource{}
+}
+
+type ipRestrictionsSettingsResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *ipRestrictionsSettingsResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_ip_restrictions_settings"
+}
+
+func (r *ipRestrictionsSettingsResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
+
+// checkLockout verifies that the Terraform runner will still be able to reach dbt Cloud once the rules are enforced,
+// it fails when the IP address of the runner can't be detected, e.g. without internet access, as the runners with
+// restricted egress are the most likely to be locked out
+func (r *ipRestrictionsSettingsResource) checkLockout(
+	plan IPRestrictionsSettingsResourceModel,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	var runnerCidrs []string
+	if !plan.RunnerCidrs.IsNull() {
+		runnerCidrs = helper.StringSetToStringSlice(plan.RunnerCidrs)
+	} else {
+		runnerIP, err := lookupRunnerIP(plan.RunnerIPLookupURL.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("runner_ip_lookup_url"),
+				"Unable to detect the IP address of the Terraform runner",
+				err.Error()+". Set `runner_cidrs` to provide the IP addresses of the runner, or set `skip_lockout_check` to `true` if the runner is allowed by other means.",
+			)
+			return diags
+		}
+		runnerCidrs = []string{runnerIP}
+	}
+
+	rules, err := r.client.GetIPRestrictions()
+	if err != nil {
+		diags.AddError("Error reading IP Restrictions Rules", err.Error())
+		return diags
+	}
+
+	err = checkRunnerAllowed(*rules, runnerCidrs)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("enabled"),
+			"Enforcing the IP restrictions would lock out the Terraform runner",
+			fmt.Sprintf(
+				"%s. Update the rules before enforcing them, or set `skip_lockout_check` to `true` if the runner is allowed by other means.",
+				err.Error(),
+			),
+		)
+	}
+	return diags
+}
+
+// ModifyPlan checks for lockouts when the enforcement of the rules is turned on, so that it fails before any change
+func (r *ipRestrictionsSettingsResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan IPRestrictionsSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Enabled.ValueBool() || plan.SkipLockoutCheck.ValueBool() ||
+		plan.RunnerCidrs.IsUnknown() || plan.RunnerIPLookupURL.IsUnknown() {
+		return
+	}
+
+	// the rules are already enforced, there is no lockout to prevent
+	if !req.State.Raw.IsNull() {
+		var state IPRestrictionsSettingsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.Enabled.ValueBool() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.checkLockout(plan)...)
+}
+
+func (r *ipRestrictionsSettingsResource) updateSettings(
+	plan IPRestrictionsSettingsResourceModel,
+) (IPRestrictionsSettingsResourceModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	settings, err := r.client.UpdateIPRestrictionsSettings(dbt_cloud.IPRestrictionsSettings{
+		RuleSetEnabled:          plan.Enabled.ValueBool(),
+		EnabledForServiceTokens: plan.EnabledForServiceTokens.ValueBool(),
+	})
+	if err != nil {
+		diags.AddError("Error updating IP Restrictions Settings", err.Error())
+		return plan, diags
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d", r.client.AccountID))
+	plan.Enabled = types.BoolValue(settings.RuleSetEnabled)
+	plan.EnabledForServiceTokens = types.BoolValue(settings.EnabledForServiceTokens)
+	return plan, diags
+}
+
+func (r *ipRestrictionsSettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan IPRestrictionsSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.updateSettings(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ipRestrictionsSettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state IPRestrictionsSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetIPRestrictionsSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IP Restrictions Settings", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%d", r.client.AccountID))
+	state.Enabled = types.BoolValue(settings.RuleSetEnabled)
+	state.EnabledForServiceTokens = types.BoolValue(settings.EnabledForServiceTokens)
+
+	// those are only used by the provider and are not set after an import
+	if state.RunnerIPLookupURL.IsNull() {
+		state.RunnerIPLookupURL = types.StringValue(defaultRunnerIPLookupURL)
+	}
+	if state.SkipLockoutCheck.IsNull() {
+		state.SkipLockoutCheck = types.BoolValue(false)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ipRestrictionsSettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan IPRestrictionsSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.updateSettings(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ipRestrictionsSettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// no-op, we keep the existing values as we technically can't "delete" the settings, just turn them on and off
+}
+
+func (r *ipRestrictionsSettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,87 @@
+package ip_restrictions_settings_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudIPRestrictionsSettingsResource(t *testing.T) {
+	ruleName := strings.ToUpper(acctest_helper.RandomName())
+
+	// we never enforce the rules in the tests, to avoid locking out the test account
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Settings without enforcement
+			{
+				Config: testAccDbtCloudIPRestrictionsSettingsResourceConfig(ruleName, false, `["10.2.0.1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_ip_restrictions_settings.test",
+						"enabled",
+						"false",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_ip_restrictions_settings.test",
+						"enabled_for_service_tokens",
+						"false",
+					),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_ip_restrictions_settings.test",
+						"id",
+					),
+				),
+			},
+			// Enforcing the rules fails when the runner is not allowed
+			{
+				Config: testAccDbtCloudIPRestrictionsSettingsResourceConfig(ruleName, true, `["192.168.10.1"]`),
+				ExpectError: regexp.MustCompile(
+					"Enforcing the IP restrictions would lock out the Terraform runner",
+				),
+			},
+			// Import
+			{
+				Config:            testAccDbtCloudIPRestrictionsSettingsResourceConfig(ruleName, false, `["10.2.0.1"]`),
+				ResourceName:      "dbtcloud_ip_restrictions_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"runner_cidrs",
+				},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudIPRestrictionsSettingsResourceConfig(
+	ruleName string,
+	enabled bool,
+	runnerCidrs string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_ip_restrictions_rule" "test" {
+	name        = "%s"
+	type        = "allow"
+	description = "Test IP restriction rule for the settings"
+	cidrs = [
+		{
+			cidr = "10.2.0.0/24"
+		}
+	]
+}
+
+resource "dbtcloud_ip_restrictions_settings" "test" {
+	enabled                    = %t
+	enabled_for_service_tokens = false
+	runner_cidrs               = %s
+
+	depends_on = [dbtcloud_ip_restrictions_rule.test]
+}
+`, ruleName, enabled, runnerCidrs)
+}
@@ -0,0 +1,81 @@
+package ip_restrictions_settings
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *ipRestrictionsSettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: helper.DocString(
+			`Manages the account wide enforcement of the IP restriction rules defined with ~~~dbtcloud_ip_restrictions_rule~~~.
+
+			When planning to enforce the rules, the resource checks that the IP addresses of the Terraform runner are allowed by the rules already created in dbt Cloud, to avoid locking the runner out of the dbt Cloud account. The IP addresses can be provided in ~~~runner_cidrs~~~ or are detected by calling ~~~runner_ip_lookup_url~~~, and the plan fails when the address can't be detected (e.g. without internet access). When the rules are created in the same run, they need to be applied before setting ~~~enabled~~~ to ~~~true~~~.
+
+			~> When ~~~runner_cidrs~~~ is not set, planning to enforce the rules sends a request from the runner to ~~~runner_ip_lookup_url~~~, by default the third-party service ~~~https://checkip.amazonaws.com~~~, which sees the public IP address of the runner. Set ~~~runner_cidrs~~~ or ~~~skip_lockout_check~~~ to avoid this external call.
+
+			When destroying the resource, the current enforcement settings are not changed. Deactivating enforcement requires applying the resource with ~~~enabled~~~ set to ~~~false~~~.
+
+			~> This resource manages the ~~~rule_set_enabled~~~ value of all the rules, the deprecated ~~~rule_set_enabled~~~ attribute of the ~~~dbtcloud_ip_restrictions_rule~~~ resources should not be set when using it.`,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the account.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the IP restriction rules are enforced for the account",
+			},
+			"enabled_for_service_tokens": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the IP restriction rules also apply to the API calls made with service tokens - Defaults to `false`",
+			},
+			"runner_cidrs": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The IP addresses or CIDR ranges of the Terraform runners, checked against the rules before enforcing them. When not set, the public IP address of the current runner is detected with `runner_ip_lookup_url`",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ConflictsWith(path.MatchRoot("runner_ip_lookup_url")),
+				},
+			},
+			"runner_ip_lookup_url": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultRunnerIPLookupURL),
+				Description: "The URL returning the public IP address of the Terraform runner as plain text, called when planning to enforce the rules if `runner_cidrs` is not set. The service receives a request from the runner - Defaults to `" + defaultRunnerIPLookupURL + "`",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"skip_lockout_check": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Skip the check that the Terraform runner is allowed by the rules before enforcing them. Only use it if the runner is allowed by some rules not known by the check - Defaults to `false`",
+			},
+		},
+	}
+}
@@ -5,14 +5,13 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestDbtCloudJobsDataSource(t *testing.T) {
 
-	randomJobName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
-	randomJobName2 := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	randomJobName := acctest_helper.RandomName()
+	randomJobName2 := acctest_helper.RandomName()
 
 	config := jobs(randomJobName, randomJobName2)
 
@@ -56,6 +55,7 @@ func TestDbtCloudJobsDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
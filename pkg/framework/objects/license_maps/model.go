@@ -0,0 +1,122 @@
+package license_maps
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+type LicenseMapsResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	LicenseMaps    types.Map    `tfsdk:"license_maps"`
+	LicenseMapIDs  types.Map    `tfsdk:"license_map_ids"`
+	ImportExisting types.Bool   `tfsdk:"import_existing"`
+}
+
+var licenseMapsElementType = types.SetType{ElemType: types.StringType}
+
+// groupByLicenseType returns the SSO groups of the active license maps for each license type
+// an account can have several maps for the same license type, their groups are merged
+func groupByLicenseType(licenseMaps []dbt_cloud.LicenseMap) map[string][]string {
+	groups := map[string][]string{}
+	for _, licenseMap := range licenseMaps {
+		if licenseMap.State != dbt_cloud.STATE_ACTIVE {
+			continue
+		}
+		groups[licenseMap.LicenseType] = lo.Uniq(
+			append(groups[licenseMap.LicenseType], licenseMap.SSOLicenseMappingGroups...),
+		)
+	}
+	for licenseType := range groups {
+		sort.Strings(groups[licenseType])
+	}
+	return groups
+}
+
+// findGroupConflicts returns the SSO groups that are mapped to more than one license type, with those license types
+func findGroupConflicts(groupsByLicenseType map[string][]string) map[string][]string {
+	licenseTypesByGroup := map[string][]string{}
+	for licenseType, groups := range groupsByLicenseType {
+		for _, group := range lo.Uniq(groups) {
+			licenseTypesByGroup[group] = append(licenseTypesByGroup[group], licenseType)
+		}
+	}
+
+	conflicts := map[string][]string{}
+	for group, licenseTypes := range licenseTypesByGroup {
+		if len(licenseTypes) > 1 {
+			sort.Strings(licenseTypes)
+			conflicts[group] = licenseTypes
+		}
+	}
+	return conflicts
+}
+
+// licenseMapsFromModel returns the SSO groups for each license type, the license types with unknown groups are skipped
+func licenseMapsFromModel(
+	ctx context.Context,
+	licenseMaps types.Map,
+) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	groupsByLicenseType := map[string][]string{}
+	if licenseMaps.IsNull() || licenseMaps.IsUnknown() {
+		return groupsByLicenseType, diags
+	}
+
+	for licenseType, value := range licenseMaps.Elements() {
+		groupsSet, ok := value.(types.Set)
+		if !ok || groupsSet.IsUnknown() || groupsSet.IsNull() {
+			continue
+		}
+		groups := []types.String{}
+		diags.Append(groupsSet.ElementsAs(ctx, &groups, false)...)
+
+		knownGroups := []string{}
+		for _, group := range groups {
+			if !group.IsUnknown() && !group.IsNull() {
+				knownGroups = append(knownGroups, group.ValueString())
+			}
+		}
+		groupsByLicenseType[licenseType] = knownGroups
+	}
+	return groupsByLicenseType, diags
+}
+
+// setFromAPI sets the license maps of the account, the ID of the first map of each license type is kept
+func (m *LicenseMapsResourceModel) setFromAPI(
+	ctx context.Context,
+	accountID int,
+	licenseMaps []dbt_cloud.LicenseMap,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	licenseMapIDs := map[string]int64{}
+	for _, licenseMap := range licenseMaps {
+		if licenseMap.State != dbt_cloud.STATE_ACTIVE || licenseMap.ID == nil {
+			continue
+		}
+		if _, ok := licenseMapIDs[licenseMap.LicenseType]; !ok {
+			licenseMapIDs[licenseMap.LicenseType] = int64(*licenseMap.ID)
+		}
+	}
+
+	licenseMapsValue, mapDiags := types.MapValueFrom(
+		ctx,
+		licenseMapsElementType,
+		groupByLicenseType(licenseMaps),
+	)
+	diags.Append(mapDiags...)
+	licenseMapIDsValue, mapDiags := types.MapValueFrom(ctx, types.Int64Type, licenseMapIDs)
+	diags.Append(mapDiags...)
+
+	m.ID = types.StringValue(fmt.Sprintf("%d", accountID))
+	m.LicenseMaps = licenseMapsValue
+	m.LicenseMapIDs = licenseMapIDsValue
+	return diags
+}
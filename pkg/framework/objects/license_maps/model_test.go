@@ -0,0 +1,89 @@
+package license_maps
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+)
+
+func TestFindGroupConflicts(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		groupsByLicenseType map[string][]string
+		expected            map[string][]string
+	}{
+		{
+			name: "no conflict",
+			groupsByLicenseType: map[string][]string{
+				"developer": {"engineers"},
+				"read_only": {"analysts", "finance"},
+			},
+			expected: map[string][]string{},
+		},
+		{
+			name: "group in two license types",
+			groupsByLicenseType: map[string][]string{
+				"developer": {"engineers", "analysts"},
+				"read_only": {"analysts"},
+				"it":        {"admins"},
+			},
+			expected: map[string][]string{
+				"analysts": {"developer", "read_only"},
+			},
+		},
+		{
+			name: "duplicate group in the same license type",
+			groupsByLicenseType: map[string][]string{
+				"developer": {"engineers", "engineers"},
+			},
+			expected: map[string][]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		conflicts := findGroupConflicts(testCase.groupsByLicenseType)
+		if !reflect.DeepEqual(conflicts, testCase.expected) {
+			t.Errorf("%s: expected %v, got %v", testCase.name, testCase.expected, conflicts)
+		}
+	}
+}
+
+func TestGroupByLicenseType(t *testing.T) {
+	t.Parallel()
+
+	licenseMaps := []dbt_cloud.LicenseMap{
+		{
+			LicenseType:             "developer",
+			State:                   dbt_cloud.STATE_ACTIVE,
+			SSOLicenseMappingGroups: []string{"engineers"},
+		},
+		{
+			LicenseType:             "developer",
+			State:                   dbt_cloud.STATE_ACTIVE,
+			SSOLicenseMappingGroups: []string{"analytics-engineers", "engineers"},
+		},
+		{
+			LicenseType:             "read_only",
+			State:                   dbt_cloud.STATE_DELETED,
+			SSOLicenseMappingGroups: []string{"finance"},
+		},
+		{
+			LicenseType:             "it",
+			State:                   dbt_cloud.STATE_ACTIVE,
+			SSOLicenseMappingGroups: []string{},
+		},
+	}
+
+	expected := map[string][]string{
+		"developer": {"analytics-engineers", "engineers"},
+		"it":        {},
+	}
+
+	groups := groupByLicenseType(licenseMaps)
+	if !reflect.DeepEqual(groups, expected) {
+		t.Errorf("expected %v, got %v", expected, groups)
+	}
+}
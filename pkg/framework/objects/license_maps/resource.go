@@ -0,0 +1,374 @@
+package license_maps
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+var (
+	_ resource.Resource                   = &licenseMapsResource{}
+	_ resource.ResourceWithConfigure      = &licenseMapsResource{}
+	_ resource.ResourceWithImportState    = &licenseMapsResource{}
+	_ resource.ResourceWithValidateConfig = &licenseMapsResource{}
+	_ resource.ResourceWithModifyPlan     = &licenseMapsResource{}
+)
+
+func LicenseMapsResource() resource.Resource {
+	return &licenseMapsResource{}
+}
+
+type licenseMapsResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *licenseMapsResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_license_maps"
+}
+
+// checkGroupConflicts returns a diagnostic with the given severity for each SSO group mapped to more than one license type
+func checkGroupConflicts(groupsByLicenseType map[string][]string, severity diag.Severity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conflicts := findGroupConflicts(groupsByLicenseType)
+	groups := lo.Keys(conflicts)
+	sort.Strings(groups)
+
+	for _, group := range groups {
+		summary := "SSO group mapped to several license types"
+		detail := fmt.Sprintf(
+			"The SSO group %q is mapped to the license types %s. The license given to the users of the group would depend on the precedence of the license types in dbt Cloud, map the group to only one license type.",
+			group,
+			strings.Join(conflicts[group], ", "),
+		)
+		if severity == diag.SeverityWarning {
+			diags.AddAttributeWarning(path.Root("license_maps"), summary, detail)
+		} else {
+			diags.AddAttributeError(path.Root("license_maps"), summary, detail)
+		}
+	}
+	return diags
+}
+
+func (r *licenseMapsResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data LicenseMapsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupsByLicenseType, diags := licenseMapsFromModel(ctx, data.LicenseMaps)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(checkGroupConflicts(groupsByLicenseType, diag.SeverityError)...)
+}
+
+// ModifyPlan warns about the conflicts of the license maps adopted or kept from the account when
+// license_maps is not set, as they are not in the config checked by ValidateConfig
+func (r *licenseMapsResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var config, plan LicenseMapsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !config.LicenseMaps.IsNull() {
+		return
+	}
+
+	var groupsByLicenseType map[string][]string
+	if !req.State.Raw.IsNull() {
+		var state LicenseMapsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var diags diag.Diagnostics
+		groupsByLicenseType, diags = licenseMapsFromModel(ctx, state.LicenseMaps)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		if !plan.ImportExisting.ValueBool() {
+			return
+		}
+
+		existing, err := r.client.GetAllLicenseMaps()
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to get all license maps", "Error: "+err.Error())
+			return
+		}
+		groupsByLicenseType = groupByLicenseType(existing)
+	}
+
+	resp.Diagnostics.Append(checkGroupConflicts(groupsByLicenseType, diag.SeverityWarning)...)
+}
+
+// reconcile updates the license maps of the account to match the groups of each license type
+// the first map of each license type is updated, and the other maps are deleted
+func (r *licenseMapsResource) reconcile(
+	desired map[string][]string,
+	existing []dbt_cloud.LicenseMap,
+) error {
+	existingByType := map[string][]dbt_cloud.LicenseMap{}
+	for _, licenseMap := range existing {
+		if licenseMap.State != dbt_cloud.STATE_ACTIVE || licenseMap.ID == nil {
+			continue
+		}
+		existingByType[licenseMap.LicenseType] = append(
+			existingByType[licenseMap.LicenseType],
+			licenseMap,
+		)
+	}
+
+	licenseTypes := lo.Keys(desired)
+	sort.Strings(licenseTypes)
+
+	for _, licenseType := range licenseTypes {
+		groups := desired[licenseType]
+		licenseMaps := existingByType[licenseType]
+
+		if len(licenseMaps) == 0 {
+			_, err := r.client.CreateLicenseMap(licenseType, groups)
+			if err != nil {
+				return fmt.Errorf("error creating the %s license map: %w", licenseType, err)
+			}
+			continue
+		}
+
+		licenseMap := licenseMaps[0]
+		added, removed := lo.Difference(groups, licenseMap.SSOLicenseMappingGroups)
+		if len(added) > 0 || len(removed) > 0 {
+			licenseMap.SSOLicenseMappingGroups = groups
+			_, err := r.client.UpdateLicenseMap(*licenseMap.ID, licenseMap)
+			if err != nil {
+				return fmt.Errorf("error updating the %s license map: %w", licenseType, err)
+			}
+		}
+
+		for _, duplicateLicenseMap := range licenseMaps[1:] {
+			err := r.client.DestroyLicenseMap(*duplicateLicenseMap.ID)
+			if err != nil {
+				return fmt.Errorf("error deleting the %s license map: %w", licenseType, err)
+			}
+		}
+	}
+
+	for licenseType, licenseMaps := range existingByType {
+		if _, ok := desired[licenseType]; ok {
+			continue
+		}
+		for _, licenseMap := range licenseMaps {
+			err := r.client.DestroyLicenseMap(*licenseMap.ID)
+			if err != nil {
+				return fmt.Errorf("error deleting the %s license map: %w", licenseType, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// apply reconciles the license maps of the account with the plan and returns the new state
+func (r *licenseMapsResource) apply(
+	ctx context.Context,
+	plan LicenseMapsResourceModel,
+	existing []dbt_cloud.LicenseMap,
+) (LicenseMapsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// the config can only be checked at plan time when all the groups are known
+	if !plan.LicenseMaps.IsUnknown() {
+		desired, modelDiags := licenseMapsFromModel(ctx, plan.LicenseMaps)
+		diags.Append(modelDiags...)
+		diags.Append(checkGroupConflicts(desired, diag.SeverityError)...)
+		if diags.HasError() {
+			return plan, diags
+		}
+
+		err := r.reconcile(desired, existing)
+		if err != nil {
+			diags.AddError("Unable to update the license maps", err.Error())
+			return plan, diags
+		}
+	}
+
+	allLicenseMaps, err := r.client.GetAllLicenseMaps()
+	if err != nil {
+		diags.AddError("Unable to get all license maps", "Error: "+err.Error())
+		return plan, diags
+	}
+
+	diags.Append(plan.setFromAPI(ctx, r.client.AccountID, allLicenseMaps)...)
+	return plan, diags
+}
+
+func (r *licenseMapsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan LicenseMapsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.GetAllLicenseMaps()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get all license maps", "Error: "+err.Error())
+		return
+	}
+
+	existingTypes := lo.Uniq(lo.FilterMap(existing, func(licenseMap dbt_cloud.LicenseMap, _ int) (string, bool) {
+		return licenseMap.LicenseType, licenseMap.State == dbt_cloud.STATE_ACTIVE
+	}))
+	if len(existingTypes) > 0 && !plan.ImportExisting.ValueBool() {
+		sort.Strings(existingTypes)
+		resp.Diagnostics.AddAttributeError(
+			path.Root("import_existing"),
+			"The account already has license maps",
+			fmt.Sprintf(
+				"The account already has license maps for the license types %s. As this resource manages all the license maps of the account, set `import_existing` to `true` to adopt them, or import the resource.",
+				strings.Join(existingTypes, ", "),
+			),
+		)
+		return
+	}
+
+	newState, diags := r.apply(ctx, plan, existing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *licenseMapsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state LicenseMapsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allLicenseMaps, err := r.client.GetAllLicenseMaps()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get all license maps", "Error: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromAPI(ctx, r.client.AccountID, allLicenseMaps)...)
+
+	// this is only used when creating the resource and is not set after an import
+	if state.ImportExisting.IsNull() {
+		state.ImportExisting = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *licenseMapsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan LicenseMapsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.GetAllLicenseMaps()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get all license maps", "Error: "+err.Error())
+		return
+	}
+
+	newState, diags := r.apply(ctx, plan, existing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *licenseMapsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	existing, err := r.client.GetAllLicenseMaps()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get all license maps", "Error: "+err.Error())
+		return
+	}
+
+	err = r.reconcile(map[string][]string{}, existing)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete the license maps", err.Error())
+		return
+	}
+}
+
+func (r *licenseMapsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// the license maps can only be managed for the account configured in the provider
+	if req.ID != strconv.Itoa(r.client.AccountID) {
+		resp.Diagnostics.AddError(
+			"Invalid account ID",
+			fmt.Sprintf(
+				"The license maps can only be imported for the account configured in the provider, expected the ID %d but got %q",
+				r.client.AccountID,
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *licenseMapsResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
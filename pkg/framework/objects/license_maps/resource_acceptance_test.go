@@ -0,0 +1,186 @@
+package license_maps_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudLicenseMapsResourceGroupConflict(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "dbtcloud_license_maps" "test" {
+  license_maps = {
+    developer = ["engineers", "analysts"]
+    read_only = ["analysts"]
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"analysts" is mapped to the license types developer, read_only`),
+			},
+		},
+	})
+}
+
+func TestAccDbtCloudLicenseMapsResource(t *testing.T) {
+
+	// the resource deletes all the license maps of the account, so the test only runs on dedicated accounts
+	if _, exists := os.LookupEnv("DBT_ACCEPTANCE_TEST_LICENSE_MAPS"); !exists && !acctest_helper.IsFakeAPI() {
+		t.Skip(
+			"Skipping license maps acceptance tests as the env var DBT_ACCEPTANCE_TEST_LICENSE_MAPS is not set",
+		)
+	}
+
+	groupName := strings.ToUpper(acctest_helper.RandomName())
+	groupName2 := strings.ToUpper(acctest_helper.RandomName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudLicenseMapsResourceConfig(
+					fmt.Sprintf(`developer = ["%s"]`, groupName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_license_maps.test",
+						"license_maps.developer.#",
+						"1",
+					),
+					resource.TestCheckTypeSetElemAttr(
+						"dbtcloud_license_maps.test",
+						"license_maps.developer.*",
+						groupName,
+					),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_license_maps.test",
+						"license_map_ids.developer",
+					),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudLicenseMapsResourceConfig(
+					fmt.Sprintf(
+						`developer = ["%s"]
+    read_only = ["%s"]`,
+						groupName,
+						groupName2,
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_license_maps.test",
+						"license_maps.%",
+						"2",
+					),
+					resource.TestCheckTypeSetElemAttr(
+						"dbtcloud_license_maps.test",
+						"license_maps.read_only.*",
+						groupName2,
+					),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbtcloud_license_maps.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"import_existing"},
+			},
+			// the license maps of another account can't be imported
+			{
+				ResourceName:  "dbtcloud_license_maps.test",
+				ImportState:   true,
+				ImportStateId: "999999999",
+				ExpectError:   regexp.MustCompile("Invalid account ID"),
+			},
+		},
+	})
+}
+
+func TestAccDbtCloudLicenseMapsResourceAdoptConflicts(t *testing.T) {
+
+	// the resource deletes all the license maps of the account, so the test only runs on dedicated accounts
+	if _, exists := os.LookupEnv("DBT_ACCEPTANCE_TEST_LICENSE_MAPS"); !exists && !acctest_helper.IsFakeAPI() {
+		t.Skip(
+			"Skipping license maps acceptance tests as the env var DBT_ACCEPTANCE_TEST_LICENSE_MAPS is not set",
+		)
+	}
+
+	groupName := strings.ToUpper(acctest_helper.RandomName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the conflicting maps are adopted as they are, with a warning when planning
+			{
+				PreConfig: func() {
+					client, err := acctest_helper.SharedClient()
+					if err != nil {
+						t.Fatalf("Issue getting the client: %s", err)
+					}
+					for _, licenseType := range []string{"developer", "read_only"} {
+						if _, err := client.CreateLicenseMap(licenseType, []string{groupName}); err != nil {
+							t.Fatalf("Issue creating the %s license map: %s", licenseType, err)
+						}
+					}
+				},
+				Config: `
+resource "dbtcloud_license_maps" "test" {
+  import_existing = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(
+						"dbtcloud_license_maps.test",
+						"license_maps.developer.*",
+						groupName,
+					),
+					resource.TestCheckTypeSetElemAttr(
+						"dbtcloud_license_maps.test",
+						"license_maps.read_only.*",
+						groupName,
+					),
+				),
+			},
+			// setting the maps resolves the conflict
+			{
+				Config: testAccDbtCloudLicenseMapsResourceConfig(
+					fmt.Sprintf(`developer = ["%s"]`, groupName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_license_maps.test",
+						"license_maps.%",
+						"1",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudLicenseMapsResourceConfig(licenseMaps string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_license_maps" "test" {
+  import_existing = true
+  license_maps = {
+    %s
+  }
+}
+`, licenseMaps)
+}
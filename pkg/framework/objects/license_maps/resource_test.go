@@ -0,0 +1,50 @@
+package license_maps
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestCheckGroupConflicts(t *testing.T) {
+	t.Parallel()
+
+	groupsByLicenseType := map[string][]string{
+		"developer": {"engineers", "analysts"},
+		"read_only": {"analysts", "finance"},
+		"it":        {"finance"},
+	}
+
+	testCases := []struct {
+		name     string
+		severity diag.Severity
+	}{
+		{
+			name:     "conflicts in the config",
+			severity: diag.SeverityError,
+		},
+		{
+			name:     "conflicts in the adopted license maps",
+			severity: diag.SeverityWarning,
+		},
+	}
+
+	for _, testCase := range testCases {
+		diags := checkGroupConflicts(groupsByLicenseType, testCase.severity)
+		if len(diags) != 2 {
+			t.Fatalf("%s: expected one diagnostic per conflicting group, got %v", testCase.name, diags)
+		}
+		for _, d := range diags {
+			if d.Severity() != testCase.severity {
+				t.Errorf("%s: expected the severity %s, got %s", testCase.name, testCase.severity, d.Severity())
+			}
+		}
+		if diags[0].Detail() >= diags[1].Detail() {
+			t.Errorf("%s: expected the groups to be sorted, got %v", testCase.name, diags)
+		}
+	}
+
+	if diags := checkGroupConflicts(map[string][]string{"developer": {"engineers"}}, diag.SeverityError); diags.HasError() {
+		t.Errorf("expected no diagnostic without conflict, got %v", diags)
+	}
+}
@@ -0,0 +1,75 @@
+package license_maps
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var licenseTypes = []string{
+	"developer",
+	"read_only",
+	"it",
+}
+
+func (r *licenseMapsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: helper.DocString(
+			`Manages all the license maps of the account, mapping SSO groups to license types.
+
+			This resource is authoritative: the license maps of the account that are not in the configuration are deleted, and a given SSO group can only be mapped to one license type. It should not be used with ~~~dbtcloud_license_map~~~ or ~~~dbtcloud_partial_license_map~~~.
+
+			When the account already has license maps, creating the resource fails unless ~~~import_existing~~~ is set to ~~~true~~~. The existing maps are then adopted, and updated to match ~~~license_maps~~~ if it is set. When ~~~license_maps~~~ is not set, the SSO groups of the adopted maps that are mapped to more than one license type are reported as warnings when planning.
+
+			Destroying the resource deletes all the license maps of the account.
+
+			The resource can be imported with the ID of the account configured in the provider.`,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"license_maps": schema.MapAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.SetType{ElemType: types.StringType},
+				Description: "Map of the SSO groups to map to each license type, with the license type as the key (`developer`, `read_only` or `it`). Each SSO group can only be mapped to one license type. When not set with `import_existing`, the license maps of the account are kept as they are",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(licenseTypes...)),
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"license_map_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The ID of the license map of each license type",
+			},
+			"import_existing": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to adopt the license maps already existing in the account when creating the resource, instead of failing - Defaults to `false`",
+			},
+		},
+	}
+}
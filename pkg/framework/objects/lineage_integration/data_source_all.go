@@ -0,0 +1,109 @@
+package lineage_integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &lineageIntegrationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &lineageIntegrationsDataSource{}
+)
+
+func LineageIntegrationsDataSource() datasource.DataSource {
+	return &lineageIntegrationsDataSource{}
+}
+
+type lineageIntegrationsDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *lineageIntegrationsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_lineage_integrations"
+}
+
+func (d *lineageIntegrationsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state LineageIntegrationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiLineageIntegrations, err := d.client.GetLineageIntegrations(state.ProjectID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue when retrieving lineage integrations",
+			err.Error(),
+		)
+		return
+	}
+
+	state.LineageIntegrations = []LineageIntegrationDataSourceModel{}
+	for _, lineageIntegration := range apiLineageIntegrations {
+		config := lineageIntegration.Config
+
+		workspaceIDs := types.SetNull(types.StringType)
+		if config.WorkspaceIDs != nil {
+			setValue, diags := types.SetValueFrom(ctx, types.StringType, *config.WorkspaceIDs)
+			resp.Diagnostics.Append(diags...)
+			workspaceIDs = setValue
+		}
+
+		state.LineageIntegrations = append(
+			state.LineageIntegrations,
+			LineageIntegrationDataSourceModel{
+				ID: types.StringValue(
+					fmt.Sprintf(
+						"%d%s%d",
+						lineageIntegration.ProjectID,
+						dbt_cloud.ID_DELIMITER,
+						*lineageIntegration.ID,
+					),
+				),
+				LineageIntegrationID: types.Int64PointerValue(lineageIntegration.ID),
+				Name:                 types.StringValue(lineageIntegration.Name),
+				Host:                 nullableString(config.Host),
+				SiteID:               nullableString(config.SiteID),
+				TokenName:            nullableString(config.TokenName),
+				TenantID:             nullableString(config.TenantID),
+				ClientID:             nullableString(config.ClientID),
+				WorkspaceIDs:         workspaceIDs,
+			},
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// nullableString returns a null value for the fields not used by the BI tool of the integration
+func nullableString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func (d *lineageIntegrationsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
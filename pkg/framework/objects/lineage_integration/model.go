@@ -1,14 +1,158 @@
 package lineage_integration
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type LineageIntegrationResourceModel struct {
 	ID                   types.String `tfsdk:"id"`
 	LineageIntegrationID types.Int64  `tfsdk:"lineage_integration_id"`
 	ProjectID            types.Int64  `tfsdk:"project_id"`
 	Name                 types.String `tfsdk:"name"`
+	// the Tableau fields were at the root of the resource before the BI tools had their own block
+	Host      types.String        `tfsdk:"host"`
+	SiteID    types.String        `tfsdk:"site_id"`
+	TokenName types.String        `tfsdk:"token_name"`
+	Token     types.String        `tfsdk:"token"`
+	Tableau   *TableauConfigModel `tfsdk:"tableau"`
+	PowerBI   *PowerBIConfigModel `tfsdk:"power_bi"`
+}
+
+type TableauConfigModel struct {
+	Host      types.String `tfsdk:"host"`
+	SiteID    types.String `tfsdk:"site_id"`
+	TokenName types.String `tfsdk:"token_name"`
+	Token     types.String `tfsdk:"token"`
+}
+
+type PowerBIConfigModel struct {
+	TenantID     types.String `tfsdk:"tenant_id"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	WorkspaceIDs types.Set    `tfsdk:"workspace_ids"`
+}
+
+type LineageIntegrationsDataSourceModel struct {
+	ProjectID           types.Int64                         `tfsdk:"project_id"`
+	LineageIntegrations []LineageIntegrationDataSourceModel `tfsdk:"lineage_integrations"`
+}
+
+type LineageIntegrationDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	LineageIntegrationID types.Int64  `tfsdk:"lineage_integration_id"`
+	Name                 types.String `tfsdk:"name"`
 	Host                 types.String `tfsdk:"host"`
 	SiteID               types.String `tfsdk:"site_id"`
 	TokenName            types.String `tfsdk:"token_name"`
-	Token                types.String `tfsdk:"token"`
+	TenantID             types.String `tfsdk:"tenant_id"`
+	ClientID             types.String `tfsdk:"client_id"`
+	WorkspaceIDs         types.Set    `tfsdk:"workspace_ids"`
+}
+
+// usesLegacyTableauFields returns true when the Tableau config is set at the root of the resource
+func (m *LineageIntegrationResourceModel) usesLegacyTableauFields() bool {
+	return !m.Host.IsNull()
+}
+
+// integrationName returns the name of the BI tool configured in the model
+func (m *LineageIntegrationResourceModel) integrationName() string {
+	if m.PowerBI != nil {
+		return dbt_cloud.LINEAGE_INTEGRATION_POWER_BI
+	}
+	return dbt_cloud.LINEAGE_INTEGRATION_TABLEAU
+}
+
+// toAPIConfig returns the config of the BI tool to send to dbt Cloud
+func (m *LineageIntegrationResourceModel) toAPIConfig(
+	ctx context.Context,
+) (dbt_cloud.LineageIntegrationConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case m.PowerBI != nil:
+		config := dbt_cloud.LineageIntegrationConfig{
+			TenantID:     m.PowerBI.TenantID.ValueString(),
+			ClientID:     m.PowerBI.ClientID.ValueString(),
+			ClientSecret: m.PowerBI.ClientSecret.ValueString(),
+		}
+		if !m.PowerBI.WorkspaceIDs.IsNull() && !m.PowerBI.WorkspaceIDs.IsUnknown() {
+			workspaceIDs := []string{}
+			diags.Append(m.PowerBI.WorkspaceIDs.ElementsAs(ctx, &workspaceIDs, false)...)
+			config.WorkspaceIDs = &workspaceIDs
+		}
+		return config, diags
+	case m.Tableau != nil:
+		return dbt_cloud.LineageIntegrationConfig{
+			Host:      m.Tableau.Host.ValueString(),
+			SiteID:    m.Tableau.SiteID.ValueString(),
+			TokenName: m.Tableau.TokenName.ValueString(),
+			Token:     m.Tableau.Token.ValueString(),
+		}, diags
+	default:
+		return dbt_cloud.LineageIntegrationConfig{
+			Host:      m.Host.ValueString(),
+			SiteID:    m.SiteID.ValueString(),
+			TokenName: m.TokenName.ValueString(),
+			Token:     m.Token.ValueString(),
+		}, diags
+	}
+}
+
+// setFromAPI sets the config returned by dbt Cloud, the secrets are not returned and are kept from the state
+func (m *LineageIntegrationResourceModel) setFromAPI(
+	ctx context.Context,
+	lineageIntegration *dbt_cloud.LineageIntegration,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.LineageIntegrationID = types.Int64PointerValue(lineageIntegration.ID)
+	m.ProjectID = types.Int64Value(lineageIntegration.ProjectID)
+	m.Name = types.StringValue(lineageIntegration.Name)
+
+	config := lineageIntegration.Config
+
+	// after an import, the secrets are not known
+	const importedSecret = "********"
+
+	switch {
+	case lineageIntegration.Name == dbt_cloud.LINEAGE_INTEGRATION_POWER_BI:
+		clientSecret := types.StringValue(importedSecret)
+		workspaceIDs := types.SetNull(types.StringType)
+		if m.PowerBI != nil {
+			clientSecret = m.PowerBI.ClientSecret
+			workspaceIDs = m.PowerBI.WorkspaceIDs
+		}
+		if config.WorkspaceIDs != nil && (len(*config.WorkspaceIDs) > 0 || !workspaceIDs.IsNull()) {
+			var setDiags diag.Diagnostics
+			workspaceIDs, setDiags = types.SetValueFrom(ctx, types.StringType, *config.WorkspaceIDs)
+			diags.Append(setDiags...)
+		}
+		m.PowerBI = &PowerBIConfigModel{
+			TenantID:     types.StringValue(config.TenantID),
+			ClientID:     types.StringValue(config.ClientID),
+			ClientSecret: clientSecret,
+			WorkspaceIDs: workspaceIDs,
+		}
+	case m.usesLegacyTableauFields():
+		m.Host = types.StringValue(config.Host)
+		m.SiteID = types.StringValue(config.SiteID)
+		m.TokenName = types.StringValue(config.TokenName)
+	default:
+		token := types.StringValue(importedSecret)
+		if m.Tableau != nil {
+			token = m.Tableau.Token
+		}
+		m.Tableau = &TableauConfigModel{
+			Host:      types.StringValue(config.Host),
+			SiteID:    types.StringValue(config.SiteID),
+			TokenName: types.StringValue(config.TokenName),
+			Token:     token,
+		}
+	}
+
+	return diags
 }
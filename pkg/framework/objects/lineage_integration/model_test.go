@@ -0,0 +1,279 @@
+package lineage_integration
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestToAPIConfig(t *testing.T) {
+	t.Parallel()
+
+	workspaceIDs := []string{"workspace-1"}
+
+	testCases := []struct {
+		name     string
+		model    LineageIntegrationResourceModel
+		expected dbt_cloud.LineageIntegrationConfig
+	}{
+		{
+			name: "legacy Tableau fields",
+			model: LineageIntegrationResourceModel{
+				Host:      types.StringValue("https://tableau.example.com"),
+				SiteID:    types.StringValue("site"),
+				TokenName: types.StringValue("token-name"),
+				Token:     types.StringValue("token"),
+			},
+			expected: dbt_cloud.LineageIntegrationConfig{
+				Host:      "https://tableau.example.com",
+				SiteID:    "site",
+				TokenName: "token-name",
+				Token:     "token",
+			},
+		},
+		{
+			name: "tableau block",
+			model: LineageIntegrationResourceModel{
+				Host: types.StringNull(),
+				Tableau: &TableauConfigModel{
+					Host:      types.StringValue("https://tableau.example.com"),
+					SiteID:    types.StringValue("site"),
+					TokenName: types.StringValue("token-name"),
+					Token:     types.StringValue("token"),
+				},
+			},
+			expected: dbt_cloud.LineageIntegrationConfig{
+				Host:      "https://tableau.example.com",
+				SiteID:    "site",
+				TokenName: "token-name",
+				Token:     "token",
+			},
+		},
+		{
+			name: "power_bi block with workspaces",
+			model: LineageIntegrationResourceModel{
+				Host: types.StringNull(),
+				PowerBI: &PowerBIConfigModel{
+					TenantID:     types.StringValue("tenant"),
+					ClientID:     types.StringValue("client"),
+					ClientSecret: types.StringValue("secret"),
+					WorkspaceIDs: types.SetValueMust(
+						types.StringType,
+						[]attr.Value{types.StringValue("workspace-1")},
+					),
+				},
+			},
+			expected: dbt_cloud.LineageIntegrationConfig{
+				TenantID:     "tenant",
+				ClientID:     "client",
+				ClientSecret: "secret",
+				WorkspaceIDs: &workspaceIDs,
+			},
+		},
+		{
+			name: "power_bi block without workspaces",
+			model: LineageIntegrationResourceModel{
+				Host: types.StringNull(),
+				PowerBI: &PowerBIConfigModel{
+					TenantID:     types.StringValue("tenant"),
+					ClientID:     types.StringValue("client"),
+					ClientSecret: types.StringValue("secret"),
+					WorkspaceIDs: types.SetNull(types.StringType),
+				},
+			},
+			expected: dbt_cloud.LineageIntegrationConfig{
+				TenantID:     "tenant",
+				ClientID:     "client",
+				ClientSecret: "secret",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		config, diags := testCase.model.toAPIConfig(context.Background())
+		if diags.HasError() {
+			t.Errorf("%s: unexpected diagnostics %v", testCase.name, diags)
+			continue
+		}
+		if !reflect.DeepEqual(config, testCase.expected) {
+			t.Errorf("%s: expected %+v, got %+v", testCase.name, testCase.expected, config)
+		}
+	}
+}
+
+func TestSetFromAPI(t *testing.T) {
+	t.Parallel()
+
+	id := int64(10)
+	tableauConfig := dbt_cloud.LineageIntegrationConfig{
+		Host:      "https://tableau.example.com",
+		SiteID:    "site",
+		TokenName: "token-name",
+	}
+	noWorkspaceIDs := []string{}
+	workspaceIDs := []string{"workspace-1", "workspace-2"}
+
+	testCases := []struct {
+		name               string
+		current            LineageIntegrationResourceModel
+		fromAPI            dbt_cloud.LineageIntegration
+		expectedTableau    *TableauConfigModel
+		expectedPowerBI    *PowerBIConfigModel
+		expectedLegacyHost types.String
+	}{
+		{
+			name:    "imported Tableau integration",
+			current: LineageIntegrationResourceModel{Host: types.StringNull()},
+			fromAPI: dbt_cloud.LineageIntegration{
+				Name:   dbt_cloud.LINEAGE_INTEGRATION_TABLEAU,
+				Config: tableauConfig,
+			},
+			expectedTableau: &TableauConfigModel{
+				Host:      types.StringValue("https://tableau.example.com"),
+				SiteID:    types.StringValue("site"),
+				TokenName: types.StringValue("token-name"),
+				Token:     types.StringValue("********"),
+			},
+			expectedLegacyHost: types.StringNull(),
+		},
+		{
+			name: "legacy Tableau fields",
+			current: LineageIntegrationResourceModel{
+				Host:  types.StringValue("https://old.example.com"),
+				Token: types.StringValue("token"),
+			},
+			fromAPI: dbt_cloud.LineageIntegration{
+				Name:   dbt_cloud.LINEAGE_INTEGRATION_TABLEAU,
+				Config: tableauConfig,
+			},
+			expectedLegacyHost: types.StringValue("https://tableau.example.com"),
+		},
+		{
+			name: "tableau block keeps the token",
+			current: LineageIntegrationResourceModel{
+				Host:    types.StringNull(),
+				Tableau: &TableauConfigModel{Token: types.StringValue("token")},
+			},
+			fromAPI: dbt_cloud.LineageIntegration{
+				Name:   dbt_cloud.LINEAGE_INTEGRATION_TABLEAU,
+				Config: tableauConfig,
+			},
+			expectedTableau: &TableauConfigModel{
+				Host:      types.StringValue("https://tableau.example.com"),
+				SiteID:    types.StringValue("site"),
+				TokenName: types.StringValue("token-name"),
+				Token:     types.StringValue("token"),
+			},
+			expectedLegacyHost: types.StringNull(),
+		},
+		{
+			name:    "imported Power BI integration without workspaces",
+			current: LineageIntegrationResourceModel{Host: types.StringNull()},
+			fromAPI: dbt_cloud.LineageIntegration{
+				Name: dbt_cloud.LINEAGE_INTEGRATION_POWER_BI,
+				Config: dbt_cloud.LineageIntegrationConfig{
+					TenantID:     "tenant",
+					ClientID:     "client",
+					WorkspaceIDs: &noWorkspaceIDs,
+				},
+			},
+			expectedPowerBI: &PowerBIConfigModel{
+				TenantID:     types.StringValue("tenant"),
+				ClientID:     types.StringValue("client"),
+				ClientSecret: types.StringValue("********"),
+				WorkspaceIDs: types.SetNull(types.StringType),
+			},
+			expectedLegacyHost: types.StringNull(),
+		},
+		{
+			name: "power_bi block keeps the secret and reads the workspaces",
+			current: LineageIntegrationResourceModel{
+				Host: types.StringNull(),
+				PowerBI: &PowerBIConfigModel{
+					ClientSecret: types.StringValue("secret"),
+					WorkspaceIDs: types.SetNull(types.StringType),
+				},
+			},
+			fromAPI: dbt_cloud.LineageIntegration{
+				Name: dbt_cloud.LINEAGE_INTEGRATION_POWER_BI,
+				Config: dbt_cloud.LineageIntegrationConfig{
+					TenantID:     "tenant",
+					ClientID:     "client",
+					WorkspaceIDs: &workspaceIDs,
+				},
+			},
+			expectedPowerBI: &PowerBIConfigModel{
+				TenantID:     types.StringValue("tenant"),
+				ClientID:     types.StringValue("client"),
+				ClientSecret: types.StringValue("secret"),
+				WorkspaceIDs: types.SetValueMust(
+					types.StringType,
+					[]attr.Value{types.StringValue("workspace-1"), types.StringValue("workspace-2")},
+				),
+			},
+			expectedLegacyHost: types.StringNull(),
+		},
+		{
+			name: "power_bi block with the workspaces removed",
+			current: LineageIntegrationResourceModel{
+				Host: types.StringNull(),
+				PowerBI: &PowerBIConfigModel{
+					ClientSecret: types.StringValue("secret"),
+					WorkspaceIDs: types.SetNull(types.StringType),
+				},
+			},
+			fromAPI: dbt_cloud.LineageIntegration{
+				Name: dbt_cloud.LINEAGE_INTEGRATION_POWER_BI,
+				Config: dbt_cloud.LineageIntegrationConfig{
+					TenantID:     "tenant",
+					ClientID:     "client",
+					WorkspaceIDs: &noWorkspaceIDs,
+				},
+			},
+			expectedPowerBI: &PowerBIConfigModel{
+				TenantID:     types.StringValue("tenant"),
+				ClientID:     types.StringValue("client"),
+				ClientSecret: types.StringValue("secret"),
+				WorkspaceIDs: types.SetNull(types.StringType),
+			},
+			expectedLegacyHost: types.StringNull(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		model := testCase.current
+		testCase.fromAPI.ID = &id
+		testCase.fromAPI.ProjectID = 20
+
+		diags := model.setFromAPI(context.Background(), &testCase.fromAPI)
+		if diags.HasError() {
+			t.Errorf("%s: unexpected diagnostics %v", testCase.name, diags)
+			continue
+		}
+
+		if model.LineageIntegrationID.ValueInt64() != id || model.ProjectID.ValueInt64() != 20 ||
+			model.Name.ValueString() != testCase.fromAPI.Name {
+			t.Errorf("%s: unexpected IDs or name %+v", testCase.name, model)
+		}
+		if !model.Host.Equal(testCase.expectedLegacyHost) {
+			t.Errorf("%s: expected the host %s, got %s", testCase.name, testCase.expectedLegacyHost, model.Host)
+		}
+		if !reflect.DeepEqual(model.Tableau, testCase.expectedTableau) {
+			t.Errorf("%s: expected the tableau block %+v, got %+v", testCase.name, testCase.expectedTableau, model.Tableau)
+		}
+		if (model.PowerBI == nil) != (testCase.expectedPowerBI == nil) {
+			t.Errorf("%s: expected the power_bi block %+v, got %+v", testCase.name, testCase.expectedPowerBI, model.PowerBI)
+			continue
+		}
+		if model.PowerBI != nil && (!model.PowerBI.TenantID.Equal(testCase.expectedPowerBI.TenantID) ||
+			!model.PowerBI.ClientID.Equal(testCase.expectedPowerBI.ClientID) ||
+			!model.PowerBI.ClientSecret.Equal(testCase.expectedPowerBI.ClientSecret) ||
+			!model.PowerBI.WorkspaceIDs.Equal(testCase.expectedPowerBI.WorkspaceIDs)) {
+			t.Errorf("%s: expected the power_bi block %+v, got %+v", testCase.name, testCase.expectedPowerBI, model.PowerBI)
+		}
+	}
+}
@@ -3,19 +3,23 @@ package lineage_integration
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource                = &lineageIntegrationResource{}
-	_ resource.ResourceWithConfigure   = &lineageIntegrationResource{}
-	_ resource.ResourceWithImportState = &lineageIntegrationResource{}
+	_ resource.Resource                     = &lineageIntegrationResource{}
+	_ resource.ResourceWithConfigure        = &lineageIntegrationResource{}
+	_ resource.ResourceWithImportState      = &lineageIntegrationResource{}
+	_ resource.ResourceWithConfigValidators = &lineageIntegrationResource{}
+	_ resource.ResourceWithModifyPlan       = &lineageIntegrationResource{}
 )
 
 func LineageIntegrationResource() resource.Resource {
@@ -34,6 +38,90 @@ func (r *lineageIntegrationResource) Metadata(
 	resp.TypeName = req.ProviderTypeName + "_lineage_integration"
 }
 
+func (r *lineageIntegrationResource) ConfigValidators(
+	_ context.Context,
+) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("host"),
+			path.MatchRoot("tableau"),
+			path.MatchRoot("power_bi"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("host"),
+			path.MatchRoot("site_id"),
+			path.MatchRoot("token_name"),
+			path.MatchRoot("token"),
+		),
+	}
+}
+
+func (r *lineageIntegrationResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		// the resource is being deleted
+		return
+	}
+
+	var plan LineageIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.integrationName()
+	resp.Diagnostics.Append(
+		resp.Plan.SetAttribute(ctx, path.Root("name"), types.StringValue(name))...,
+	)
+
+	if !req.State.Raw.IsNull() {
+		var state LineageIntegrationResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// the BI tool of an integration can't be changed in dbt Cloud
+		if state.Name.ValueString() != name {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("name"))
+		}
+		return
+	}
+
+	// lineage integrations can only be created for projects with a production environment
+	// the check is skipped when the project is created in the same apply
+	if plan.ProjectID.IsUnknown() || r.client == nil {
+		return
+	}
+
+	environments, err := r.client.GetAllEnvironments(int(plan.ProjectID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to check the environments of the project",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	for _, environment := range environments {
+		if environment.DeploymentType != nil &&
+			*environment.DeploymentType == string(dbt_cloud.EnvironmentCategory_Production) {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("project_id"),
+		"No production environment in the project",
+		fmt.Sprintf(
+			"The project %d doesn't have an environment tagged as production. The lineage integration can only be created once a production environment exists, create the environment first, e.g. with `terraform apply -target`.",
+			plan.ProjectID.ValueInt64(),
+		),
+	)
+}
+
 func (r *lineageIntegrationResource) Read(
 	ctx context.Context,
 	req resource.ReadRequest,
@@ -42,6 +130,9 @@ func (r *lineageIntegrationResource) Read(
 	var data LineageIntegrationResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	projectID := data.ProjectID.ValueInt64()
 	lineageIntegrationID := data.LineageIntegrationID.ValueInt64()
@@ -67,19 +158,7 @@ func (r *lineageIntegrationResource) Read(
 			*lineageIntegration.ID,
 		),
 	)
-
-	data.LineageIntegrationID = types.Int64PointerValue(lineageIntegration.ID)
-	data.ProjectID = types.Int64Value(int64(lineageIntegration.ProjectID))
-	data.Name = types.StringValue(lineageIntegration.Name)
-	data.Host = types.StringValue(lineageIntegration.Config.Host)
-	data.SiteID = types.StringValue(lineageIntegration.Config.SiteID)
-	data.TokenName = types.StringValue(lineageIntegration.Config.TokenName)
-
-	// we only set the token if it is null as it is sensitive
-	// this means that we are importing the data
-	if data.Token.IsNull() {
-		data.Token = types.StringValue("********")
-	}
+	resp.Diagnostics.Append(data.setFromAPI(ctx, lineageIntegration)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
@@ -97,13 +176,16 @@ func (r *lineageIntegrationResource) Create(
 		return
 	}
 
+	config, diags := data.toAPIConfig(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	lineageIntegration, err := r.client.CreateLineageIntegration(
 		data.ProjectID.ValueInt64(),
-		data.Name.ValueString(),
-		data.Host.ValueString(),
-		data.SiteID.ValueString(),
-		data.TokenName.ValueString(),
-		data.Token.ValueString(),
+		data.integrationName(),
+		config,
 	)
 
 	if err != nil {
@@ -123,6 +205,7 @@ func (r *lineageIntegrationResource) Create(
 		),
 	)
 	data.LineageIntegrationID = types.Int64PointerValue(lineageIntegration.ID)
+	data.Name = types.StringValue(data.integrationName())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -164,19 +247,45 @@ func (r *lineageIntegrationResource) Update(
 		return
 	}
 
+	planConfig, diags := plan.toAPIConfig(ctx)
+	resp.Diagnostics.Append(diags...)
+	stateConfig, diags := state.toAPIConfig(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// we only send the fields that changed, moving from the legacy fields to the tableau block is a no-op
 	patchPayload := dbt_cloud.LineageIntegration{}
 
-	if plan.Host != state.Host {
-		patchPayload.Config.Host = plan.Host.ValueString()
+	if planConfig.Host != stateConfig.Host {
+		patchPayload.Config.Host = planConfig.Host
+	}
+	if planConfig.SiteID != stateConfig.SiteID {
+		patchPayload.Config.SiteID = planConfig.SiteID
+	}
+	if planConfig.TokenName != stateConfig.TokenName {
+		patchPayload.Config.TokenName = planConfig.TokenName
 	}
-	if plan.SiteID != state.SiteID {
-		patchPayload.Config.SiteID = plan.SiteID.ValueString()
+	if planConfig.Token != stateConfig.Token {
+		patchPayload.Config.Token = planConfig.Token
 	}
-	if plan.TokenName != state.TokenName {
-		patchPayload.Config.TokenName = plan.TokenName.ValueString()
+	if planConfig.TenantID != stateConfig.TenantID {
+		patchPayload.Config.TenantID = planConfig.TenantID
 	}
-	if plan.Token != state.Token {
-		patchPayload.Config.Token = plan.Token.ValueString()
+	if planConfig.ClientID != stateConfig.ClientID {
+		patchPayload.Config.ClientID = planConfig.ClientID
+	}
+	if planConfig.ClientSecret != stateConfig.ClientSecret {
+		patchPayload.Config.ClientSecret = planConfig.ClientSecret
+	}
+	if !reflect.DeepEqual(planConfig.WorkspaceIDs, stateConfig.WorkspaceIDs) {
+		// removing the filter means fetching the lineage from all the workspaces
+		workspaceIDs := []string{}
+		if planConfig.WorkspaceIDs != nil {
+			workspaceIDs = *planConfig.WorkspaceIDs
+		}
+		patchPayload.Config.WorkspaceIDs = &workspaceIDs
 	}
 
 	projectID := state.ProjectID.ValueInt64()
@@ -9,34 +9,41 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDbtCloudLineageIntegrationResource(t *testing.T) {
 
-	envVarLineageIntegration, exists := os.LookupEnv("DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION")
+	lineageIntegrationHost := "https://tableau.example.com"
+	lineageIntegrationSiteID := "site"
+	lineageIntegrationTokenName := "token_name"
+	lineageIntegrationToken := "token"
 
-	if !exists {
-		t.Skip(
-			"Skipping lineage configuration acceptance tests as the env var DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION is not set",
-		)
-	}
+	if !acctest_helper.IsFakeAPI() {
+		envVarLineageIntegration, exists := os.LookupEnv("DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION")
 
-	lineageIntegrationConfigs := strings.Split(envVarLineageIntegration, "~")
-	if len(lineageIntegrationConfigs) != 4 {
-		t.Fatalf(
-			"DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION env var should be in the format: host~side_id~token_name~token",
-		)
-	}
+		if !exists {
+			t.Skip(
+				"Skipping lineage configuration acceptance tests as the env var DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION is not set",
+			)
+		}
+
+		lineageIntegrationConfigs := strings.Split(envVarLineageIntegration, "~")
+		if len(lineageIntegrationConfigs) != 4 {
+			t.Fatalf(
+				"DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION env var should be in the format: host~side_id~token_name~token",
+			)
+		}
 
-	lineageIntegrationHost := lineageIntegrationConfigs[0]
-	lineageIntegrationSiteID := lineageIntegrationConfigs[1]
-	lineageIntegrationTokenName := lineageIntegrationConfigs[2]
-	lineageIntegrationToken := lineageIntegrationConfigs[3]
+		lineageIntegrationHost = lineageIntegrationConfigs[0]
+		lineageIntegrationSiteID = lineageIntegrationConfigs[1]
+		lineageIntegrationTokenName = lineageIntegrationConfigs[2]
+		lineageIntegrationToken = lineageIntegrationConfigs[3]
+	}
 
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -50,21 +57,243 @@ func TestAccDbtCloudLineageIntegrationResource(t *testing.T) {
 					lineageIntegrationSiteID,
 					lineageIntegrationTokenName,
 					lineageIntegrationToken,
+					false,
 				),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
 						"dbtcloud_lineage_integration.my_lineage",
 						"id",
 					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_lineage_integration.my_lineage",
+						"name",
+						"tableau",
+					),
+				),
+			},
+			// MODIFY, moving to the tableau block doesn't recreate the integration
+			{
+				Config: testAccDbtCloudLineageIntegrationResourceBasicConfig(
+					projectName,
+					lineageIntegrationHost,
+					lineageIntegrationSiteID,
+					lineageIntegrationTokenName,
+					lineageIntegrationToken,
+					true,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_lineage_integration.my_lineage",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_lineage_integration.my_lineage",
+						"tableau.host",
+						lineageIntegrationHost,
+					),
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_lineage_integration.my_lineage",
+						"host",
+					),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_lineage_integrations.all",
+						"lineage_integrations.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_lineage_integrations.all",
+						"lineage_integrations.0.site_id",
+						lineageIntegrationSiteID,
+					),
 				),
 			},
-			// MODIFY
 			// IMPORT
 			{
 				ResourceName:            "dbtcloud_lineage_integration.my_lineage",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"token"},
+				ImportStateVerifyIgnore: []string{"tableau.token"},
+			},
+		},
+	})
+}
+
+func TestAccDbtCloudLineageIntegrationResourcePowerBI(t *testing.T) {
+
+	tenantID, clientID, clientSecret, workspaceID := "tenant", "client", "secret", "workspace"
+	host, siteID, tokenName, token := "https://tableau.example.com", "site", "token_name", "token"
+
+	if !acctest_helper.IsFakeAPI() {
+		envVarPowerBI, existsPowerBI := os.LookupEnv("DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION_POWER_BI")
+		envVarTableau, existsTableau := os.LookupEnv("DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION")
+		if !existsPowerBI || !existsTableau {
+			t.Skip(
+				"Skipping Power BI lineage configuration acceptance tests as the env vars DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION_POWER_BI and DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION are not set",
+			)
+		}
+
+		powerBIConfigs := strings.Split(envVarPowerBI, "~")
+		tableauConfigs := strings.Split(envVarTableau, "~")
+		if len(powerBIConfigs) != 4 || len(tableauConfigs) != 4 {
+			t.Fatalf(
+				"DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION_POWER_BI env var should be in the format: tenant_id~client_id~client_secret~workspace_id and DBT_ACCEPTANCE_TEST_LINEAGE_INTEGRATION in the format: host~side_id~token_name~token",
+			)
+		}
+		tenantID, clientID, clientSecret, workspaceID = powerBIConfigs[0], powerBIConfigs[1], powerBIConfigs[2], powerBIConfigs[3]
+		host, siteID, tokenName, token = tableauConfigs[0], tableauConfigs[1], tableauConfigs[2], tableauConfigs[3]
+	}
+
+	projectName := strings.ToUpper(acctest_helper.RandomName())
+
+	powerBIConfig := func(workspaceIDs string) string {
+		return fmt.Sprintf(`
+  power_bi = {
+    tenant_id     = "%s"
+    client_id     = "%s"
+    client_secret = "%s"
+    %s
+  }
+`, tenantID, clientID, clientSecret, workspaceIDs)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudLineageIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudLineageIntegrationResourceConfig(
+					projectName,
+					powerBIConfig(fmt.Sprintf(`workspace_ids = ["%s"]`, workspaceID)),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_lineage_integration.my_lineage",
+						"name",
+						"powerbi",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_lineage_integration.my_lineage",
+						"power_bi.tenant_id",
+						tenantID,
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_lineage_integration.my_lineage",
+						"power_bi.workspace_ids.#",
+						"1",
+					),
+					resource.TestCheckTypeSetElemAttr(
+						"dbtcloud_lineage_integration.my_lineage",
+						"power_bi.workspace_ids.*",
+						workspaceID,
+					),
+				),
+			},
+			// MODIFY, removing the workspaces fetches the lineage from all of them
+			{
+				Config: testAccDbtCloudLineageIntegrationResourceConfig(
+					projectName,
+					powerBIConfig(""),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_lineage_integration.my_lineage",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_lineage_integration.my_lineage",
+						"power_bi.workspace_ids",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_lineage_integration.my_lineage",
+						"power_bi.client_id",
+						clientID,
+					),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbtcloud_lineage_integration.my_lineage",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"power_bi.client_secret"},
+			},
+			// MODIFY, changing the BI tool recreates the integration
+			{
+				Config: testAccDbtCloudLineageIntegrationResourceConfig(
+					projectName,
+					fmt.Sprintf(`
+  tableau = {
+    host = "%s"
+    site_id = "%s"
+    token_name = "%s"
+    token = "%s"
+  }
+`, host, siteID, tokenName, token),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_lineage_integration.my_lineage",
+							plancheck.ResourceActionDestroyBeforeCreate,
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_lineage_integration.my_lineage",
+						"name",
+						"tableau",
+					),
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_lineage_integration.my_lineage",
+						"power_bi.tenant_id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDbtCloudLineageIntegrationResourceWithoutProduction(t *testing.T) {
+
+	projectName := strings.ToUpper(acctest_helper.RandomName())
+	projectConfig := fmt.Sprintf(`
+resource "dbtcloud_project" "test_lineage_integration" {
+	name = "%s"
+}
+`, projectName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudLineageIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfig,
+			},
+			// the project exists and has no production environment
+			{
+				Config: projectConfig + `
+resource dbtcloud_lineage_integration my_lineage {
+  project_id = dbtcloud_project.test_lineage_integration.id
+  tableau = {
+    host       = "https://tableau.example.com"
+    site_id    = "site"
+    token_name = "token_name"
+    token      = "token"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("No production environment in the project"),
 			},
 		},
 	})
@@ -72,7 +301,39 @@ func TestAccDbtCloudLineageIntegrationResource(t *testing.T) {
 
 func testAccDbtCloudLineageIntegrationResourceBasicConfig(
 	projectName, host, siteID, tokenName, token string,
+	useTableauBlock bool,
 ) string {
+	lineageConfig := fmt.Sprintf(`
+  host = "%s"
+  site_id = "%s"
+  token_name = "%s"
+  token = "%s"
+`, host, siteID, tokenName, token)
+
+	dataSourceConfig := ""
+	if useTableauBlock {
+		lineageConfig = fmt.Sprintf(`
+  tableau = {
+    host = "%s"
+    site_id = "%s"
+    token_name = "%s"
+    token = "%s"
+  }
+`, host, siteID, tokenName, token)
+
+		dataSourceConfig = `
+data dbtcloud_lineage_integrations all {
+  project_id = dbtcloud_project.test_lineage_integration.id
+
+  depends_on = [dbtcloud_lineage_integration.my_lineage]
+}
+`
+	}
+
+	return testAccDbtCloudLineageIntegrationResourceConfig(projectName, lineageConfig) + dataSourceConfig
+}
+
+func testAccDbtCloudLineageIntegrationResourceConfig(projectName, lineageConfig string) string {
 	return fmt.Sprintf(`
 resource "dbtcloud_project" "test_lineage_integration" {
 	name = "%s"
@@ -108,14 +369,10 @@ resource dbtcloud_environment my_env {
 
 resource dbtcloud_lineage_integration my_lineage {
   project_id = dbtcloud_project.test_lineage_integration.id
-  host = "%s"
-  site_id = "%s"
-  token_name = "%s"
-  token = "%s"
-
+  %s
   depends_on = [dbtcloud_environment.my_env]
 }
-`, projectName, host, siteID, tokenName, token)
+`, projectName, lineageConfig)
 }
 
 func testAccCheckDbtCloudLineageIntegrationDestroy(s *terraform.State) error {
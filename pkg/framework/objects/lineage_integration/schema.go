@@ -4,14 +4,18 @@ import (
 	"context"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const legacyTableauDeprecationMessage = "Use the `tableau` block instead, the attributes at the root of the resource will be removed in the next major version of the provider"
+
 func (r *lineageIntegrationResource) Schema(
 	_ context.Context,
 	_ resource.SchemaRequest,
@@ -19,9 +23,9 @@ func (r *lineageIntegrationResource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		Description: helper.DocString(`
-		Setup lineage integration for dbt Cloud to automatically fetch lineage from external BI tools in dbt Explorer. Currently supports Tableau.
+		Setup lineage integration for dbt Cloud to automatically fetch lineage from external BI tools in dbt Explorer. Currently supports Tableau and Power BI, configured in the ~~~tableau~~~ or ~~~power_bi~~~ block.
 
-		This resource requires having an environment tagged as production already created for you project.
+		This resource requires having an environment tagged as production already created for you project. An error is raised when planning if an existing project doesn't have one, the environment needs to be created first, e.g. with ~~~terraform apply -target~~~.
 		`),
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -47,28 +51,136 @@ func (r *lineageIntegrationResource) Schema(
 			},
 			"name": schema.StringAttribute{
 				Computed:    true,
-				Description: "The integration type. Today only 'tableau' is supported",
-				Default:     stringdefault.StaticString("tableau"),
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Description: "The integration type, `tableau` or `powerbi` depending on the block set. Changing the BI tool recreates the integration",
 			},
 			"host": schema.StringAttribute{
-				Required:    true,
-				Description: "The URL of the BI server (see docs for more details)",
+				Optional:           true,
+				Description:        "The URL of the BI server (see docs for more details)",
+				DeprecationMessage: legacyTableauDeprecationMessage,
 			},
 			"site_id": schema.StringAttribute{
-				Required:    true,
-				Description: "The sitename for the collections of dashboards (see docs for more details)",
+				Optional:           true,
+				Description:        "The sitename for the collections of dashboards (see docs for more details)",
+				DeprecationMessage: legacyTableauDeprecationMessage,
 			},
 			"token_name": schema.StringAttribute{
-				Required:    true,
-				Description: "The token to use to authenticate to the BI server",
+				Optional:           true,
+				Description:        "The token to use to authenticate to the BI server",
+				DeprecationMessage: legacyTableauDeprecationMessage,
 			},
 			"token": schema.StringAttribute{
+				Optional:           true,
+				Sensitive:          true,
+				Description:        "The secret token value to use to authenticate to the BI server",
+				DeprecationMessage: legacyTableauDeprecationMessage,
+			},
+			"tableau": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Tableau configuration",
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						Required:    true,
+						Description: "The URL of the Tableau server (see docs for more details)",
+					},
+					"site_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The sitename for the collections of dashboards (see docs for more details)",
+					},
+					"token_name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the personal access token to use to authenticate to Tableau",
+					},
+					"token": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "The secret value of the personal access token",
+					},
+				},
+			},
+			"power_bi": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Power BI configuration, using a Microsoft Entra ID service principal",
+				Attributes: map[string]schema.Attribute{
+					"tenant_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The ID of the Microsoft Entra ID tenant of the service principal",
+					},
+					"client_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The client ID of the service principal",
+					},
+					"client_secret": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "The client secret of the service principal",
+					},
+					"workspace_ids": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "The IDs of the Power BI workspaces to fetch the lineage from. The lineage is fetched from all the workspaces the service principal can access when not set",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *lineageIntegrationsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasource_schema.Schema{
+		Description: "Retrieve the lineage integrations of a project. The secrets used by the integrations are not returned",
+		Attributes: map[string]datasource_schema.Attribute{
+			"project_id": datasource_schema.Int64Attribute{
 				Required:    true,
-				Sensitive:   true,
-				Description: "The secret token value to use to authenticate to the BI server",
+				Description: "The ID of the project",
+			},
+			"lineage_integrations": datasource_schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of lineage integrations of the project",
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"id": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Combination of `project_id` and `lineage_integration_id`",
+						},
+						"lineage_integration_id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the lineage integration",
+						},
+						"name": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The integration type, `tableau` or `powerbi`",
+						},
+						"host": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The URL of the Tableau server",
+						},
+						"site_id": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The Tableau sitename",
+						},
+						"token_name": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Tableau personal access token",
+						},
+						"tenant_id": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The Microsoft Entra ID tenant of the Power BI service principal",
+						},
+						"client_id": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The client ID of the Power BI service principal",
+						},
+						"workspace_ids": datasource_schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the Power BI workspaces the lineage is fetched from",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -74,7 +74,7 @@ func (d *notificationDataSource) Schema(
 			},
 			"notification_type": schema.Int64Attribute{
 				Computed:    true,
-				Description: "Type of notification (1 = dbt Cloud user email (default): does not require an external_email ; 2 = Slack channel: requires `slack_channel_id` and `slack_channel_name` ; 4 = external email: requires setting an `external_email`)",
+				Description: "Type of notification (1 = dbt Cloud user email (default): does not require an external_email ; 2 = Slack channel: requires `slack_channel_id` and `slack_channel_name` ; 4 = external email: requires setting an `external_email` ; 5 = Microsoft Teams channel: requires `ms_teams_channel_id` and `ms_teams_channel_name`)",
 			},
 			"external_email": schema.StringAttribute{
 				Computed:    true,
@@ -88,6 +88,14 @@ func (d *notificationDataSource) Schema(
 				Computed:    true,
 				Description: "The name of the slack channel",
 			},
+			"ms_teams_channel_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the Microsoft Teams channel to receive the notification",
+			},
+			"ms_teams_channel_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the Microsoft Teams channel",
+			},
 		},
 	}
 }
@@ -144,6 +152,8 @@ func (d *notificationDataSource) Read(
 	data.ExternalEmail = types.StringPointerValue(notification.ExternalEmail)
 	data.SlackChannelID = types.StringPointerValue(notification.SlackChannelID)
 	data.SlackChannelName = types.StringPointerValue(notification.SlackChannelName)
+	data.TeamsChannelID = types.StringPointerValue(notification.TeamsChannelID)
+	data.TeamsChannelName = types.StringPointerValue(notification.TeamsChannelName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -24,9 +23,9 @@ func TestAccDbtCloudNotificationDataSource(t *testing.T) {
 	}
 
 	currentTime := time.Now().Unix()
-	notificationEmail := fmt.Sprintf("%d-datasource@nomail.com", currentTime)
+	notificationEmail := fmt.Sprintf("%s%d-datasource@nomail.com", acctest_helper.TestNamePrefix, currentTime)
 
-	randomProjectName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	randomProjectName := acctest_helper.RandomName()
 
 	config := notification(randomProjectName, userID, notificationEmail)
 
@@ -48,6 +47,7 @@ func TestAccDbtCloudNotificationDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
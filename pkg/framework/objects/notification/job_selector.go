@@ -0,0 +1,335 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+var (
+	jobSelectorEvents = map[string]string{
+		"cancel":  "on_cancel",
+		"failure": "on_failure",
+		"warning": "on_warning",
+		"success": "on_success",
+	}
+	jobSelectorDeploymentTypes = []string{"production", "staging", "general"}
+)
+
+type JobSelectorModel struct {
+	ProjectIDs     types.Set    `tfsdk:"project_ids"`
+	EnvironmentIDs types.Set    `tfsdk:"environment_ids"`
+	JobNameRegex   types.String `tfsdk:"job_name_regex"`
+	DeploymentType types.String `tfsdk:"deployment_type"`
+	NotifyOn       types.Set    `tfsdk:"notify_on"`
+}
+
+// JobSelectorSchema returns the schema of the job_selector attribute, shared between notification resources
+func JobSelectorSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Description: helper.DocString(
+			`Select jobs dynamically instead of listing their IDs. The matching jobs are retrieved at plan time and added to the ~~~on_<event>~~~ sets of the events listed in ~~~notify_on~~~, so that new or deleted jobs show up as changes in the plan.
+			The ~~~on_<event>~~~ attributes of the events listed in ~~~notify_on~~~ can't be set when using a job selector.`,
+		),
+		Attributes: map[string]schema.Attribute{
+			"project_ids": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Select the jobs of those projects. At least one of `project_ids` or `environment_ids` is required",
+			},
+			"environment_ids": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Select the jobs of those environments. When used with `project_ids`, only the jobs matching both the projects and the environments are selected",
+			},
+			"job_name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only select the jobs with a name matching this regular expression",
+			},
+			"deployment_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only select the jobs of environments with this deployment type - Possible values are `production`, `staging` or `general` for environments without a deployment type",
+				Validators: []validator.String{
+					stringvalidator.OneOf(jobSelectorDeploymentTypes...),
+				},
+			},
+			"notify_on": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The events triggering the notification for the selected jobs - Possible values are `cancel`, `failure`, `warning` and `success`",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("cancel", "failure", "warning", "success"),
+					),
+				},
+			},
+		},
+	}
+}
+
+// ValidateJobSelectorConfig checks that the job IDs of the events handled by the job selector are not also set statically
+func ValidateJobSelectorConfig(ctx context.Context, data NotificationResourceModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if data.JobSelector == nil {
+		return diags
+	}
+
+	if data.JobSelector.ProjectIDs.IsNull() && data.JobSelector.EnvironmentIDs.IsNull() {
+		diags.AddAttributeError(
+			path.Root("job_selector"),
+			"Missing job selector scope",
+			"At least one of `project_ids` or `environment_ids` is required in `job_selector`.",
+		)
+	}
+
+	if !data.JobSelector.JobNameRegex.IsNull() && !data.JobSelector.JobNameRegex.IsUnknown() {
+		_, err := regexp.Compile(data.JobSelector.JobNameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("job_selector").AtName("job_name_regex"),
+				"Invalid regular expression",
+				err.Error(),
+			)
+		}
+	}
+
+	if data.JobSelector.NotifyOn.IsUnknown() {
+		return diags
+	}
+
+	configuredSets := map[string]types.Set{
+		"on_cancel":  data.OnCancel,
+		"on_failure": data.OnFailure,
+		"on_warning": data.OnWarning,
+		"on_success": data.OnSuccess,
+	}
+
+	for _, event := range helper.StringSetToStringSlice(data.JobSelector.NotifyOn) {
+		attributeName, ok := jobSelectorEvents[event]
+		if !ok {
+			continue
+		}
+		if !configuredSets[attributeName].IsNull() {
+			diags.AddAttributeError(
+				path.Root(attributeName),
+				"Job IDs already set by the job selector",
+				fmt.Sprintf(
+					"`%s` can't be set when `%s` is listed in `job_selector.notify_on`. Remove one or the other.",
+					attributeName,
+					event,
+				),
+			)
+		}
+	}
+
+	return diags
+}
+
+// ModifyPlanForJobSelector sets the job IDs of the events handled by the job selector to the jobs currently matching it
+func ModifyPlanForJobSelector(
+	ctx context.Context,
+	client *dbt_cloud.Client,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		// nothing to do when the resource is destroyed
+		return
+	}
+
+	var plan NotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selector := plan.JobSelector
+	if selector == nil || selector.NotifyOn.IsUnknown() {
+		return
+	}
+
+	notifyOn := helper.StringSetToStringSlice(selector.NotifyOn)
+
+	var newJobIDs types.Set
+	if isSetPartiallyUnknown(selector.ProjectIDs) ||
+		isSetPartiallyUnknown(selector.EnvironmentIDs) ||
+		selector.JobNameRegex.IsUnknown() ||
+		selector.DeploymentType.IsUnknown() {
+		// we can't know the jobs yet
+		newJobIDs = types.SetUnknown(types.Int64Type)
+	} else {
+		jobIDs, diags := ExpandJobSelector(client, *selector)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		newJobIDs, diags = types.SetValueFrom(ctx, types.Int64Type, jobIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for _, event := range notifyOn {
+		attributeName, ok := jobSelectorEvents[event]
+		if !ok {
+			continue
+		}
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root(attributeName), newJobIDs)...,
+		)
+	}
+}
+
+// ResolveJobSelector sets the job IDs that were not known at plan time, when the job selector depends on other resources
+func ResolveJobSelector(
+	ctx context.Context,
+	client *dbt_cloud.Client,
+	model *NotificationResourceModel,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if model.JobSelector == nil {
+		return diags
+	}
+
+	modelSets := map[string]*types.Set{
+		"on_cancel":  &model.OnCancel,
+		"on_failure": &model.OnFailure,
+		"on_warning": &model.OnWarning,
+		"on_success": &model.OnSuccess,
+	}
+
+	var jobIDs types.Set
+	for _, event := range helper.StringSetToStringSlice(model.JobSelector.NotifyOn) {
+		attributeName, ok := jobSelectorEvents[event]
+		if !ok || !modelSets[attributeName].IsUnknown() {
+			continue
+		}
+
+		if jobIDs.IsNull() {
+			selectedJobIDs, expandDiags := ExpandJobSelector(client, *model.JobSelector)
+			diags.Append(expandDiags...)
+			if diags.HasError() {
+				return diags
+			}
+			var setDiags diag.Diagnostics
+			jobIDs, setDiags = types.SetValueFrom(ctx, types.Int64Type, selectedJobIDs)
+			diags.Append(setDiags...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+		*modelSets[attributeName] = jobIDs
+	}
+
+	return diags
+}
+
+// isSetPartiallyUnknown returns true if the set or some of its elements are not known yet
+func isSetPartiallyUnknown(set types.Set) bool {
+	if set.IsUnknown() {
+		return true
+	}
+	for _, el := range set.Elements() {
+		if el.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandJobSelector returns the IDs of the jobs currently matching the selector
+func ExpandJobSelector(
+	client *dbt_cloud.Client,
+	selector JobSelectorModel,
+) ([]int, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	projectIDs := []int{}
+	if !selector.ProjectIDs.IsNull() {
+		projectIDs = helper.Int64SetToIntSlice(selector.ProjectIDs)
+	}
+	environmentIDs := []int{}
+	if !selector.EnvironmentIDs.IsNull() {
+		environmentIDs = helper.Int64SetToIntSlice(selector.EnvironmentIDs)
+	}
+
+	var jobNameRegex *regexp.Regexp
+	if !selector.JobNameRegex.IsNull() {
+		var err error
+		jobNameRegex, err = regexp.Compile(selector.JobNameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("job_selector").AtName("job_name_regex"),
+				"Invalid regular expression",
+				err.Error(),
+			)
+			return nil, diags
+		}
+	}
+
+	// the API only allows filtering by either project or environment
+	allJobs := []dbt_cloud.JobWithEnvironment{}
+	if len(projectIDs) > 0 {
+		for _, projectID := range projectIDs {
+			jobs, err := client.GetAllJobs(projectID, 0)
+			if err != nil {
+				diags.AddError("Unable to retrieve the jobs of the job selector", err.Error())
+				return nil, diags
+			}
+			allJobs = append(allJobs, jobs...)
+		}
+	} else {
+		for _, environmentID := range environmentIDs {
+			jobs, err := client.GetAllJobs(0, environmentID)
+			if err != nil {
+				diags.AddError("Unable to retrieve the jobs of the job selector", err.Error())
+				return nil, diags
+			}
+			allJobs = append(allJobs, jobs...)
+		}
+	}
+
+	jobIDs := []int{}
+	for _, job := range allJobs {
+		if job.ID == nil || job.State == dbt_cloud.STATE_DELETED {
+			continue
+		}
+		if len(environmentIDs) > 0 && !slices.Contains(environmentIDs, job.Environment_Id) {
+			continue
+		}
+		if jobNameRegex != nil && !jobNameRegex.MatchString(job.Name) {
+			continue
+		}
+		if !selector.DeploymentType.IsNull() {
+			deploymentType := "general"
+			if job.Environment.DeploymentType != nil && *job.Environment.DeploymentType != "" {
+				deploymentType = *job.Environment.DeploymentType
+			}
+			if deploymentType != selector.DeploymentType.ValueString() {
+				continue
+			}
+		}
+		jobIDs = append(jobIDs, *job.ID)
+	}
+
+	return lo.Uniq(jobIDs), diags
+}
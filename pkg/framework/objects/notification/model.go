@@ -2,24 +2,30 @@ package notification
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type NotificationResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	UserID           types.Int64  `tfsdk:"user_id"`
-	OnCancel         types.Set    `tfsdk:"on_cancel"`
-	OnFailure        types.Set    `tfsdk:"on_failure"`
-	OnWarning        types.Set    `tfsdk:"on_warning"`
-	OnSuccess        types.Set    `tfsdk:"on_success"`
-	State            types.Int64  `tfsdk:"state"`
-	NotificationType types.Int64  `tfsdk:"notification_type"`
-	ExternalEmail    types.String `tfsdk:"external_email"`
-	SlackChannelID   types.String `tfsdk:"slack_channel_id"`
-	SlackChannelName types.String `tfsdk:"slack_channel_name"`
+	ID               types.String      `tfsdk:"id"`
+	UserID           types.Int64       `tfsdk:"user_id"`
+	OnCancel         types.Set         `tfsdk:"on_cancel"`
+	OnFailure        types.Set         `tfsdk:"on_failure"`
+	OnWarning        types.Set         `tfsdk:"on_warning"`
+	OnSuccess        types.Set         `tfsdk:"on_success"`
+	State            types.Int64       `tfsdk:"state"`
+	NotificationType types.Int64       `tfsdk:"notification_type"`
+	ExternalEmail    types.String      `tfsdk:"external_email"`
+	SlackChannelID   types.String      `tfsdk:"slack_channel_id"`
+	SlackChannelName types.String      `tfsdk:"slack_channel_name"`
+	TeamsChannelID   types.String      `tfsdk:"ms_teams_channel_id"`
+	TeamsChannelName types.String      `tfsdk:"ms_teams_channel_name"`
+	JobSelector      *JobSelectorModel `tfsdk:"job_selector"`
 }
 
 type NotificationDataSourceModel struct {
@@ -34,6 +40,8 @@ type NotificationDataSourceModel struct {
 	ExternalEmail    types.String `tfsdk:"external_email"`
 	SlackChannelID   types.String `tfsdk:"slack_channel_id"`
 	SlackChannelName types.String `tfsdk:"slack_channel_name"`
+	TeamsChannelID   types.String `tfsdk:"ms_teams_channel_id"`
+	TeamsChannelName types.String `tfsdk:"ms_teams_channel_name"`
 }
 
 func ConvertNotificationModelToData(model NotificationResourceModel) dbt_cloud.Notification {
@@ -70,5 +78,35 @@ func ConvertNotificationModelToData(model NotificationResourceModel) dbt_cloud.N
 		notification.SlackChannelName = &slackChannelName
 	}
 
+	if !model.TeamsChannelID.IsNull() {
+		teamsChannelID := model.TeamsChannelID.ValueString()
+		notification.TeamsChannelID = &teamsChannelID
+	}
+
+	if !model.TeamsChannelName.IsNull() {
+		teamsChannelName := model.TeamsChannelName.ValueString()
+		notification.TeamsChannelName = &teamsChannelName
+	}
+
 	return notification
 }
+
+// CheckSlackIntegration returns an error diagnostic if the account doesn't have an active Slack integration
+// as Slack notifications can't be delivered without it
+func CheckSlackIntegration(client *dbt_cloud.Client) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	_, err := client.GetSlackIntegration()
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			diags.AddAttributeError(
+				path.Root("notification_type"),
+				"Slack integration not configured",
+				"Slack notifications require the Slack integration to be configured for the dbt Cloud account. Please set it up in the dbt Cloud Account Settings before creating Slack notifications.",
+			)
+			return diags
+		}
+		diags.AddError("Error getting the Slack integration", err.Error())
+	}
+	return diags
+}
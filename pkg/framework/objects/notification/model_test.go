@@ -0,0 +1,153 @@
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/fake_api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConvertNotificationModelToData(t *testing.T) {
+	t.Parallel()
+
+	jobIDs := types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)})
+	emptyJobIDs := types.SetValueMust(types.Int64Type, []attr.Value{})
+
+	testCases := []struct {
+		name     string
+		model    NotificationResourceModel
+		expected map[string]any
+	}{
+		{
+			name: "teams notification",
+			model: NotificationResourceModel{
+				ID:               types.StringValue("10"),
+				UserID:           types.Int64Value(100),
+				OnCancel:         emptyJobIDs,
+				OnFailure:        jobIDs,
+				OnWarning:        emptyJobIDs,
+				OnSuccess:        emptyJobIDs,
+				State:            types.Int64Value(1),
+				NotificationType: types.Int64Value(5),
+				ExternalEmail:    types.StringNull(),
+				SlackChannelID:   types.StringNull(),
+				SlackChannelName: types.StringNull(),
+				TeamsChannelID:   types.StringValue("19:abc@thread.tacv2"),
+				TeamsChannelName: types.StringValue("data-alerts"),
+			},
+			expected: map[string]any{
+				"id":                    float64(10),
+				"type":                  float64(5),
+				"ms_teams_channel_id":   "19:abc@thread.tacv2",
+				"ms_teams_channel_name": "data-alerts",
+				"slack_channel_id":      nil,
+				"external_email":        nil,
+			},
+		},
+		{
+			// the Teams channel is sent as null to remove it, like the Slack channel
+			name: "email notification",
+			model: NotificationResourceModel{
+				ID:               types.StringNull(),
+				UserID:           types.Int64Value(100),
+				OnCancel:         emptyJobIDs,
+				OnFailure:        jobIDs,
+				OnWarning:        emptyJobIDs,
+				OnSuccess:        emptyJobIDs,
+				State:            types.Int64Value(1),
+				NotificationType: types.Int64Value(4),
+				ExternalEmail:    types.StringValue("team@example.com"),
+				SlackChannelID:   types.StringNull(),
+				SlackChannelName: types.StringNull(),
+				TeamsChannelID:   types.StringNull(),
+				TeamsChannelName: types.StringNull(),
+			},
+			expected: map[string]any{
+				"type":                  float64(4),
+				"external_email":        "team@example.com",
+				"slack_channel_id":      nil,
+				"slack_channel_name":    nil,
+				"ms_teams_channel_id":   nil,
+				"ms_teams_channel_name": nil,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		data, err := json.Marshal(ConvertNotificationModelToData(testCase.model))
+		if err != nil {
+			t.Fatalf("%s: %s", testCase.name, err)
+		}
+		fields := map[string]any{}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			t.Fatalf("%s: %s", testCase.name, err)
+		}
+
+		for key, expected := range testCase.expected {
+			value, ok := fields[key]
+			if !ok {
+				t.Errorf("%s: expected %s to be sent, got %s", testCase.name, key, data)
+				continue
+			}
+			if value != expected {
+				t.Errorf("%s: expected %s to be %v, got %v", testCase.name, key, expected, value)
+			}
+		}
+	}
+}
+
+func TestCheckSlackIntegration(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		integration   map[string]any
+		expectedError string
+	}{
+		{
+			name:        "active integration",
+			integration: map[string]any{"team_id": "T1", "team_name": "dbt", "state": 1},
+		},
+		{
+			name:          "disconnected integration",
+			integration:   map[string]any{"team_id": "T1", "team_name": "dbt", "state": 2},
+			expectedError: "Slack integration not configured",
+		},
+		{
+			name:          "no integration",
+			expectedError: "Slack integration not configured",
+		},
+	}
+
+	for _, testCase := range testCases {
+		server := fake_api.NewServer(100, "token")
+		if testCase.integration != nil {
+			server.Seed("integrations/slack", testCase.integration)
+		}
+		client := &dbt_cloud.Client{
+			HTTPClient: &http.Client{Timeout: 5 * time.Second},
+			HostURL:    server.HostURL(),
+			Token:      server.Token,
+			AccountID:  server.AccountID,
+		}
+
+		diags := CheckSlackIntegration(client)
+		server.Close()
+
+		if testCase.expectedError == "" {
+			if diags.HasError() {
+				t.Errorf("%s: unexpected diagnostics: %v", testCase.name, diags)
+			}
+			continue
+		}
+		if !diags.HasError() || !strings.Contains(diags.Errors()[0].Summary(), testCase.expectedError) {
+			t.Errorf("%s: expected the error %q, got %v", testCase.name, testCase.expectedError, diags)
+		}
+	}
+}
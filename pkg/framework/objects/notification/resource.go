@@ -16,6 +16,7 @@ var (
 	_ resource.ResourceWithConfigure      = &notificationResource{}
 	_ resource.ResourceWithImportState    = &notificationResource{}
 	_ resource.ResourceWithValidateConfig = &notificationResource{}
+	_ resource.ResourceWithModifyPlan     = &notificationResource{}
 )
 
 func NotificationResource() resource.Resource {
@@ -48,11 +49,12 @@ func (r *notificationResource) ValidateConfig(
 	}
 
 	if data.NotificationType == types.Int64Value(1) &&
-		!(data.ExternalEmail.IsNull() && data.SlackChannelID.IsNull() && data.SlackChannelName.IsNull()) {
+		!(data.ExternalEmail.IsNull() && data.SlackChannelID.IsNull() && data.SlackChannelName.IsNull() &&
+			data.TeamsChannelID.IsNull() && data.TeamsChannelName.IsNull()) {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("notification_type"),
 			"Notification type is not compatible with the other attributes",
-			"Notification type 1 is for internal notifications only. Please remove the external email, slack channel ID, and slack channel name attributes.",
+			"Notification type 1 is for internal notifications only. Please remove the external email, Slack channel and Microsoft Teams channel attributes.",
 		)
 	}
 
@@ -73,6 +75,26 @@ func (r *notificationResource) ValidateConfig(
 			"Notification type 4 requires an external email.",
 		)
 	}
+
+	if data.NotificationType == types.Int64Value(5) &&
+		(data.TeamsChannelID.IsNull() || data.TeamsChannelName.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("notification_type"),
+			"Notification type is not compatible with the other attributes",
+			"Notification type 5 requires a Microsoft Teams channel ID and Microsoft Teams channel name.",
+		)
+	}
+
+	resp.Diagnostics.Append(ValidateJobSelectorConfig(ctx, data)...)
+}
+
+func (r *notificationResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// the job IDs of the job selector are refreshed at every plan
+	ModifyPlanForJobSelector(ctx, r.client, req, resp)
 }
 
 func (r *notificationResource) Read(
@@ -126,6 +148,8 @@ func (r *notificationResource) Read(
 	data.ExternalEmail = types.StringPointerValue(notification.ExternalEmail)
 	data.SlackChannelID = types.StringPointerValue(notification.SlackChannelID)
 	data.SlackChannelName = types.StringPointerValue(notification.SlackChannelName)
+	data.TeamsChannelID = types.StringPointerValue(notification.TeamsChannelID)
+	data.TeamsChannelName = types.StringPointerValue(notification.TeamsChannelName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
@@ -143,6 +167,18 @@ func (r *notificationResource) Create(
 		return
 	}
 
+	resp.Diagnostics.Append(ResolveJobSelector(ctx, r.client, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.NotificationType == types.Int64Value(2) {
+		resp.Diagnostics.Append(CheckSlackIntegration(r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var intOnCancel, intOnFailure, intOnWarning, intOnSuccess []int
 
 	diags := data.OnCancel.ElementsAs(context.Background(), &intOnCancel, false)
@@ -176,6 +212,8 @@ func (r *notificationResource) Create(
 		data.ExternalEmail.ValueStringPointer(),
 		data.SlackChannelID.ValueStringPointer(),
 		data.SlackChannelName.ValueStringPointer(),
+		data.TeamsChannelID.ValueStringPointer(),
+		data.TeamsChannelName.ValueStringPointer(),
 	)
 
 	if err != nil {
@@ -234,6 +272,18 @@ func (r *notificationResource) Update(
 		return
 	}
 
+	resp.Diagnostics.Append(ResolveJobSelector(ctx, r.client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.NotificationType == types.Int64Value(2) && plan.NotificationType != state.NotificationType {
+		resp.Diagnostics.Append(CheckSlackIntegration(r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if plan.UserID != state.UserID {
 		state.UserID = plan.UserID
 	}
@@ -274,6 +324,17 @@ func (r *notificationResource) Update(
 		state.SlackChannelName = plan.SlackChannelName
 	}
 
+	if plan.TeamsChannelID != state.TeamsChannelID {
+		state.TeamsChannelID = plan.TeamsChannelID
+	}
+
+	if plan.TeamsChannelName != state.TeamsChannelName {
+		state.TeamsChannelName = plan.TeamsChannelName
+	}
+
+	// the job selector is only used at plan time to compute the job IDs
+	state.JobSelector = plan.JobSelector
+
 	notification := ConvertNotificationModelToData(state)
 	notification.AccountId = r.client.AccountID
 
@@ -2,13 +2,13 @@ package notification_test
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -27,9 +27,9 @@ func TestAccDbtCloudNotificationResource(t *testing.T) {
 	}
 
 	currentTime := time.Now().Unix()
-	notificationEmail := fmt.Sprintf("%d-resource@nomail.com", currentTime)
+	notificationEmail := fmt.Sprintf("%s%d-resource@nomail.com", acctest_helper.TestNamePrefix, currentTime)
 
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -141,6 +141,231 @@ func TestAccDbtCloudNotificationResource(t *testing.T) {
 	})
 }
 
+func TestAccDbtCloudNotificationResourceJobSelector(t *testing.T) {
+
+	if acctest_helper.IsDbtCloudPR() {
+		t.Skip("Skipping notifications in dbt Cloud CI for now")
+	}
+
+	currentTime := time.Now().Unix()
+	notificationEmail := fmt.Sprintf("%s%d-selector@nomail.com", acctest_helper.TestNamePrefix, currentTime)
+
+	projectName := strings.ToUpper(acctest_helper.RandomName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudNotificationDestroy,
+		Steps: []resource.TestStep{
+			// INVALID config with the same event in the selector and the static list, checked first as the
+			// config of the last step is used to destroy the resources
+			{
+				Config: testAccDbtCloudNotificationResourceBasicConfig(projectName) + `
+resource "dbtcloud_notification" "test_notification_selector" {
+	user_id           = 100
+	on_failure        = [dbtcloud_job.test_notification_job_1.id]
+	notification_type = 1
+	job_selector = {
+		project_ids = [dbtcloud_project.test_notification_project.id]
+		notify_on   = ["failure"]
+	}
+}
+`,
+				ExpectError: regexp.MustCompile("Job IDs already set by the job selector"),
+			},
+			{
+				Config: testAccDbtCloudNotificationResourceJobSelector(
+					projectName,
+					notificationEmail,
+					".*",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudNotificationExists(
+						"dbtcloud_notification.test_notification_selector",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_notification.test_notification_selector",
+						"on_failure.#",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_notification.test_notification_selector",
+						"on_cancel.#",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_notification.test_notification_selector",
+						"on_success.#",
+						"1",
+					),
+				),
+			},
+			// MODIFY the regex to only select one job
+			{
+				Config: testAccDbtCloudNotificationResourceJobSelector(
+					projectName,
+					notificationEmail,
+					"^Job 1",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_notification.test_notification_selector",
+						"on_failure.#",
+						"1",
+					),
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_notification.test_notification_selector",
+						"on_failure.0",
+						"dbtcloud_job.test_notification_job_1",
+						"id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDbtCloudNotificationResourceTeams(t *testing.T) {
+
+	// the channel needs to be in a team connected to the account with the Microsoft Teams integration
+	teamsChannelID := os.Getenv("DBT_ACCEPTANCE_TEST_MS_TEAMS_CHANNEL_ID")
+	teamsChannelName := os.Getenv("DBT_ACCEPTANCE_TEST_MS_TEAMS_CHANNEL_NAME")
+	if acctest_helper.IsFakeAPI() {
+		teamsChannelID = "19:tf-acc@thread.tacv2"
+		teamsChannelName = "tf-acc-alerts"
+	}
+	if teamsChannelID == "" || teamsChannelName == "" {
+		t.Skip(
+			"Skipping Teams notifications, DBT_ACCEPTANCE_TEST_MS_TEAMS_CHANNEL_ID and DBT_ACCEPTANCE_TEST_MS_TEAMS_CHANNEL_NAME are not set",
+		)
+	}
+
+	projectName := strings.ToUpper(acctest_helper.RandomName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudNotificationResourceTeams(
+					projectName,
+					teamsChannelID,
+					teamsChannelName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudNotificationExists(
+						"dbtcloud_notification.test_notification_teams",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_notification.test_notification_teams",
+						"notification_type",
+						"5",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_notification.test_notification_teams",
+						"ms_teams_channel_id",
+						teamsChannelID,
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_notification.test_notification_teams",
+						"ms_teams_channel_name",
+						teamsChannelName,
+					),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_notification.test_notification_teams",
+						"on_failure.0",
+					),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbtcloud_notification.test_notification_teams",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func TestAccDbtCloudNotificationResourceSlackWithoutIntegration(t *testing.T) {
+
+	// the accounts used for the acceptance tests might have the Slack integration configured
+	if !acctest_helper.IsFakeAPI() {
+		t.Skip("Skipping the Slack integration check, it requires an account without the Slack integration")
+	}
+
+	projectName := strings.ToUpper(acctest_helper.RandomName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDbtCloudNotificationResourceSlack(projectName),
+				ExpectError: regexp.MustCompile("Slack integration not configured"),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudNotificationResourceTeams(
+	projectName, teamsChannelID, teamsChannelName string,
+) string {
+
+	notificationsConfig := fmt.Sprintf(`
+resource "dbtcloud_notification" "test_notification_teams" {
+	user_id               = 100
+	on_failure            = [dbtcloud_job.test_notification_job_1.id]
+	notification_type     = 5
+	ms_teams_channel_id   = "%s"
+	ms_teams_channel_name = "%s"
+}
+`, teamsChannelID, teamsChannelName)
+	return testAccDbtCloudNotificationResourceBasicConfig(projectName) + "\n" + notificationsConfig
+}
+
+func testAccDbtCloudNotificationResourceSlack(projectName string) string {
+
+	notificationsConfig := `
+resource "dbtcloud_notification" "test_notification_slack" {
+	user_id            = 100
+	on_failure         = [dbtcloud_job.test_notification_job_1.id]
+	notification_type  = 2
+	slack_channel_id   = "C0123456789"
+	slack_channel_name = "#tf-acc-alerts"
+}
+`
+	return testAccDbtCloudNotificationResourceBasicConfig(projectName) + "\n" + notificationsConfig
+}
+
+func testAccDbtCloudNotificationResourceJobSelector(
+	projectName, notificationEmail, jobNameRegex string,
+) string {
+
+	notificationsConfig := fmt.Sprintf(`
+resource "dbtcloud_notification" "test_notification_selector" {
+	user_id           = 100
+	on_success        = [dbtcloud_job.test_notification_job_2.id]
+	notification_type = 4
+	external_email    = "%s"
+	job_selector = {
+		project_ids    = [dbtcloud_project.test_notification_project.id]
+		job_name_regex = "%s"
+		notify_on      = ["failure", "cancel"]
+	}
+
+	depends_on = [
+		dbtcloud_job.test_notification_job_1,
+		dbtcloud_job.test_notification_job_2,
+	]
+}
+`, notificationEmail, jobNameRegex)
+	return testAccDbtCloudNotificationResourceBasicConfig(projectName) + "\n" + notificationsConfig
+}
+
 func testAccDbtCloudNotificationResourceBasicConfig(projectName string) string {
 	return fmt.Sprintf(`
 resource "dbtcloud_project" "test_notification_project" {
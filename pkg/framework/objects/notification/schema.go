@@ -22,7 +22,7 @@ func (r *notificationResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
-		Description: "Setup notifications on jobs success/failure to internal users, external email addresses or Slack channels",
+		Description: "Setup notifications on jobs success/failure to internal users, external email addresses, Slack channels or Microsoft Teams channels",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -77,7 +77,7 @@ func (r *notificationResource) Schema(
 				Optional:    true,
 				Computed:    true,
 				Default:     int64default.StaticInt64(1),
-				Description: "Type of notification (1 = dbt Cloud user email (default): does not require an external_email ; 2 = Slack channel: requires `slack_channel_id` and `slack_channel_name` ; 4 = external email: requires setting an `external_email`)",
+				Description: "Type of notification (1 = dbt Cloud user email (default): does not require an external_email ; 2 = Slack channel: requires `slack_channel_id` and `slack_channel_name` ; 4 = external email: requires setting an `external_email` ; 5 = Microsoft Teams channel: requires `ms_teams_channel_id` and `ms_teams_channel_name`)",
 			},
 			"external_email": schema.StringAttribute{
 				Optional:    true,
@@ -86,23 +86,56 @@ func (r *notificationResource) Schema(
 					stringvalidator.ConflictsWith(
 						path.MatchRoot("slack_channel_id"),
 						path.MatchRoot("slack_channel_name"),
+						path.MatchRoot("ms_teams_channel_id"),
+						path.MatchRoot("ms_teams_channel_name"),
 					),
 				},
 			},
 			"slack_channel_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "The ID of the Slack channel to receive the notification. It can be found at the bottom of the Slack channel settings",
+				Description: "The ID of the Slack channel to receive the notification. It can be found at the bottom of the Slack channel settings or with the `dbtcloud_slack_channels` data source",
 				Validators: []validator.String{
-					stringvalidator.ConflictsWith(path.MatchRoot("external_email")),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("external_email"),
+						path.MatchRoot("ms_teams_channel_id"),
+						path.MatchRoot("ms_teams_channel_name"),
+					),
 				},
 			},
 			"slack_channel_name": schema.StringAttribute{
 				Optional:    true,
 				Description: "The name of the slack channel",
 				Validators: []validator.String{
-					stringvalidator.ConflictsWith(path.MatchRoot("external_email")),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("external_email"),
+						path.MatchRoot("ms_teams_channel_id"),
+						path.MatchRoot("ms_teams_channel_name"),
+					),
+				},
+			},
+			"ms_teams_channel_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the Microsoft Teams channel to receive the notification",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("external_email"),
+						path.MatchRoot("slack_channel_id"),
+						path.MatchRoot("slack_channel_name"),
+					),
+				},
+			},
+			"ms_teams_channel_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the Microsoft Teams channel",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("external_email"),
+						path.MatchRoot("slack_channel_id"),
+						path.MatchRoot("slack_channel_name"),
+					),
 				},
 			},
+			"job_selector": JobSelectorSchema(),
 		},
 	}
 }
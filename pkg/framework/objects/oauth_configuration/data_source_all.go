@@ -0,0 +1,79 @@
+package oauth_configuration
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var (
+	_ datasource.DataSource              = &oAuthConfigurationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &oAuthConfigurationsDataSource{}
+)
+
+func OAuthConfigurationsDataSource() datasource.DataSource {
+	return &oAuthConfigurationsDataSource{}
+}
+
+type oAuthConfigurationsDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *oAuthConfigurationsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_oauth_configurations"
+}
+
+func (d *oAuthConfigurationsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state OAuthConfigurationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oAuthConfigurations, err := d.client.GetAllOAuthConfigurations()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue when retrieving the OAuth configurations",
+			err.Error(),
+		)
+		return
+	}
+
+	state.OAuthConfigurations = []OAuthConfigurationDataSourceModel{}
+	for _, oAuthConfiguration := range oAuthConfigurations {
+		if !state.Name.IsNull() && oAuthConfiguration.Name != state.Name.ValueString() {
+			continue
+		}
+		if !state.Type.IsNull() && oAuthConfiguration.Type != state.Type.ValueString() {
+			continue
+		}
+		state.OAuthConfigurations = append(
+			state.OAuthConfigurations,
+			oAuthConfigurationToDataSourceModel(oAuthConfiguration),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *oAuthConfigurationsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
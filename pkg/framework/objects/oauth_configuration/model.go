@@ -1,17 +1,132 @@
 package oauth_configuration
 
 import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
 )
 
 type OAuthConfigurationResourceModel struct {
-	ID               types.Int64  `tfsdk:"id"`
-	Type             types.String `tfsdk:"type"`
-	Name             types.String `tfsdk:"name"`
-	ClientId         types.String `tfsdk:"client_id"`
-	ClientSecret     types.String `tfsdk:"client_secret"`
-	AuthorizeUrl     types.String `tfsdk:"authorize_url"`
-	TokenUrl         types.String `tfsdk:"token_url"`
-	RedirectUri      types.String `tfsdk:"redirect_uri"`
-	ApplicationIdUri types.String `tfsdk:"application_id_uri"`
+	ID                      types.Int64  `tfsdk:"id"`
+	Type                    types.String `tfsdk:"type"`
+	Name                    types.String `tfsdk:"name"`
+	ClientId                types.String `tfsdk:"client_id"`
+	ClientSecret            types.String `tfsdk:"client_secret"`
+	ClientSecretVersion     types.Int64  `tfsdk:"client_secret_version"`
+	AuthorizeUrl            types.String `tfsdk:"authorize_url"`
+	TokenUrl                types.String `tfsdk:"token_url"`
+	RedirectUri             types.String `tfsdk:"redirect_uri"`
+	ApplicationIdUri        types.String `tfsdk:"application_id_uri"`
+	Issuer                  types.String `tfsdk:"issuer"`
+	Audience                types.String `tfsdk:"audience"`
+	Scopes                  types.Set    `tfsdk:"scopes"`
+	SecurityIntegrationName types.String `tfsdk:"security_integration_name"`
+}
+
+type OAuthConfigurationsDataSourceModel struct {
+	Name                types.String                        `tfsdk:"name"`
+	Type                types.String                        `tfsdk:"type"`
+	OAuthConfigurations []OAuthConfigurationDataSourceModel `tfsdk:"oauth_configurations"`
+}
+
+type OAuthConfigurationDataSourceModel struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	Type                    types.String `tfsdk:"type"`
+	Name                    types.String `tfsdk:"name"`
+	ClientId                types.String `tfsdk:"client_id"`
+	AuthorizeUrl            types.String `tfsdk:"authorize_url"`
+	TokenUrl                types.String `tfsdk:"token_url"`
+	RedirectUri             types.String `tfsdk:"redirect_uri"`
+	ApplicationIdUri        types.String `tfsdk:"application_id_uri"`
+	Issuer                  types.String `tfsdk:"issuer"`
+	Audience                types.String `tfsdk:"audience"`
+	Scopes                  types.Set    `tfsdk:"scopes"`
+	SecurityIntegrationName types.String `tfsdk:"security_integration_name"`
+}
+
+// extraFromModel returns the config specific to the type of OAuth configuration, or nil when there is none
+func (m *OAuthConfigurationResourceModel) extraFromModel() *dbt_cloud.OAuthConfigurationExtra {
+	switch m.Type.ValueString() {
+	case dbt_cloud.OAUTH_TYPE_ENTRA:
+		if m.ApplicationIdUri.ValueString() == "" {
+			return nil
+		}
+		return &dbt_cloud.OAuthConfigurationExtra{
+			ApplicationIdUri: m.ApplicationIdUri.ValueStringPointer(),
+		}
+	case dbt_cloud.OAUTH_TYPE_OKTA:
+		if m.Issuer.IsNull() && m.Audience.IsNull() && m.Scopes.IsNull() {
+			return nil
+		}
+		extra := dbt_cloud.OAuthConfigurationExtra{
+			Issuer:   m.Issuer.ValueStringPointer(),
+			Audience: m.Audience.ValueStringPointer(),
+		}
+		if !m.Scopes.IsNull() {
+			extra.Scopes = helper.StringSetToStringSlice(m.Scopes)
+		}
+		return &extra
+	case dbt_cloud.OAUTH_TYPE_SNOWFLAKE:
+		return &dbt_cloud.OAuthConfigurationExtra{
+			SecurityIntegrationName: m.SecurityIntegrationName.ValueStringPointer(),
+		}
+	default:
+		return nil
+	}
+}
+
+// setFromAPI sets all the fields returned by the API, the client secret is never returned
+func (m *OAuthConfigurationResourceModel) setFromAPI(oAuthConfiguration *dbt_cloud.OAuthConfiguration) {
+	m.ID = types.Int64Value(*oAuthConfiguration.ID)
+	m.Type = types.StringValue(oAuthConfiguration.Type)
+	m.Name = types.StringValue(oAuthConfiguration.Name)
+	m.ClientId = types.StringValue(oAuthConfiguration.ClientId)
+	m.AuthorizeUrl = types.StringValue(oAuthConfiguration.AuthorizeUrl)
+	m.TokenUrl = types.StringValue(oAuthConfiguration.TokenUrl)
+	m.RedirectUri = types.StringValue(oAuthConfiguration.RedirectUri)
+
+	extra := oAuthConfiguration.OAuthConfigurationExtra
+	if extra == nil {
+		extra = &dbt_cloud.OAuthConfigurationExtra{}
+	}
+	m.ApplicationIdUri = types.StringValue(lo.FromPtr(extra.ApplicationIdUri))
+	m.Issuer = types.StringPointerValue(extra.Issuer)
+	m.Audience = types.StringPointerValue(extra.Audience)
+	m.Scopes = scopesToSet(extra.Scopes)
+	m.SecurityIntegrationName = types.StringPointerValue(extra.SecurityIntegrationName)
+}
+
+func oAuthConfigurationToDataSourceModel(
+	oAuthConfiguration dbt_cloud.OAuthConfiguration,
+) OAuthConfigurationDataSourceModel {
+	extra := oAuthConfiguration.OAuthConfigurationExtra
+	if extra == nil {
+		extra = &dbt_cloud.OAuthConfigurationExtra{}
+	}
+
+	return OAuthConfigurationDataSourceModel{
+		ID:                      types.Int64PointerValue(oAuthConfiguration.ID),
+		Type:                    types.StringValue(oAuthConfiguration.Type),
+		Name:                    types.StringValue(oAuthConfiguration.Name),
+		ClientId:                types.StringValue(oAuthConfiguration.ClientId),
+		AuthorizeUrl:            types.StringValue(oAuthConfiguration.AuthorizeUrl),
+		TokenUrl:                types.StringValue(oAuthConfiguration.TokenUrl),
+		RedirectUri:             types.StringValue(oAuthConfiguration.RedirectUri),
+		ApplicationIdUri:        types.StringPointerValue(extra.ApplicationIdUri),
+		Issuer:                  types.StringPointerValue(extra.Issuer),
+		Audience:                types.StringPointerValue(extra.Audience),
+		Scopes:                  scopesToSet(extra.Scopes),
+		SecurityIntegrationName: types.StringPointerValue(extra.SecurityIntegrationName),
+	}
+}
+
+func scopesToSet(scopes []string) types.Set {
+	if scopes == nil {
+		return types.SetNull(types.StringType)
+	}
+	scopesSet, _ := types.SetValueFrom(context.Background(), types.StringType, scopes)
+	return scopesSet
 }
@@ -7,8 +7,10 @@ import (
 	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,6 +19,7 @@ var (
 	_ resource.ResourceWithConfigure      = &oAuthConfigurationResource{}
 	_ resource.ResourceWithImportState    = &oAuthConfigurationResource{}
 	_ resource.ResourceWithValidateConfig = &oAuthConfigurationResource{}
+	_ resource.ResourceWithModifyPlan     = &oAuthConfigurationResource{}
 )
 
 func OAuthConfigurationResource() resource.Resource {
@@ -48,21 +51,121 @@ func (r *oAuthConfigurationResource) ValidateConfig(
 		return
 	}
 
-	if data.Type.ValueString() == "okta" && !data.ApplicationIdUri.IsNull() {
+	if data.Type.IsUnknown() {
+		return
+	}
+	oAuthType := data.Type.ValueString()
+
+	if oAuthType != dbt_cloud.OAUTH_TYPE_ENTRA && !data.ApplicationIdUri.IsNull() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("application_id_uri"),
-			"application_id_uri is not supported for Okta",
+			fmt.Sprintf("application_id_uri is not supported for %s", oAuthType),
 			"application_id_uri is only supported for Entra ID (i.e. Azure AD) OAuth integrations",
 		)
 	}
 
-	if data.Type.ValueString() == "entra" && data.ApplicationIdUri.IsNull() {
+	if oAuthType == dbt_cloud.OAUTH_TYPE_ENTRA && data.ApplicationIdUri.IsNull() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("application_id_uri"),
 			"application_id_uri is required for Entra ID",
 			"application_id_uri is required for Entra ID (i.e. Azure AD) OAuth integrations",
 		)
 	}
+
+	if oAuthType != dbt_cloud.OAUTH_TYPE_OKTA {
+		oktaAttributes := []struct {
+			name  string
+			isSet bool
+		}{
+			{"issuer", !data.Issuer.IsNull()},
+			{"audience", !data.Audience.IsNull()},
+			{"scopes", !data.Scopes.IsNull()},
+		}
+		for _, attribute := range oktaAttributes {
+			if attribute.isSet {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute.name),
+					fmt.Sprintf("%s is not supported for %s", attribute.name, oAuthType),
+					fmt.Sprintf("%s is only supported for Okta OAuth integrations", attribute.name),
+				)
+			}
+		}
+	}
+
+	if oAuthType == dbt_cloud.OAUTH_TYPE_SNOWFLAKE && data.SecurityIntegrationName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("security_integration_name"),
+			"security_integration_name is required for Snowflake",
+			"security_integration_name is required for Snowflake native OAuth integrations",
+		)
+	}
+
+	if oAuthType != dbt_cloud.OAUTH_TYPE_SNOWFLAKE && !data.SecurityIntegrationName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("security_integration_name"),
+			fmt.Sprintf("security_integration_name is not supported for %s", oAuthType),
+			"security_integration_name is only supported for Snowflake native OAuth integrations",
+		)
+	}
+}
+
+// isClientSecretSent returns true when the client secret needs to be sent to dbt Cloud when updating the configuration
+// the secret is write-only, so only a new version can be detected
+func isClientSecretSent(plan, state OAuthConfigurationResourceModel) bool {
+	return !plan.ClientSecretVersion.IsNull() && !plan.ClientSecretVersion.Equal(state.ClientSecretVersion)
+}
+
+// clientSecretFromConfig returns the client secret, which is only available in the config as it is write-only
+func clientSecretFromConfig(ctx context.Context, config tfsdk.Config) (types.String, diag.Diagnostics) {
+	var clientSecret types.String
+	diags := config.GetAttribute(ctx, path.Root("client_secret"), &clientSecret)
+	return clientSecret, diags
+}
+
+func (r *oAuthConfigurationResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var plan, state OAuthConfigurationResourceModel
+
+	if req.Plan.Raw.IsNull() {
+		// nothing to do when the resource is destroyed
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	clientSecret, diags := clientSecretFromConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !clientSecret.IsNull() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"client_secret is required",
+			"client_secret is required when creating the OAuth configuration",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ClientSecretVersion.IsUnknown() && isClientSecretSent(plan, state) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"client_secret is required",
+			"client_secret is required when changing client_secret_version, as the new secret is sent to dbt Cloud",
+		)
+	}
 }
 
 func (r *oAuthConfigurationResource) Read(
@@ -90,24 +193,10 @@ func (r *oAuthConfigurationResource) Read(
 		return
 	}
 
-	state.ID = types.Int64Value(int64(*retrievedOAuthConfiguration.ID))
-	state.Type = types.StringValue(retrievedOAuthConfiguration.Type)
-	state.Name = types.StringValue(retrievedOAuthConfiguration.Name)
-	state.ClientId = types.StringValue(retrievedOAuthConfiguration.ClientId)
-	state.AuthorizeUrl = types.StringValue(retrievedOAuthConfiguration.AuthorizeUrl)
-	state.TokenUrl = types.StringValue(retrievedOAuthConfiguration.TokenUrl)
-	state.RedirectUri = types.StringValue(retrievedOAuthConfiguration.RedirectUri)
-
-	if retrievedOAuthConfiguration.OAuthConfigurationExtra != nil {
-		state.ApplicationIdUri = types.StringValue(
-			*retrievedOAuthConfiguration.OAuthConfigurationExtra.ApplicationIdUri,
-		)
-	} else {
-		state.ApplicationIdUri = types.StringValue("")
-	}
+	state.setFromAPI(retrievedOAuthConfiguration)
 
 	// secrets are not set when reading.
-	// Here the only secret is `client_secret`
+	// Here the only secret is `client_secret`, which is write-only, and its version is kept as it is in the state
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
@@ -121,6 +210,8 @@ func (r *oAuthConfigurationResource) Create(
 	var plan OAuthConfigurationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	configClientSecret, diags := clientSecretFromConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -128,14 +219,11 @@ func (r *oAuthConfigurationResource) Create(
 	oAuthType := plan.Type.ValueString()
 	name := plan.Name.ValueString()
 	clientID := plan.ClientId.ValueString()
-	clientSecret := plan.ClientSecret.ValueString()
+	clientSecret := configClientSecret.ValueString()
 	authorizeURL := plan.AuthorizeUrl.ValueString()
 	tokenURL := plan.TokenUrl.ValueString()
 	redirectURI := plan.RedirectUri.ValueString()
 
-	// will be set to "" if not configured
-	applicationURI := plan.ApplicationIdUri.ValueString()
-
 	createdOAuthConfiguration, err := r.client.CreateOAuthConfiguration(
 		oAuthType,
 		name,
@@ -144,7 +232,7 @@ func (r *oAuthConfigurationResource) Create(
 		authorizeURL,
 		tokenURL,
 		redirectURI,
-		applicationURI,
+		plan.extraFromModel(),
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -196,6 +284,8 @@ func (r *oAuthConfigurationResource) Update(
 		return
 	}
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	clientSecret, diags := clientSecretFromConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -219,8 +309,10 @@ func (r *oAuthConfigurationResource) Update(
 	if plan.ClientId != state.ClientId {
 		retrievedOAuthConfiguration.ClientId = plan.ClientId.ValueString()
 	}
-	if plan.ClientSecret != state.ClientSecret {
-		retrievedOAuthConfiguration.ClientSecret = plan.ClientSecret.ValueString()
+	// the secret is never returned by the API and is kept as is when it is not sent
+	retrievedOAuthConfiguration.ClientSecret = ""
+	if isClientSecretSent(plan, state) {
+		retrievedOAuthConfiguration.ClientSecret = clientSecret.ValueString()
 	}
 	if plan.AuthorizeUrl != state.AuthorizeUrl {
 		retrievedOAuthConfiguration.AuthorizeUrl = plan.AuthorizeUrl.ValueString()
@@ -231,16 +323,7 @@ func (r *oAuthConfigurationResource) Update(
 	if plan.RedirectUri != state.RedirectUri {
 		retrievedOAuthConfiguration.RedirectUri = plan.RedirectUri.ValueString()
 	}
-	if plan.ApplicationIdUri != state.ApplicationIdUri {
-		if plan.ApplicationIdUri.IsNull() {
-			retrievedOAuthConfiguration.OAuthConfigurationExtra = nil
-		} else {
-			applicationIdUri := plan.ApplicationIdUri.ValueString()
-			retrievedOAuthConfiguration.OAuthConfigurationExtra = &dbt_cloud.OAuthConfigurationExtra{
-				ApplicationIdUri: &applicationIdUri,
-			}
-		}
-	}
+	retrievedOAuthConfiguration.OAuthConfigurationExtra = plan.extraFromModel()
 
 	_, err = r.client.UpdateOAuthConfiguration(
 		oAuthConfigurationID,
@@ -9,20 +9,22 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccDbtCloudOAuthConfigurationOktaResource(t *testing.T) {
 
 	oAuthType := "okta"
-	oAuthConfigurationName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	oAuthConfigurationName := acctest_helper.RandomName()
 	oauthClientId := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	oauthClientSecret := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	authorizeUrl := "https://" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha) + ".com"
 	tokenUrl := "https://" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha) + ".com"
 	redirectUri := "https://" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha) + ".com"
 
-	oAuthConfigurationName2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	oAuthConfigurationName2 := acctest_helper.RandomName()
 	oauthClientId2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	oauthClientSecret2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	authorizeUrl2 := "https://" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha) + ".com"
@@ -33,6 +35,10 @@ func TestAccDbtCloudOAuthConfigurationOktaResource(t *testing.T) {
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudOAuthConfigurationDestroy,
+		// client_secret is write-only
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDbtCloudOAuthConfigurationResourceBasicConfig(
@@ -47,10 +53,9 @@ func TestAccDbtCloudOAuthConfigurationOktaResource(t *testing.T) {
 				),
 				// we just need to check the ones that have special logics or are computed
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(
+					resource.TestCheckNoResourceAttr(
 						"dbtcloud_oauth_configuration.test_oauth_configuration",
 						"client_secret",
-						oauthClientSecret,
 					),
 				),
 			},
@@ -68,10 +73,9 @@ func TestAccDbtCloudOAuthConfigurationOktaResource(t *testing.T) {
 				),
 				// we just need to check the ones that have special logics or are computed
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(
+					resource.TestCheckNoResourceAttr(
 						"dbtcloud_oauth_configuration.test_oauth_configuration",
 						"client_secret",
-						oauthClientSecret2,
 					),
 				),
 			},
@@ -89,7 +93,7 @@ func TestAccDbtCloudOAuthConfigurationOktaResource(t *testing.T) {
 func TestAccDbtCloudOAuthConfigurationEntraResource(t *testing.T) {
 
 	oAuthType := "entra"
-	oAuthConfigurationName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	oAuthConfigurationName := acctest_helper.RandomName()
 	oauthClientId := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	oauthClientSecret := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	authorizeUrl := "https://" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha) + ".com"
@@ -100,7 +104,7 @@ func TestAccDbtCloudOAuthConfigurationEntraResource(t *testing.T) {
 		acctest.CharSetAlpha,
 	) + ".com"
 
-	oAuthConfigurationName2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	oAuthConfigurationName2 := acctest_helper.RandomName()
 	oauthClientId2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	oauthClientSecret2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	authorizeUrl2 := "https://" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha) + ".com"
@@ -115,6 +119,10 @@ func TestAccDbtCloudOAuthConfigurationEntraResource(t *testing.T) {
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudOAuthConfigurationDestroy,
+		// client_secret is write-only
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDbtCloudOAuthConfigurationResourceBasicConfig(
@@ -129,10 +137,9 @@ func TestAccDbtCloudOAuthConfigurationEntraResource(t *testing.T) {
 				),
 				// we just need to check the ones that have special logics or are computed
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(
+					resource.TestCheckNoResourceAttr(
 						"dbtcloud_oauth_configuration.test_oauth_configuration",
 						"client_secret",
-						oauthClientSecret,
 					),
 				),
 			},
@@ -150,10 +157,9 @@ func TestAccDbtCloudOAuthConfigurationEntraResource(t *testing.T) {
 				),
 				// we just need to check the ones that have special logics or are computed
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(
+					resource.TestCheckNoResourceAttr(
 						"dbtcloud_oauth_configuration.test_oauth_configuration",
 						"client_secret",
-						oauthClientSecret2,
 					),
 				),
 			},
@@ -207,6 +213,193 @@ resource "dbtcloud_oauth_configuration" "test_oauth_configuration" {
 		application_id_uri_config)
 }
 
+func TestAccDbtCloudOAuthConfigurationSecretVersion(t *testing.T) {
+
+	oAuthConfigurationName := acctest_helper.RandomName()
+	oauthClientSecret := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	oauthClientSecret2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudOAuthConfigurationDestroy,
+		// client_secret is write-only
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudOAuthConfigurationResourceSecretVersionConfig(
+					oAuthConfigurationName,
+					fmt.Sprintf(`"%s"`, oauthClientSecret),
+					1,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_oauth_configuration.test_oauth_configuration",
+						"client_secret_version",
+						"1",
+					),
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_oauth_configuration.test_oauth_configuration",
+						"client_secret",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_oauth_configuration.test_oauth_configuration",
+						"issuer",
+						"https://example.okta.com/oauth2/default",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_oauth_configuration.test_oauth_configuration",
+						"scopes.#",
+						"2",
+					),
+				),
+			},
+			// the secret can be removed from the config once it has been sent, without any change
+			{
+				Config: testAccDbtCloudOAuthConfigurationResourceSecretVersionConfig(
+					oAuthConfigurationName,
+					"null",
+					1,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_oauth_configuration.test_oauth_configuration",
+						"client_secret",
+					),
+				),
+			},
+			// changing the version requires a secret
+			{
+				Config: testAccDbtCloudOAuthConfigurationResourceSecretVersionConfig(
+					oAuthConfigurationName,
+					"null",
+					2,
+				),
+				ExpectError: regexp.MustCompile("client_secret is required"),
+			},
+			// ROTATE
+			{
+				Config: testAccDbtCloudOAuthConfigurationResourceSecretVersionConfig(
+					oAuthConfigurationName,
+					fmt.Sprintf(`"%s"`, oauthClientSecret2),
+					2,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_oauth_configuration.test_oauth_configuration",
+						"client_secret_version",
+						"2",
+					),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "dbtcloud_oauth_configuration.test_oauth_configuration",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"client_secret",
+					"client_secret_version",
+				},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudOAuthConfigurationResourceSecretVersionConfig(
+	oAuthConfigurationName string,
+	clientSecret string,
+	clientSecretVersion int,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_oauth_configuration" "test_oauth_configuration" {
+  type                  = "okta"
+  name                  = "%s"
+  client_id             = "client_id"
+  client_secret         = %s
+  client_secret_version = %d
+  authorize_url         = "https://example.okta.com/oauth2/default/v1/authorize"
+  token_url             = "https://example.okta.com/oauth2/default/v1/token"
+  redirect_uri          = "https://example.com/complete/okta"
+  issuer                = "https://example.okta.com/oauth2/default"
+  audience              = "https://example.snowflakecomputing.com"
+  scopes                = ["session:role-any", "offline_access"]
+}
+`, oAuthConfigurationName, clientSecret, clientSecretVersion)
+}
+
+func TestAccDbtCloudOAuthConfigurationSnowflakeResource(t *testing.T) {
+
+	oAuthConfigurationName := acctest_helper.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudOAuthConfigurationDestroy,
+		// client_secret is write-only
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "dbtcloud_oauth_configuration" "test_oauth_configuration" {
+  type                      = "snowflake"
+  name                      = "%s"
+  client_id                 = "client_id"
+  client_secret             = "client_secret"
+  authorize_url             = "https://example.snowflakecomputing.com/oauth/authorize"
+  token_url                 = "https://example.snowflakecomputing.com/oauth/token-request"
+  redirect_uri              = "https://example.com/complete/snowflake"
+  security_integration_name = "DBT_CLOUD"
+}
+
+data "dbtcloud_oauth_configurations" "by_name" {
+  name = dbtcloud_oauth_configuration.test_oauth_configuration.name
+}
+`, oAuthConfigurationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_oauth_configuration.test_oauth_configuration",
+						"security_integration_name",
+						"DBT_CLOUD",
+					),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_oauth_configurations.by_name",
+						"oauth_configurations.#",
+						"1",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.dbtcloud_oauth_configurations.by_name",
+						"oauth_configurations.0.id",
+						"dbtcloud_oauth_configuration.test_oauth_configuration",
+						"id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_oauth_configurations.by_name",
+						"oauth_configurations.0.security_integration_name",
+						"DBT_CLOUD",
+					),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbtcloud_oauth_configuration.test_oauth_configuration",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
 func testAccCheckDbtCloudOAuthConfigurationDestroy(s *terraform.State) error {
 	apiClient, err := acctest_helper.SharedClient()
 	if err != nil {
@@ -0,0 +1,55 @@
+package oauth_configuration
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsClientSecretSent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		plan     OAuthConfigurationResourceModel
+		state    OAuthConfigurationResourceModel
+		expected bool
+	}{
+		{
+			name:     "no version",
+			plan:     OAuthConfigurationResourceModel{ClientSecretVersion: types.Int64Null()},
+			state:    OAuthConfigurationResourceModel{ClientSecretVersion: types.Int64Null()},
+			expected: false,
+		},
+		{
+			name:     "same version",
+			plan:     OAuthConfigurationResourceModel{ClientSecretVersion: types.Int64Value(1)},
+			state:    OAuthConfigurationResourceModel{ClientSecretVersion: types.Int64Value(1)},
+			expected: false,
+		},
+		{
+			name:     "new version",
+			plan:     OAuthConfigurationResourceModel{ClientSecretVersion: types.Int64Value(2)},
+			state:    OAuthConfigurationResourceModel{ClientSecretVersion: types.Int64Value(1)},
+			expected: true,
+		},
+		{
+			name:     "version added to the config",
+			plan:     OAuthConfigurationResourceModel{ClientSecretVersion: types.Int64Value(1)},
+			state:    OAuthConfigurationResourceModel{ClientSecretVersion: types.Int64Null()},
+			expected: true,
+		},
+		{
+			name:     "version removed from the config",
+			plan:     OAuthConfigurationResourceModel{ClientSecretVersion: types.Int64Null()},
+			state:    OAuthConfigurationResourceModel{ClientSecretVersion: types.Int64Value(1)},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if isClientSecretSent(testCase.plan, testCase.state) != testCase.expected {
+			t.Errorf("%s: expected %t", testCase.name, testCase.expected)
+		}
+	}
+}
@@ -3,8 +3,11 @@ package oauth_configuration
 import (
 	"context"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -12,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func (r *oAuthConfigurationResource) Schema(
@@ -21,9 +25,11 @@ func (r *oAuthConfigurationResource) Schema(
 ) {
 	resp.Schema = resource_schema.Schema{
 		Description: helper.DocString(
-			`Configure an external OAuth integration for the data warehouse. Currently supports Okta and Entra ID (i.e. Azure AD) for Snowflake.
+			`Configure an external OAuth integration for the data warehouse. Currently supports Okta, Entra ID (i.e. Azure AD) and Snowflake native OAuth security integrations for Snowflake.
 			
-			See the [documentation](https://docs.getdbt.com/docs/cloud/manage-access/external-oauth) for more information on how to configure it.`,
+			See the [documentation](https://docs.getdbt.com/docs/cloud/manage-access/external-oauth) for more information on how to configure it.
+
+			The client secret is never returned by dbt Cloud and ~~~client_secret~~~ is write-only, it is not stored in the plan or the state and requires Terraform 1.11 or later. The secret is only sent when the configuration is created and when ~~~client_secret_version~~~ changes, so it can be removed from the config once it has been applied, and set again with a new version to rotate it.`,
 		),
 		Attributes: map[string]resource_schema.Attribute{
 			"id": resource_schema.Int64Attribute{
@@ -36,12 +42,16 @@ func (r *oAuthConfigurationResource) Schema(
 			},
 			"type": resource_schema.StringAttribute{
 				Required:    true,
-				Description: "The type of OAuth integration (`entra` or `okta`)",
+				Description: "The type of OAuth integration (`entra`, `okta` or `snowflake` for Snowflake native OAuth security integrations)",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.OneOf("okta", "entra"),
+					stringvalidator.OneOf(
+						dbt_cloud.OAUTH_TYPE_OKTA,
+						dbt_cloud.OAUTH_TYPE_ENTRA,
+						dbt_cloud.OAUTH_TYPE_SNOWFLAKE,
+					),
 				},
 			},
 			"name": resource_schema.StringAttribute{
@@ -53,10 +63,15 @@ func (r *oAuthConfigurationResource) Schema(
 				Description: "The Client ID for the OAuth integration",
 			},
 			"client_secret": resource_schema.StringAttribute{
-				Required:    true,
-				Description: "The Client secret for the OAuth integration",
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The Client secret for the OAuth integration. It is write-only and only sent to dbt Cloud when creating the configuration and when changing `client_secret_version`, where it is required",
 				Sensitive:   true,
 			},
+			"client_secret_version": resource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "A version number for `client_secret`. As the secret is write-only, changes to it are not detected and the new secret is only sent to dbt Cloud when the version changes. Increase the version to rotate the secret",
+			},
 			"authorize_url": resource_schema.StringAttribute{
 				Required:    true,
 				Description: "The Authorize URL for the OAuth integration",
@@ -75,6 +90,107 @@ func (r *oAuthConfigurationResource) Schema(
 				Description: "The Application ID URI for the OAuth integration. Only for Entra",
 				Default:     stringdefault.StaticString(""),
 			},
+			"issuer": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "The issuer of the tokens, i.e. the URL of the Okta authorization server. Only for Okta",
+			},
+			"audience": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "The audience of the tokens configured in the Okta authorization server. Only for Okta",
+			},
+			"scopes": resource_schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The scopes requested for the tokens, e.g. `session:role-any`. Only for Okta",
+			},
+			"security_integration_name": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the Snowflake security integration of type `OAUTH`. Required for `snowflake`",
+			},
+		},
+	}
+}
+
+func (d *oAuthConfigurationsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasource_schema.Schema{
+		Description: "Retrieve the OAuth configurations of the account, for example to find the ID of a configuration from its name when configuring a connection. The client secrets are not returned",
+		Attributes: map[string]datasource_schema.Attribute{
+			"name": datasource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the OAuth configurations with this name",
+			},
+			"type": datasource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the OAuth configurations of this type (`entra`, `okta` or `snowflake`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						dbt_cloud.OAUTH_TYPE_OKTA,
+						dbt_cloud.OAUTH_TYPE_ENTRA,
+						dbt_cloud.OAUTH_TYPE_SNOWFLAKE,
+					),
+				},
+			},
+			"oauth_configurations": datasource_schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The OAuth configurations matching the filters",
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the OAuth configuration",
+						},
+						"type": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of OAuth integration",
+						},
+						"name": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of OAuth integration",
+						},
+						"client_id": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The Client ID for the OAuth integration",
+						},
+						"authorize_url": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The Authorize URL for the OAuth integration",
+						},
+						"token_url": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The Token URL for the OAuth integration",
+						},
+						"redirect_uri": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The redirect URL for the OAuth integration",
+						},
+						"application_id_uri": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The Application ID URI for the OAuth integration. Only for Entra",
+						},
+						"issuer": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The issuer of the tokens. Only for Okta",
+						},
+						"audience": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The audience of the tokens. Only for Okta",
+						},
+						"scopes": datasource_schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The scopes requested for the tokens. Only for Okta",
+						},
+						"security_integration_name": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Snowflake security integration. Only for Snowflake native OAuth",
+						},
+					},
+				},
+			},
 		},
 	}
 }
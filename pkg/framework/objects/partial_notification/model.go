@@ -43,6 +43,19 @@ func matchPartial(
 			return false
 		}
 
+	case 5:
+		// MS Teams notification
+		if !(notificationModel.TeamsChannelID == types.StringPointerValue(
+			notificationResponse.TeamsChannelID,
+		)) {
+			return false
+		}
+		if !(notificationModel.TeamsChannelName == types.StringPointerValue(
+			notificationResponse.TeamsChannelName,
+		)) {
+			return false
+		}
+
 		// TODO(cwalden): What happens with the other cases(i.e. the deprecated `3` type)?
 	}
 	return true
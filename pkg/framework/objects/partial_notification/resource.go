@@ -14,8 +14,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &partialNotificationResource{}
-	_ resource.ResourceWithConfigure = &partialNotificationResource{}
+	_ resource.Resource                   = &partialNotificationResource{}
+	_ resource.ResourceWithConfigure      = &partialNotificationResource{}
+	_ resource.ResourceWithValidateConfig = &partialNotificationResource{}
+	_ resource.ResourceWithModifyPlan     = &partialNotificationResource{}
 )
 
 func PartialNotificationResource() resource.Resource {
@@ -48,11 +50,12 @@ func (r *partialNotificationResource) ValidateConfig(
 	}
 
 	if data.NotificationType == types.Int64Value(1) &&
-		!(data.ExternalEmail.IsNull() && data.SlackChannelID.IsNull() && data.SlackChannelName.IsNull()) {
+		!(data.ExternalEmail.IsNull() && data.SlackChannelID.IsNull() && data.SlackChannelName.IsNull() &&
+			data.TeamsChannelID.IsNull() && data.TeamsChannelName.IsNull()) {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("notification_type"),
 			"Notification type is not compatible with the other attributes",
-			"Notification type 1 is for internal notifications only. Please remove the external email, slack channel ID, and slack channel name attributes.",
+			"Notification type 1 is for internal notifications only. Please remove the external email, Slack channel and Microsoft Teams channel attributes.",
 		)
 	}
 
@@ -73,6 +76,26 @@ func (r *partialNotificationResource) ValidateConfig(
 			"Notification type 4 requires an external email.",
 		)
 	}
+
+	if data.NotificationType == types.Int64Value(5) &&
+		(data.TeamsChannelID.IsNull() || data.TeamsChannelName.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("notification_type"),
+			"Notification type is not compatible with the other attributes",
+			"Notification type 5 requires a Microsoft Teams channel ID and Microsoft Teams channel name.",
+		)
+	}
+
+	resp.Diagnostics.Append(notification.ValidateJobSelectorConfig(ctx, data)...)
+}
+
+func (r *partialNotificationResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// the job IDs of the job selector are refreshed at every plan
+	notification.ModifyPlanForJobSelector(ctx, r.client, req, resp)
 }
 
 func (r *partialNotificationResource) Read(
@@ -144,6 +167,8 @@ func (r *partialNotificationResource) Read(
 	state.ExternalEmail = types.StringPointerValue(notification.ExternalEmail)
 	state.SlackChannelID = types.StringPointerValue(notification.SlackChannelID)
 	state.SlackChannelName = types.StringPointerValue(notification.SlackChannelName)
+	state.TeamsChannelID = types.StringPointerValue(notification.TeamsChannelID)
+	state.TeamsChannelName = types.StringPointerValue(notification.TeamsChannelName)
 
 	// we set the "partial" values by intersecting the config with the remote
 	intOnCancel, intOnFailure, intOnWarning, intOnSuccess, ok := extractModelJobLists(state)
@@ -192,6 +217,18 @@ func (r *partialNotificationResource) Create(
 		return
 	}
 
+	resp.Diagnostics.Append(notification.ResolveJobSelector(ctx, r.client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.NotificationType == types.Int64Value(2) {
+		resp.Diagnostics.Append(notification.CheckSlackIntegration(r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// we read the values from the config
 	intOnCancel, intOnFailure, intOnWarning, intOnSuccess, ok := extractModelJobLists(plan)
 	if !ok {
@@ -275,6 +312,8 @@ func (r *partialNotificationResource) Create(
 					ExternalEmail:    plan.ExternalEmail.ValueStringPointer(),
 					SlackChannelID:   plan.SlackChannelID.ValueStringPointer(),
 					SlackChannelName: plan.SlackChannelName.ValueStringPointer(),
+					TeamsChannelID:   plan.TeamsChannelID.ValueStringPointer(),
+					TeamsChannelName: plan.TeamsChannelName.ValueStringPointer(),
 				},
 			)
 			if err != nil {
@@ -300,6 +339,8 @@ func (r *partialNotificationResource) Create(
 			plan.ExternalEmail.ValueStringPointer(),
 			plan.SlackChannelID.ValueStringPointer(),
 			plan.SlackChannelName.ValueStringPointer(),
+			plan.TeamsChannelID.ValueStringPointer(),
+			plan.TeamsChannelName.ValueStringPointer(),
 		)
 
 		if err != nil {
@@ -375,6 +416,8 @@ func (r *partialNotificationResource) Delete(
 				ExternalEmail:    state.ExternalEmail.ValueStringPointer(),
 				SlackChannelID:   state.SlackChannelID.ValueStringPointer(),
 				SlackChannelName: state.SlackChannelName.ValueStringPointer(),
+				TeamsChannelID:   state.TeamsChannelID.ValueStringPointer(),
+				TeamsChannelName: state.TeamsChannelName.ValueStringPointer(),
 			},
 		)
 		if err != nil {
@@ -408,6 +451,11 @@ func (r *partialNotificationResource) Update(
 		return
 	}
 
+	resp.Diagnostics.Append(notification.ResolveJobSelector(ctx, r.client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	notificationID := state.ID.ValueString()
 	notification, err := r.client.GetNotification(notificationID)
 	if err != nil {
@@ -477,6 +525,8 @@ func (r *partialNotificationResource) Update(
 				ExternalEmail:    plan.ExternalEmail.ValueStringPointer(),
 				SlackChannelID:   plan.SlackChannelID.ValueStringPointer(),
 				SlackChannelName: plan.SlackChannelName.ValueStringPointer(),
+				TeamsChannelID:   plan.TeamsChannelID.ValueStringPointer(),
+				TeamsChannelName: plan.TeamsChannelName.ValueStringPointer(),
 			},
 		)
 		if err != nil {
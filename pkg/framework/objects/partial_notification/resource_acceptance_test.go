@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -27,9 +26,9 @@ func TestAccDbtCloudPartialNotificationResource(t *testing.T) {
 	}
 
 	currentTime := time.Now().Unix()
-	notificationEmail := fmt.Sprintf("%d-partial-resource@nomail.com", currentTime)
+	notificationEmail := fmt.Sprintf("%s%d-partial-resource@nomail.com", acctest_helper.TestNamePrefix, currentTime)
 
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -3,6 +3,7 @@ package partial_notification
 import (
 	"context"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/notification"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,7 +24,7 @@ func (r *partialNotificationResource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		Description: helper.DocString(
-			`Setup partial notifications on jobs success/failure to internal users, external email addresses or Slack channels. This is different from ~~~dbt_cloud_notification~~~ as it allows to have multiple resources updating the same notification recipient (email, user or Slack channel) and is useful for companies managing a single dbt Cloud Account configuration from different Terraform projects/workspaces.
+			`Setup partial notifications on jobs success/failure to internal users, external email addresses, Slack channels or Microsoft Teams channels. This is different from ~~~dbt_cloud_notification~~~ as it allows to have multiple resources updating the same notification recipient (email, user, Slack channel or Microsoft Teams channel) and is useful for companies managing a single dbt Cloud Account configuration from different Terraform projects/workspaces.
 
 			If a company uses only one Terraform project/workspace to manage all their dbt Cloud Account config, it is recommended to use ~~~dbt_cloud_notification~~~ instead of ~~~dbt_cloud_partial_notification~~~.
 
@@ -94,7 +95,7 @@ func (r *partialNotificationResource) Schema(
 				Optional:    true,
 				Computed:    true,
 				Default:     int64default.StaticInt64(1),
-				Description: "Type of notification (1 = dbt Cloud user email (default): does not require an external_email ; 2 = Slack channel: requires `slack_channel_id` and `slack_channel_name` ; 4 = external email: requires setting an `external_email`) [global, used as identifier]",
+				Description: "Type of notification (1 = dbt Cloud user email (default): does not require an external_email ; 2 = Slack channel: requires `slack_channel_id` and `slack_channel_name` ; 4 = external email: requires setting an `external_email` ; 5 = Microsoft Teams channel: requires `ms_teams_channel_id` and `ms_teams_channel_name`) [global, used as identifier]",
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
 				},
@@ -106,6 +107,8 @@ func (r *partialNotificationResource) Schema(
 					stringvalidator.ConflictsWith(
 						path.MatchRoot("slack_channel_id"),
 						path.MatchRoot("slack_channel_name"),
+						path.MatchRoot("ms_teams_channel_id"),
+						path.MatchRoot("ms_teams_channel_name"),
 					),
 				},
 				PlanModifiers: []planmodifier.String{
@@ -114,9 +117,13 @@ func (r *partialNotificationResource) Schema(
 			},
 			"slack_channel_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "The ID of the Slack channel to receive the notification. It can be found at the bottom of the Slack channel settings [global, used as identifier]",
+				Description: "The ID of the Slack channel to receive the notification. It can be found at the bottom of the Slack channel settings or with the `dbtcloud_slack_channels` data source [global, used as identifier]",
 				Validators: []validator.String{
-					stringvalidator.ConflictsWith(path.MatchRoot("external_email")),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("external_email"),
+						path.MatchRoot("ms_teams_channel_id"),
+						path.MatchRoot("ms_teams_channel_name"),
+					),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -126,12 +133,45 @@ func (r *partialNotificationResource) Schema(
 				Optional:    true,
 				Description: "The name of the slack channel [global, used as identifier]",
 				Validators: []validator.String{
-					stringvalidator.ConflictsWith(path.MatchRoot("external_email")),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("external_email"),
+						path.MatchRoot("ms_teams_channel_id"),
+						path.MatchRoot("ms_teams_channel_name"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ms_teams_channel_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the Microsoft Teams channel to receive the notification [global, used as identifier]",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("external_email"),
+						path.MatchRoot("slack_channel_id"),
+						path.MatchRoot("slack_channel_name"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ms_teams_channel_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the Microsoft Teams channel [global, used as identifier]",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("external_email"),
+						path.MatchRoot("slack_channel_id"),
+						path.MatchRoot("slack_channel_name"),
+					),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"job_selector": notification.JobSelectorSchema(),
 		},
 	}
 }
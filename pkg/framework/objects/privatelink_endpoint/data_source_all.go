@@ -0,0 +1,96 @@
+package privatelink_endpoint
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &privatelinkEndpointsDataSource{}
+	_ datasource.DataSourceWithConfigure = &privatelinkEndpointsDataSource{}
+)
+
+func PrivatelinkEndpointsDataSource() datasource.DataSource {
+	return &privatelinkEndpointsDataSource{}
+}
+
+type privatelinkEndpointsDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *privatelinkEndpointsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_privatelink_endpoints"
+}
+
+func (d *privatelinkEndpointsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state PrivatelinkEndpointsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cidrRange *netip.Prefix
+	if state.CIDRRange.ValueString() != "" {
+		prefix, err := parsePrefix(state.CIDRRange.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cidr_range"),
+				"Invalid CIDR range",
+				"The CIDR range needs to be a CIDR range or an IP address: "+err.Error(),
+			)
+			return
+		}
+		cidrRange = &prefix
+	}
+
+	endpoints, err := d.client.GetPrivatelinkEndpoints()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue when retrieving PrivateLink endpoints",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Endpoints = []PrivatelinkEndpointDataSourceModel{}
+	for _, endpoint := range filterEndpoints(endpoints, state.Type.ValueString(), cidrRange) {
+		state.Endpoints = append(state.Endpoints, PrivatelinkEndpointDataSourceModel{
+			ID:                     types.StringValue(endpoint.ID),
+			Name:                   types.StringValue(endpoint.Name),
+			Type:                   types.StringValue(endpoint.Type),
+			PrivatelinkEndpointURL: types.StringValue(endpoint.PrivatelinkEndpointURL),
+			CIDRRange:              types.StringValue(endpoint.CIDRRange),
+			State:                  types.Int64Value(int64(endpoint.State)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *privatelinkEndpointsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	switch c := req.ProviderData.(type) {
+	case nil: // do nothing
+	case *dbt_cloud.Client:
+		d.client = c
+	default:
+		resp.Diagnostics.AddError("Missing client", "A client is required to configure the PrivateLink endpoints data source")
+	}
+}
@@ -0,0 +1,62 @@
+package privatelink_endpoint_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudPrivatelinkEndpointsDataSource(t *testing.T) {
+
+	// we only test this explicitly as we can't create resources and need to read from existing ones
+	if os.Getenv("DBT_ACCEPTANCE_TEST_PRIVATE_LINK") == "" {
+		t.Skip("Skipping acceptance tests as DBT_ACCEPTANCE_TEST_PRIVATE_LINK is not set")
+	}
+
+	endpointName := os.Getenv("DBT_ACCEPTANCE_TEST_PRIVATE_LINK_NAME")
+
+	config := fmt.Sprintf(`
+data "dbtcloud_privatelink_endpoint" "test" {
+  name = "%s"
+}
+
+data "dbtcloud_privatelink_endpoints" "all" {
+}
+
+data "dbtcloud_privatelink_endpoints" "same_type" {
+  type = data.dbtcloud_privatelink_endpoint.test.type
+}
+`, endpointName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.dbtcloud_privatelink_endpoints.all",
+						"endpoints.#",
+					),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.dbtcloud_privatelink_endpoints.same_type",
+						"endpoints.*",
+						map[string]string{
+							"name": endpointName,
+						},
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.dbtcloud_privatelink_endpoints.same_type",
+						"endpoints.*.id",
+						"data.dbtcloud_privatelink_endpoint.test",
+						"id",
+					),
+				),
+			},
+		},
+	})
+}
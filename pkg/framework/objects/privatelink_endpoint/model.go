@@ -0,0 +1,70 @@
+package privatelink_endpoint
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PrivatelinkEndpointsDataSourceModel struct {
+	Type      types.String                         `tfsdk:"type"`
+	CIDRRange types.String                         `tfsdk:"cidr_range"`
+	Endpoints []PrivatelinkEndpointDataSourceModel `tfsdk:"endpoints"`
+}
+
+type PrivatelinkEndpointDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Type                   types.String `tfsdk:"type"`
+	PrivatelinkEndpointURL types.String `tfsdk:"private_link_endpoint_url"`
+	CIDRRange              types.String `tfsdk:"cidr_range"`
+	State                  types.Int64  `tfsdk:"state"`
+}
+
+// parsePrefix accepts both CIDR ranges and single IP addresses
+func parsePrefix(value string) (netip.Prefix, error) {
+	value = strings.TrimSpace(value)
+	if !strings.Contains(value, "/") {
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	return netip.ParsePrefix(value)
+}
+
+// overlapsCIDRRange returns true when one of the comma separated CIDR ranges of the endpoint overlaps the prefix
+func overlapsCIDRRange(endpointCIDRRange string, prefix netip.Prefix) bool {
+	for _, cidrRange := range strings.Split(endpointCIDRRange, ",") {
+		endpointPrefix, err := parsePrefix(cidrRange)
+		if err != nil {
+			continue
+		}
+		if endpointPrefix.Overlaps(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterEndpoints returns the endpoints of the given type and overlapping the CIDR range, empty filters match all the endpoints
+func filterEndpoints(
+	endpoints []dbt_cloud.PrivatelinkEndpoint,
+	endpointType string,
+	cidrRange *netip.Prefix,
+) []dbt_cloud.PrivatelinkEndpoint {
+	filteredEndpoints := []dbt_cloud.PrivatelinkEndpoint{}
+	for _, endpoint := range endpoints {
+		if endpointType != "" && !strings.EqualFold(endpoint.Type, endpointType) {
+			continue
+		}
+		if cidrRange != nil && !overlapsCIDRRange(endpoint.CIDRRange, *cidrRange) {
+			continue
+		}
+		filteredEndpoints = append(filteredEndpoints, endpoint)
+	}
+	return filteredEndpoints
+}
@@ -0,0 +1,56 @@
+package privatelink_endpoint
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+)
+
+func TestFilterEndpoints(t *testing.T) {
+	t.Parallel()
+
+	endpoints := []dbt_cloud.PrivatelinkEndpoint{
+		{ID: "1", Type: "snowflake", CIDRRange: "10.0.0.0/24"},
+		{ID: "2", Type: "databricks", CIDRRange: "10.1.0.0/16, 192.168.0.0/24"},
+		{ID: "3", Type: "Redshift", CIDRRange: ""},
+	}
+
+	type testCase struct {
+		name         string
+		endpointType string
+		cidrRange    string
+		expectIDs    []string
+	}
+
+	testCases := []testCase{
+		{name: "no filter", expectIDs: []string{"1", "2", "3"}},
+		{name: "type", endpointType: "databricks", expectIDs: []string{"2"}},
+		{name: "type with a different case", endpointType: "redshift", expectIDs: []string{"3"}},
+		{name: "unknown type", endpointType: "postgres", expectIDs: []string{}},
+		{name: "overlapping CIDR range", cidrRange: "10.0.0.0/8", expectIDs: []string{"1", "2"}},
+		{name: "IP address", cidrRange: "192.168.0.12", expectIDs: []string{"2"}},
+		{name: "type and CIDR range", endpointType: "snowflake", cidrRange: "10.1.2.0/24", expectIDs: []string{}},
+	}
+
+	for _, testCase := range testCases {
+		var filteredEndpoints []dbt_cloud.PrivatelinkEndpoint
+		if testCase.cidrRange == "" {
+			filteredEndpoints = filterEndpoints(endpoints, testCase.endpointType, nil)
+		} else {
+			prefix, err := parsePrefix(testCase.cidrRange)
+			if err != nil {
+				t.Fatalf("%s: parsing the CIDR range: %s", testCase.name, err)
+			}
+			filteredEndpoints = filterEndpoints(endpoints, testCase.endpointType, &prefix)
+		}
+
+		if len(filteredEndpoints) != len(testCase.expectIDs) {
+			t.Fatalf("%s: expected %v, got %v", testCase.name, testCase.expectIDs, filteredEndpoints)
+		}
+		for i, endpoint := range filteredEndpoints {
+			if endpoint.ID != testCase.expectIDs[i] {
+				t.Errorf("%s: expected %v, got %v", testCase.name, testCase.expectIDs, filteredEndpoints)
+			}
+		}
+	}
+}
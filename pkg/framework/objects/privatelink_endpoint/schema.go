@@ -0,0 +1,60 @@
+package privatelink_endpoint
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *privatelinkEndpointsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasource_schema.Schema{
+		Description: "Retrieve all the PrivateLink endpoints of the account, optionally filtered by type and CIDR range",
+		Attributes: map[string]datasource_schema.Attribute{
+			"type": datasource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the endpoints of this type (e.g. `snowflake`, `databricks`, `redshift`, `postgres`)",
+			},
+			"cidr_range": datasource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the endpoints with a CIDR range overlapping this CIDR range or IP address (e.g. `10.0.0.0/16` or `10.0.1.5`)",
+			},
+			"endpoints": datasource_schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of PrivateLink endpoints",
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"id": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The internal ID of the PrivateLink endpoint, to use in `private_link_endpoint_id` of the global connections",
+						},
+						"name": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Given descriptive name for the PrivateLink endpoint",
+						},
+						"type": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the PrivateLink endpoint",
+						},
+						"private_link_endpoint_url": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The URL of the PrivateLink endpoint",
+						},
+						"cidr_range": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The CIDR range of the PrivateLink endpoint",
+						},
+						"state": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "The state of the PrivateLink endpoint, 1 = active",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestDbtCloudJobsDataSource(t *testing.T) {
 
-	projectName := acctest.RandStringFromCharSet(19, acctest.CharSetAlphaNum)
+	projectName := acctest_helper.RandomName()
 	projectName1 := fmt.Sprintf("%s1", projectName)
 	projectName2 := fmt.Sprintf("%s2", projectName)
 
@@ -26,6 +25,7 @@ func TestDbtCloudJobsDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
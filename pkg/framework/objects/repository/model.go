@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryResourceModel struct {
+	ID                                    types.String `tfsdk:"id"`
+	RepositoryID                          types.Int64  `tfsdk:"repository_id"`
+	IsActive                              types.Bool   `tfsdk:"is_active"`
+	ProjectID                             types.Int64  `tfsdk:"project_id"`
+	RemoteURL                             types.String `tfsdk:"remote_url"`
+	GitCloneStrategy                      types.String `tfsdk:"git_clone_strategy"`
+	RepositoryCredentialsID               types.Int64  `tfsdk:"repository_credentials_id"`
+	GitlabProjectID                       types.Int64  `tfsdk:"gitlab_project_id"`
+	GithubInstallationID                  types.Int64  `tfsdk:"github_installation_id"`
+	AzureActiveDirectoryProjectID         types.String `tfsdk:"azure_active_directory_project_id"`
+	AzureActiveDirectoryRepositoryID      types.String `tfsdk:"azure_active_directory_repository_id"`
+	AzureBypassWebhookRegistrationFailure types.Bool   `tfsdk:"azure_bypass_webhook_registration_failure"`
+	FetchDeployKey                        types.Bool   `tfsdk:"fetch_deploy_key"`
+	DeployKey                             types.String `tfsdk:"deploy_key"`
+	PreviousDeployKey                     types.String `tfsdk:"previous_deploy_key"`
+	DeployKeyRotationTrigger              types.String `tfsdk:"deploy_key_rotation_trigger"`
+	PullRequestURLTemplate                types.String `tfsdk:"pull_request_url_template"`
+}
+
+// setFromAPI sets the fields returned by the API, the GitLab and Azure DevOps IDs are not returned and are kept as is
+func (m *RepositoryResourceModel) setFromAPI(repository *dbt_cloud.Repository) {
+	m.ID = types.StringValue(
+		fmt.Sprintf("%d%s%d", repository.ProjectID, dbt_cloud.ID_DELIMITER, *repository.ID),
+	)
+	m.RepositoryID = types.Int64Value(int64(*repository.ID))
+	m.IsActive = types.BoolValue(repository.State == dbt_cloud.STATE_ACTIVE)
+	m.ProjectID = types.Int64Value(int64(repository.ProjectID))
+	m.RemoteURL = types.StringValue(repository.RemoteUrl)
+	m.GitCloneStrategy = types.StringValue(repository.GitCloneStrategy)
+	m.RepositoryCredentialsID = types.Int64PointerValue(
+		helper.IntPointerToInt64Pointer(repository.RepositoryCredentialsID),
+	)
+	m.GithubInstallationID = types.Int64PointerValue(
+		helper.IntPointerToInt64Pointer(repository.GithubInstallationID),
+	)
+	m.DeployKey = types.StringNull()
+	if repository.DeployKey != nil {
+		m.DeployKey = types.StringValue(repository.DeployKey.PublicKey)
+	}
+	m.PullRequestURLTemplate = types.StringValue(repository.PullRequestURLTemplate)
+}
@@ -0,0 +1,416 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+var (
+	_ resource.Resource                = &repositoryResource{}
+	_ resource.ResourceWithConfigure   = &repositoryResource{}
+	_ resource.ResourceWithImportState = &repositoryResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryResource{}
+)
+
+// inPlaceCloneStrategies are the clone strategies dbt Cloud can switch between without recreating the repository
+var inPlaceCloneStrategies = []string{"deploy_key", "github_app"}
+
+func RepositoryResource() resource.Resource {
+	return &repositoryResource{}
+}
+
+type repositoryResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *repositoryResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_repository"
+}
+
+func isInPlaceCloneStrategyChange(currentStrategy, newStrategy string) bool {
+	return lo.Contains(inPlaceCloneStrategies, currentStrategy) &&
+		lo.Contains(inPlaceCloneStrategies, newStrategy)
+}
+
+func requiresReplaceForCloneStrategy(
+	_ context.Context,
+	req planmodifier.StringRequest,
+	resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+) {
+	resp.RequiresReplace = !isInPlaceCloneStrategyChange(
+		req.StateValue.ValueString(),
+		req.PlanValue.ValueString(),
+	)
+}
+
+// requiresReplaceForGitlabProjectID ignores the 0 saved by the SDKv2 version of the resource when the value was not set
+func requiresReplaceForGitlabProjectID(
+	_ context.Context,
+	req planmodifier.Int64Request,
+	resp *int64planmodifier.RequiresReplaceIfFuncResponse,
+) {
+	resp.RequiresReplace = !(req.StateValue.ValueInt64() == 0 && req.PlanValue.IsNull())
+}
+
+// isDeployKeyRotationRequested returns true when the trigger has been changed to a new non-empty value
+func isDeployKeyRotationRequested(plan, state RepositoryResourceModel) bool {
+	return !plan.DeployKeyRotationTrigger.IsNull() &&
+		!plan.DeployKeyRotationTrigger.IsUnknown() &&
+		plan.DeployKeyRotationTrigger.ValueString() != "" &&
+		!plan.DeployKeyRotationTrigger.Equal(state.DeployKeyRotationTrigger)
+}
+
+func (r *repositoryResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var plan, state RepositoryResourceModel
+
+	if req.Plan.Raw.IsNull() {
+		// nothing to do when the resource is destroyed
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("previous_deploy_key"), types.StringNull())...,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the previous key is only kept until the apply following the rotation
+	previousDeployKey := types.StringNull()
+
+	if isDeployKeyRotationRequested(plan, state) {
+		if plan.GitCloneStrategy.ValueString() != "deploy_key" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("deploy_key_rotation_trigger"),
+				"Invalid deploy key rotation",
+				"The deploy key can only be rotated for repositories using the `deploy_key` clone strategy",
+			)
+			return
+		}
+		previousDeployKey = state.DeployKey
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("deploy_key"), types.StringUnknown())...,
+		)
+	}
+
+	if !plan.GitCloneStrategy.IsUnknown() && !plan.GitCloneStrategy.Equal(state.GitCloneStrategy) {
+		// the deploy key and the credentials depend on the strategy
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("deploy_key"), types.StringUnknown())...,
+		)
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("repository_credentials_id"), types.Int64Unknown())...,
+		)
+	}
+
+	resp.Diagnostics.Append(
+		resp.Plan.SetAttribute(ctx, path.Root("previous_deploy_key"), previousDeployKey)...,
+	)
+}
+
+func (r *repositoryResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectIDString, repositoryIDString, err := helper.SplitIDToStrings(
+		state.ID.ValueString(),
+		"dbtcloud_repository",
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the repository ID", err.Error())
+		return
+	}
+
+	repository, err := r.client.GetRepository(repositoryIDString, projectIDString)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The repository resource was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error getting the repository", err.Error())
+		return
+	}
+
+	state.setFromAPI(repository)
+
+	// the following values are not sent back by the API, we keep them as they are in the config
+	// and set the defaults after an import
+	if state.GitlabProjectID.ValueInt64() == 0 {
+		// the SDKv2 version of the resource was saving 0 when the value was not set
+		state.GitlabProjectID = types.Int64Null()
+	}
+	if state.AzureActiveDirectoryProjectID.IsNull() {
+		state.AzureActiveDirectoryProjectID = types.StringValue("")
+	}
+	if state.AzureActiveDirectoryRepositoryID.IsNull() {
+		state.AzureActiveDirectoryRepositoryID = types.StringValue("")
+	}
+	if state.AzureBypassWebhookRegistrationFailure.IsNull() {
+		state.AzureBypassWebhookRegistrationFailure = types.BoolValue(false)
+	}
+	if state.FetchDeployKey.IsNull() {
+		state.FetchDeployKey = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *repositoryResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gitlabProjectID := int(plan.GitlabProjectID.ValueInt64())
+
+	repository, err := r.client.CreateRepository(
+		int(plan.ProjectID.ValueInt64()),
+		plan.RemoteURL.ValueString(),
+		plan.IsActive.ValueBool(),
+		plan.GitCloneStrategy.ValueString(),
+		gitlabProjectID,
+		int(plan.GithubInstallationID.ValueInt64()),
+		plan.AzureActiveDirectoryProjectID.ValueString(),
+		plan.AzureActiveDirectoryRepositoryID.ValueString(),
+		plan.AzureBypassWebhookRegistrationFailure.ValueBool(),
+		plan.PullRequestURLTemplate.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create the repository", "Error: "+err.Error())
+		return
+	}
+
+	// checking potential issues with the creation of GitLab repositories with service tokens
+	if repository.RepositoryCredentialsID == nil && gitlabProjectID != 0 {
+
+		_, err := r.client.DeleteRepository(
+			strconv.Itoa(*repository.ID),
+			strconv.Itoa(repository.ProjectID),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to create the repository", "Error: "+err.Error())
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to create the repository",
+			"`repository_credentials_id` is not set after creating the repository. This is likely due to creating the repository with a service token. Only user tokens / personal access tokens are supported for GitLab at the moment",
+		)
+		return
+	}
+
+	// the deploy key is not always returned when creating the repository
+	repository, err = r.client.GetRepository(
+		strconv.Itoa(*repository.ID),
+		strconv.Itoa(repository.ProjectID),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the repository", err.Error())
+		return
+	}
+
+	plan.setFromAPI(repository)
+	plan.PreviousDeployKey = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectIDString, repositoryIDString, err := helper.SplitIDToStrings(
+		state.ID.ValueString(),
+		"dbtcloud_repository",
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the repository ID", err.Error())
+		return
+	}
+
+	repository, err := r.client.GetRepository(repositoryIDString, projectIDString)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the repository", err.Error())
+		return
+	}
+
+	if plan.IsActive.ValueBool() {
+		repository.State = dbt_cloud.STATE_ACTIVE
+	} else {
+		repository.State = dbt_cloud.STATE_DELETED
+	}
+	repository.PullRequestURLTemplate = plan.PullRequestURLTemplate.ValueString()
+
+	cloneStrategy := plan.GitCloneStrategy.ValueString()
+	repository.GitCloneStrategy = cloneStrategy
+	repository.GithubInstallationID = nil
+	if cloneStrategy == "github_app" {
+		githubInstallationID := int(plan.GithubInstallationID.ValueInt64())
+		repository.GithubInstallationID = &githubInstallationID
+		repository.DeployKey = nil
+		repository.DeployKeyID = nil
+	}
+
+	rotateDeployKey := isDeployKeyRotationRequested(plan, state)
+	switchToDeployKey := cloneStrategy == "deploy_key" &&
+		state.GitCloneStrategy.ValueString() != "deploy_key"
+
+	// the deploy key replaced by a new one is not used anymore and is deleted after the update
+	var replacedDeployKeyID *int
+	if rotateDeployKey || switchToDeployKey {
+		deployKey, err := r.client.CreateDeployKey()
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to generate a new deploy key", "Error: "+err.Error())
+			return
+		}
+		replacedDeployKeyID = repository.DeployKeyID
+		repository.DeployKey = nil
+		repository.DeployKeyID = &deployKey.ID
+	}
+
+	_, err = r.client.UpdateRepository(repositoryIDString, projectIDString, *repository)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update the repository", "Error: "+err.Error())
+		return
+	}
+
+	if replacedDeployKeyID != nil {
+		err = r.client.DeleteDeployKey(*replacedDeployKeyID)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to delete the previous deploy key",
+				fmt.Sprintf(
+					"The repository uses the new deploy key but the previous one (ID %d) could not be deleted: %s",
+					*replacedDeployKeyID,
+					err,
+				),
+			)
+		}
+	}
+
+	repository, err = r.client.GetRepository(repositoryIDString, projectIDString)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the repository", err.Error())
+		return
+	}
+
+	plan.setFromAPI(repository)
+	plan.PreviousDeployKey = types.StringNull()
+	if rotateDeployKey {
+		plan.PreviousDeployKey = state.DeployKey
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectIDString, repositoryIDString, err := helper.SplitIDToStrings(
+		state.ID.ValueString(),
+		"dbtcloud_repository",
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the repository ID", err.Error())
+		return
+	}
+
+	_, err = r.client.DeleteRepository(repositoryIDString, projectIDString)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting the repository", err.Error())
+		return
+	}
+}
+
+func (r *repositoryResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	_, _, err := helper.SplitIDToStrings(req.ID, "dbtcloud_repository")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing the repository ID",
+			fmt.Sprintf("The ID needs to be in the format `project_id%srepository_id`: %s", dbt_cloud.ID_DELIMITER, err),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *repositoryResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,403 @@
+package repository_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// TODO: Add more tests
+// we are currently testing in CI the SSH cloning and GH native cloning but not GitLab native and ADO native
+// this would require having the GitLab and ADO native integrations set up in the dbt Cloud account used for CI
+
+func TestAccDbtCloudRepositoryResource(t *testing.T) {
+
+	repoUrlGithub := "git@github.com:dbt-labs/terraform-provider-dbtcloud.git"
+	projectName := strings.ToUpper(acctest_helper.RandomName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudRepositoryDestroy,
+		Steps: []resource.TestStep{
+			// Create Github repository
+			{
+				Config: testAccDbtCloudRepositoryResourceGithubConfig(repoUrlGithub, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudRepositoryExists(
+						"dbtcloud_repository.test_repository_github",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_repository.test_repository_github",
+						"remote_url",
+						repoUrlGithub,
+					),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_repository.test_repository_github",
+						"deploy_key",
+					),
+				),
+			},
+			// ROTATE DEPLOY KEY
+			{
+				Config: testAccDbtCloudRepositoryResourceGithubRotateConfig(
+					repoUrlGithub,
+					projectName,
+					"2024-01-01",
+				),
+				// the next plan removes the previous key
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_repository.test_repository_github",
+							plancheck.ResourceActionUpdate,
+						),
+						plancheck.ExpectUnknownValue(
+							"dbtcloud_repository.test_repository_github",
+							tfjsonpath.New("deploy_key"),
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_repository.test_repository_github",
+						"deploy_key",
+					),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_repository.test_repository_github",
+						"previous_deploy_key",
+					),
+					testAccCheckDbtCloudPreviousDeployKeyDeleted(
+						"dbtcloud_repository.test_repository_github",
+					),
+				),
+			},
+			// the previous key is removed at the next apply
+			{
+				Config: testAccDbtCloudRepositoryResourceGithubRotateConfig(
+					repoUrlGithub,
+					projectName,
+					"2024-01-01",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_repository.test_repository_github",
+						"previous_deploy_key",
+					),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "dbtcloud_repository.test_repository_github",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"fetch_deploy_key",
+					"deploy_key_rotation_trigger",
+				},
+			},
+		},
+	})
+
+	repoUrlGithubApplication := "git://github.com/dbt-labs/jaffle_shop.git"
+	projectNameGithubApplication := strings.ToUpper(
+		acctest.RandStringFromCharSet(10, acctest.CharSetAlpha),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudRepositoryDestroy,
+		Steps: []resource.TestStep{
+			// Create Github repository via the GithUb Application
+			{
+				Config: testAccDbtCloudRepositoryResourceGithubApplicationConfig(
+					repoUrlGithubApplication,
+					projectNameGithubApplication,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudRepositoryExists(
+						"dbtcloud_repository.test_repository_github_application",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_repository.test_repository_github_application",
+						"remote_url",
+						repoUrlGithubApplication,
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_repository.test_repository_github_application",
+						"git_clone_strategy",
+						"github_app",
+					),
+				),
+			},
+			// MODIFY
+			// IMPORT
+			{
+				ResourceName:            "dbtcloud_repository.test_repository_github_application",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fetch_deploy_key"},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudRepositoryResourceGithubConfig(repoUrl, projectName string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbtcloud_repository" "test_repository_github" {
+  remote_url = "%s"
+  project_id = dbtcloud_project.test_project.id
+  git_clone_strategy = "deploy_key"
+  pull_request_url_template = "https://github.com/my-org/my-repo/compare/qa...{{source}}"
+}
+`, projectName, repoUrl)
+}
+
+func TestAccDbtCloudRepositoryResourceCloneStrategyChange(t *testing.T) {
+
+	repoUrl := "git://github.com/dbt-labs/jaffle_shop.git"
+	projectName := strings.ToUpper(acctest_helper.RandomName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudRepositoryResourceCloneStrategyConfig(
+					repoUrl,
+					projectName,
+					"deploy_key",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_repository.test_repository",
+						"deploy_key",
+					),
+				),
+			},
+			// switching to the GitHub App doesn't recreate the repository
+			{
+				Config: testAccDbtCloudRepositoryResourceCloneStrategyConfig(
+					repoUrl,
+					projectName,
+					"github_app",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_repository.test_repository",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_repository.test_repository",
+						"git_clone_strategy",
+						"github_app",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_repository.test_repository",
+						"github_installation_id",
+						"28374841",
+					),
+				),
+			},
+			// and switching back generates a new deploy key
+			{
+				Config: testAccDbtCloudRepositoryResourceCloneStrategyConfig(
+					repoUrl,
+					projectName,
+					"deploy_key",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_repository.test_repository",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_repository.test_repository",
+						"git_clone_strategy",
+						"deploy_key",
+					),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_repository.test_repository",
+						"deploy_key",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudRepositoryResourceGithubRotateConfig(
+	repoUrl, projectName, rotationTrigger string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbtcloud_repository" "test_repository_github" {
+  remote_url = "%s"
+  project_id = dbtcloud_project.test_project.id
+  git_clone_strategy = "deploy_key"
+  pull_request_url_template = "https://github.com/my-org/my-repo/compare/qa...{{source}}"
+  deploy_key_rotation_trigger = "%s"
+}
+`, projectName, repoUrl, rotationTrigger)
+}
+
+func testAccDbtCloudRepositoryResourceCloneStrategyConfig(
+	repoUrl, projectName, cloneStrategy string,
+) string {
+	githubInstallationID := ""
+	if cloneStrategy == "github_app" {
+		githubInstallationID = "github_installation_id = 28374841"
+	}
+
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbtcloud_repository" "test_repository" {
+  remote_url = "%s"
+  project_id = dbtcloud_project.test_project.id
+  git_clone_strategy = "%s"
+  %s
+}
+`, projectName, repoUrl, cloneStrategy, githubInstallationID)
+}
+
+func testAccDbtCloudRepositoryResourceGithubApplicationConfig(repoUrl, projectName string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbtcloud_repository" "test_repository_github_application" {
+  remote_url = "%s"
+  project_id = dbtcloud_project.test_project.id
+  github_installation_id = 28374841
+  git_clone_strategy = "github_app"
+  pull_request_url_template = "https://github.com/my-org/my-repo/compare/qa...{{source}}"
+}
+`, projectName, repoUrl)
+}
+
+//
+// func testAccDbtCloudRepositoryResourceGitlabConfig(repoUrl, projectName string) string {
+// 	return fmt.Sprintf(`
+// resource "dbtcloud_project" "test_project" {
+//   name        = "%s"
+// }
+//
+// resource "dbtcloud_repository" "test_repository_gitlab" {
+//   remote_url = "%s"
+//   project_id = dbtcloud_project.test_project.id
+//   gitlab_project_id = 34786716
+// }
+// `, projectName, repoUrl)
+// }
+
+func testAccCheckDbtCloudRepositoryExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient, err := acctest_helper.SharedClient()
+		if err != nil {
+			return fmt.Errorf("Issue getting the client")
+		}
+		projectId, repositoryId, err := helper.SplitIDToStrings(
+			rs.Primary.ID,
+			"dbtcloud_repository",
+		)
+		if err != nil {
+			return err
+		}
+		_, err = apiClient.GetRepository(repositoryId, projectId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+// testAccCheckDbtCloudPreviousDeployKeyDeleted checks that the deploy key replaced by a rotation was deleted
+// the API doesn't list the deploy keys, the check is only done with the fake API
+func testAccCheckDbtCloudPreviousDeployKeyDeleted(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		server, err := acctest_helper.FakeAPI()
+		if err != nil || server == nil {
+			return err
+		}
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		previousDeployKey := rs.Primary.Attributes["previous_deploy_key"]
+		for _, deployKey := range server.Objects("deploy-keys") {
+			if deployKey["public_key"] == previousDeployKey {
+				return fmt.Errorf("the previous deploy key %v was not deleted", deployKey["id"])
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudRepositoryDestroy(s *terraform.State) error {
+	apiClient, err := acctest_helper.SharedClient()
+	if err != nil {
+		return fmt.Errorf("Issue getting the client")
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbtcloud_repository" {
+			continue
+		}
+		projectId, repositoryId, err := helper.SplitIDToStrings(
+			rs.Primary.ID,
+			"dbtcloud_repository",
+		)
+		if err != nil {
+			return err
+		}
+		_, err = apiClient.GetRepository(repositoryId, projectId)
+		if err == nil {
+			return fmt.Errorf("Repository still exists")
+		}
+		notFoundErr := "resource-not-found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
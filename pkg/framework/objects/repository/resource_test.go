@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/samber/lo"
+)
+
+func TestIsInPlaceCloneStrategyChange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		currentStrategy string
+		newStrategy     string
+		expected        bool
+	}{
+		{currentStrategy: "deploy_key", newStrategy: "github_app", expected: true},
+		{currentStrategy: "github_app", newStrategy: "deploy_key", expected: true},
+		{currentStrategy: "deploy_key", newStrategy: "deploy_key", expected: true},
+		{currentStrategy: "deploy_key", newStrategy: "git_token", expected: false},
+		{currentStrategy: "git_token", newStrategy: "github_app", expected: false},
+		{currentStrategy: "azure_active_directory_app", newStrategy: "git_token", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		actual := isInPlaceCloneStrategyChange(testCase.currentStrategy, testCase.newStrategy)
+		if actual != testCase.expected {
+			t.Errorf(
+				"%s to %s: expected %t, got %t",
+				testCase.currentStrategy,
+				testCase.newStrategy,
+				testCase.expected,
+				actual,
+			)
+		}
+	}
+}
+
+func TestRequiresReplaceForCloneStrategy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		state    string
+		plan     string
+		expected bool
+	}{
+		{state: "deploy_key", plan: "github_app", expected: false},
+		{state: "github_app", plan: "deploy_key", expected: false},
+		{state: "github_app", plan: "git_token", expected: true},
+		{state: "git_token", plan: "deploy_key", expected: true},
+	}
+
+	for _, testCase := range testCases {
+		req := planmodifier.StringRequest{
+			StateValue: types.StringValue(testCase.state),
+			PlanValue:  types.StringValue(testCase.plan),
+		}
+		resp := stringplanmodifier.RequiresReplaceIfFuncResponse{}
+		requiresReplaceForCloneStrategy(context.Background(), req, &resp)
+		if resp.RequiresReplace != testCase.expected {
+			t.Errorf("%s to %s: expected %t, got %t", testCase.state, testCase.plan, testCase.expected, resp.RequiresReplace)
+		}
+	}
+}
+
+func TestRequiresReplaceForGitlabProjectID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		state    types.Int64
+		plan     types.Int64
+		expected bool
+	}{
+		{
+			name:     "0 saved by the SDKv2 resource",
+			state:    types.Int64Value(0),
+			plan:     types.Int64Null(),
+			expected: false,
+		},
+		{
+			name:     "project set",
+			state:    types.Int64Value(0),
+			plan:     types.Int64Value(123),
+			expected: true,
+		},
+		{
+			name:     "project changed",
+			state:    types.Int64Value(123),
+			plan:     types.Int64Value(456),
+			expected: true,
+		},
+		{
+			name:     "project removed",
+			state:    types.Int64Value(123),
+			plan:     types.Int64Null(),
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		req := planmodifier.Int64Request{
+			StateValue: testCase.state,
+			PlanValue:  testCase.plan,
+		}
+		resp := int64planmodifier.RequiresReplaceIfFuncResponse{}
+		requiresReplaceForGitlabProjectID(context.Background(), req, &resp)
+		if resp.RequiresReplace != testCase.expected {
+			t.Errorf("%s: expected %t, got %t", testCase.name, testCase.expected, resp.RequiresReplace)
+		}
+	}
+}
+
+func testRepositoryModel(cloneStrategy, rotationTrigger, deployKey string) RepositoryResourceModel {
+	return RepositoryResourceModel{
+		ID:                                    types.StringValue("1:2"),
+		RepositoryID:                          types.Int64Value(2),
+		IsActive:                              types.BoolValue(true),
+		ProjectID:                             types.Int64Value(1),
+		RemoteURL:                             types.StringValue("git@github.com:dbt-labs/jaffle_shop.git"),
+		GitCloneStrategy:                      types.StringValue(cloneStrategy),
+		RepositoryCredentialsID:               types.Int64Null(),
+		GitlabProjectID:                       types.Int64Null(),
+		GithubInstallationID:                  types.Int64Null(),
+		AzureActiveDirectoryProjectID:         types.StringValue(""),
+		AzureActiveDirectoryRepositoryID:      types.StringValue(""),
+		AzureBypassWebhookRegistrationFailure: types.BoolValue(false),
+		FetchDeployKey:                        types.BoolValue(false),
+		DeployKey:                             types.StringValue(deployKey),
+		PreviousDeployKey:                     types.StringNull(),
+		DeployKeyRotationTrigger:              types.StringValue(rotationTrigger),
+		PullRequestURLTemplate:                types.StringValue(""),
+	}
+}
+
+func TestModifyPlanDeployKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResp := resource.SchemaResponse{}
+	(&repositoryResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	testCases := []struct {
+		name                      string
+		state                     *RepositoryResourceModel
+		plan                      RepositoryResourceModel
+		expectError               bool
+		expectUnknownDeployKey    bool
+		expectUnknownCredentials  bool
+		expectedPreviousDeployKey types.String
+	}{
+		{
+			name:                      "create",
+			plan:                      testRepositoryModel("deploy_key", "2024-01-01", "ssh-rsa planned"),
+			expectedPreviousDeployKey: types.StringNull(),
+		},
+		{
+			name:                      "trigger unchanged",
+			state:                     lo.ToPtr(testRepositoryModel("deploy_key", "2024-01-01", "ssh-rsa current")),
+			plan:                      testRepositoryModel("deploy_key", "2024-01-01", "ssh-rsa current"),
+			expectedPreviousDeployKey: types.StringNull(),
+		},
+		{
+			name:                      "trigger changed",
+			state:                     lo.ToPtr(testRepositoryModel("deploy_key", "2024-01-01", "ssh-rsa current")),
+			plan:                      testRepositoryModel("deploy_key", "2024-02-01", "ssh-rsa current"),
+			expectUnknownDeployKey:    true,
+			expectedPreviousDeployKey: types.StringValue("ssh-rsa current"),
+		},
+		{
+			name:                      "trigger removed",
+			state:                     lo.ToPtr(testRepositoryModel("deploy_key", "2024-01-01", "ssh-rsa current")),
+			plan:                      testRepositoryModel("deploy_key", "", "ssh-rsa current"),
+			expectedPreviousDeployKey: types.StringNull(),
+		},
+		{
+			name:        "trigger changed without deploy key",
+			state:       lo.ToPtr(testRepositoryModel("github_app", "2024-01-01", "")),
+			plan:        testRepositoryModel("github_app", "2024-02-01", ""),
+			expectError: true,
+		},
+		{
+			name:                      "clone strategy changed",
+			state:                     lo.ToPtr(testRepositoryModel("github_app", "2024-01-01", "")),
+			plan:                      testRepositoryModel("deploy_key", "2024-01-01", ""),
+			expectUnknownDeployKey:    true,
+			expectUnknownCredentials:  true,
+			expectedPreviousDeployKey: types.StringNull(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		plan := tfsdk.Plan{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		diags := plan.Set(ctx, &testCase.plan)
+		state := tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		if testCase.state != nil {
+			diags.Append(state.Set(ctx, testCase.state)...)
+		}
+		if diags.HasError() {
+			t.Fatalf("%s: setting the plan and state: %v", testCase.name, diags)
+		}
+
+		req := resource.ModifyPlanRequest{Plan: plan, State: state}
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		(&repositoryResource{}).ModifyPlan(ctx, req, &resp)
+
+		if resp.Diagnostics.HasError() != testCase.expectError {
+			t.Fatalf("%s: unexpected diagnostics: %v", testCase.name, resp.Diagnostics)
+		}
+		if testCase.expectError {
+			continue
+		}
+
+		var modifiedPlan RepositoryResourceModel
+		resp.Diagnostics.Append(resp.Plan.Get(ctx, &modifiedPlan)...)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: getting the plan: %v", testCase.name, resp.Diagnostics)
+		}
+		if modifiedPlan.DeployKey.IsUnknown() != testCase.expectUnknownDeployKey {
+			t.Errorf("%s: unexpected deploy_key %s", testCase.name, modifiedPlan.DeployKey)
+		}
+		if modifiedPlan.RepositoryCredentialsID.IsUnknown() != testCase.expectUnknownCredentials {
+			t.Errorf("%s: unexpected repository_credentials_id %s", testCase.name, modifiedPlan.RepositoryCredentialsID)
+		}
+		if !modifiedPlan.PreviousDeployKey.Equal(testCase.expectedPreviousDeployKey) {
+			t.Errorf(
+				"%s: expected previous_deploy_key %s, got %s",
+				testCase.name,
+				testCase.expectedPreviousDeployKey,
+				modifiedPlan.PreviousDeployKey,
+			)
+		}
+	}
+}
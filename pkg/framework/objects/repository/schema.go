@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func (r *repositoryResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resource_schema.Schema{
+		Description: "Manage the connection of dbt Cloud to a git repository",
+		Attributes: map[string]resource_schema.Attribute{
+			"id": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the repository, in the format `project_id:repository_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_id": resource_schema.Int64Attribute{
+				Computed:    true,
+				Description: "Repository Identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_active": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the repository is active",
+			},
+			"project_id": resource_schema.Int64Attribute{
+				Required:    true,
+				Description: "Project ID to create the repository in",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"remote_url": resource_schema.StringAttribute{
+				Required:    true,
+				Description: "Git URL for the repository or \\<Group>/\\<Project> for Gitlab",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"git_clone_strategy": resource_schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("deploy_key"),
+				Description: helper.DocString(
+					`Git clone strategy for the repository. Can be ~~~deploy_key~~~ (default) for cloning via SSH Deploy Key, ~~~github_app~~~ for GitHub native integration, ~~~deploy_token~~~ for the GitLab native integration and ~~~azure_active_directory_app~~~ for ADO native integration.
+					Switching between ~~~deploy_key~~~ and ~~~github_app~~~ updates the repository in place, other changes recreate it.`,
+				),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceForCloneStrategy,
+						"Switching between deploy_key and github_app is done in place, other changes recreate the repository",
+						"Switching between `deploy_key` and `github_app` is done in place, other changes recreate the repository",
+					),
+				},
+			},
+			"repository_credentials_id": resource_schema.Int64Attribute{
+				Computed:    true,
+				Description: "Credentials ID for the repository (From the repository side not the dbt Cloud ID)",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"gitlab_project_id": resource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the Gitlab project -  (for GitLab native integration only). It can be retrieved with the data source `dbtcloud_gitlab_project`",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						requiresReplaceForGitlabProjectID,
+						"Changing the GitLab project recreates the repository",
+						"Changing the GitLab project recreates the repository",
+					),
+				},
+			},
+			"github_installation_id": resource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the GitHub App - (for GitHub native integration only). It can be retrieved with the data source `dbtcloud_github_installations`",
+			},
+			"azure_active_directory_project_id": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "The Azure Dev Ops project ID. It can be retrieved via the Azure API or using the data source `dbtcloud_azure_dev_ops_project` and the project name - (for ADO native integration only)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"azure_active_directory_repository_id": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "The Azure Dev Ops repository ID. It can be retrieved via the Azure API or using the data source `dbtcloud_azure_dev_ops_repository` along with the ADO Project ID and the repository name - (for ADO native integration only)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"azure_bypass_webhook_registration_failure": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "If set to False (the default), the connection will fail if the service user doesn't have access to set webhooks (required for auto-triggering CI jobs). If set to True, the connection will be successful but no automated CI job will be triggered - (for ADO native integration only)",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"fetch_deploy_key": resource_schema.BoolAttribute{
+				Optional:           true,
+				Computed:           true,
+				Default:            booldefault.StaticBool(false),
+				Description:        "Whether we should return the public deploy key - (for the `deploy_key` strategy)",
+				DeprecationMessage: "This field is deprecated and will be removed in a future version of the provider, please remove it from your configuration. The key is always fetched when the clone strategy is `deploy_key`",
+			},
+			"deploy_key": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key generated by dbt when using `deploy_key` clone strategy",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_deploy_key": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key used by dbt Cloud before the last rotation with `deploy_key_rotation_trigger`. It is kept until the next apply so that both keys can be set in the Git provider during the rotation",
+			},
+			"deploy_key_rotation_trigger": resource_schema.StringAttribute{
+				Optional: true,
+				Description: helper.DocString(
+					`Arbitrary value that generates a new ~~~deploy_key~~~ when it is changed to a new non-empty value (e.g. a date or a version number) - (for the ~~~deploy_key~~~ strategy).
+					dbt Cloud uses the new key as soon as it is generated, and the previous one is available in ~~~previous_deploy_key~~~ until the next apply.`,
+				),
+			},
+			"pull_request_url_template": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "URL template for creating a pull request. If it is not set, the default template will create a PR from the current branch to the branch configured in the Development environment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccDbtCloudServiceTokenDataSource(t *testing.T) {
 
-	serviceTokenName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	serviceTokenName := acctest_helper.RandomName()
 
 	config := serviceToken(serviceTokenName)
 
@@ -32,6 +31,7 @@ func TestAccDbtCloudServiceTokenDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/samber/lo"
@@ -104,3 +105,20 @@ func ConvertServiceTokenPermissionDataToModel(
 	}
 	return allPermissionsModel, allDiags
 }
+
+func CompareServiceTokenPermissions(
+	permission1, permission2 ServiceTokenPermission,
+) bool {
+	listPermission1Envs := helper.StringSetToStringSlice(permission1.WritableEnvironmentCategories)
+	listPermission2Envs := helper.StringSetToStringSlice(permission2.WritableEnvironmentCategories)
+
+	diffEnv1, diffEnv2 := lo.Difference(
+		listPermission1Envs,
+		listPermission2Envs,
+	)
+	return permission1.PermissionSet == permission2.PermissionSet &&
+		permission1.ProjectID == permission2.ProjectID &&
+		permission1.AllProjects == permission2.AllProjects &&
+		len(diffEnv1) == 0 &&
+		len(diffEnv2) == 0
+}
@@ -7,16 +7,15 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDbtCloudServiceTokenResource(t *testing.T) {
 
-	serviceTokenName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
-	serviceTokenName2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
-	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	serviceTokenName := acctest_helper.RandomName()
+	serviceTokenName2 := acctest_helper.RandomName()
+	projectName := acctest_helper.RandomName()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
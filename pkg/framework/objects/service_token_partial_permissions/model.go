@@ -0,0 +1,12 @@
+package service_token_partial_permissions
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/service_token"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ServiceTokenPartialPermissionsResourceModel struct {
+	ID                      types.Int64                            `tfsdk:"id"`
+	ServiceTokenID          types.Int64                            `tfsdk:"service_token_id"`
+	ServiceTokenPermissions []service_token.ServiceTokenPermission `tfsdk:"service_token_permissions"`
+}
@@ -0,0 +1,296 @@
+package service_token_partial_permissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/service_token"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource              = &serviceTokenPartialPermissionsResource{}
+	_ resource.ResourceWithConfigure = &serviceTokenPartialPermissionsResource{}
+)
+
+func ServiceTokenPartialPermissionsResource() resource.Resource {
+	return &serviceTokenPartialPermissionsResource{}
+}
+
+type serviceTokenPartialPermissionsResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *serviceTokenPartialPermissionsResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_service_token_partial_permissions"
+}
+
+func (r *serviceTokenPartialPermissionsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ServiceTokenPartialPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceTokenID := int(state.ServiceTokenID.ValueInt64())
+	retrievedServiceToken, err := r.client.GetServiceToken(serviceTokenID)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The service token was not found and the partial permissions have been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Issue getting Service Token",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	remotePermissions, diags := service_token.ConvertServiceTokenPermissionDataToModel(
+		ctx,
+		retrievedServiceToken.Permissions,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relevantPermissions := helper.IntersectBy(
+		state.ServiceTokenPermissions,
+		remotePermissions,
+		service_token.CompareServiceTokenPermissions,
+	)
+
+	tflog.Info(
+		ctx,
+		"READ - Intersection of local and remote",
+		map[string]any{
+			"Relevant intersected Permissions": fmt.Sprintf("%+v", relevantPermissions),
+			"State Permissions":                fmt.Sprintf("%+v", state.ServiceTokenPermissions),
+			"Remote Permissions":               fmt.Sprintf("%+v", remotePermissions),
+		},
+	)
+
+	state.ID = types.Int64Value(int64(*retrievedServiceToken.ID))
+	state.ServiceTokenID = types.Int64Value(int64(*retrievedServiceToken.ID))
+	state.ServiceTokenPermissions = relevantPermissions
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *serviceTokenPartialPermissionsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ServiceTokenPartialPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceTokenID := int(plan.ServiceTokenID.ValueInt64())
+	retrievedServiceToken, err := r.client.GetServiceToken(serviceTokenID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Service Token",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	remotePermissions, diags := service_token.ConvertServiceTokenPermissionDataToModel(
+		ctx,
+		retrievedServiceToken.Permissions,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	missingPermissions, _ := helper.DifferenceBy(
+		plan.ServiceTokenPermissions,
+		remotePermissions,
+		service_token.CompareServiceTokenPermissions,
+	)
+
+	if len(missingPermissions) > 0 {
+		allPermissions := append(remotePermissions, missingPermissions...)
+		resp.Diagnostics.Append(r.updatePermissions(ctx, serviceTokenID, allPermissions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ID = types.Int64Value(int64(serviceTokenID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *serviceTokenPartialPermissionsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ServiceTokenPartialPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceTokenID := int(state.ServiceTokenID.ValueInt64())
+	retrievedServiceToken, err := r.client.GetServiceToken(serviceTokenID)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			// the service token has been deleted, there are no permissions left to remove
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Issue getting Service Token",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	remotePermissions, diags := service_token.ConvertServiceTokenPermissionDataToModel(
+		ctx,
+		retrievedServiceToken.Permissions,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// we only remove the permissions from the resource, the service token itself is never deleted
+	requiredAllPermissions, _ := helper.DifferenceBy(
+		remotePermissions,
+		state.ServiceTokenPermissions,
+		service_token.CompareServiceTokenPermissions,
+	)
+
+	resp.Diagnostics.Append(r.updatePermissions(ctx, serviceTokenID, requiredAllPermissions)...)
+}
+
+func (r *serviceTokenPartialPermissionsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state ServiceTokenPartialPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceTokenID := int(state.ServiceTokenID.ValueInt64())
+	retrievedServiceToken, err := r.client.GetServiceToken(serviceTokenID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Service Token",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	remotePermissions, diags := service_token.ConvertServiceTokenPermissionDataToModel(
+		ctx,
+		retrievedServiceToken.Permissions,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deletedPermissions, newPermissions := helper.DifferenceBy(
+		state.ServiceTokenPermissions,
+		plan.ServiceTokenPermissions,
+		service_token.CompareServiceTokenPermissions,
+	)
+
+	requiredAllPermissions, _ := helper.DifferenceBy(
+		helper.UnionBy(remotePermissions, newPermissions, service_token.CompareServiceTokenPermissions),
+		deletedPermissions,
+		service_token.CompareServiceTokenPermissions,
+	)
+
+	tflog.Info(
+		ctx,
+		"UPDATE - Intersection of local and remote",
+		map[string]any{
+			"Deleted Permissions":     fmt.Sprintf("%+v", deletedPermissions),
+			"New Permissions":         fmt.Sprintf("%+v", newPermissions),
+			"Required all Permission": fmt.Sprintf("%+v", requiredAllPermissions),
+			"Remote Permissions":      fmt.Sprintf("%+v", remotePermissions),
+		},
+	)
+
+	if len(deletedPermissions) > 0 || len(newPermissions) > 0 {
+		resp.Diagnostics.Append(r.updatePermissions(ctx, serviceTokenID, requiredAllPermissions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.ServiceTokenPermissions = plan.ServiceTokenPermissions
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// updatePermissions replaces the full list of permissions of the service token
+// with the list provided
+func (r *serviceTokenPartialPermissionsResource) updatePermissions(
+	ctx context.Context,
+	serviceTokenID int,
+	permissions []service_token.ServiceTokenPermission,
+) diag.Diagnostics {
+	allPermissionsRequest, diags := service_token.ConvertServiceTokenPermissionModelToData(
+		ctx,
+		permissions,
+		serviceTokenID,
+		r.client.AccountID,
+	)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := r.client.UpdateServiceTokenPermissions(serviceTokenID, allPermissionsRequest)
+	if err != nil {
+		diags.AddError(
+			"Unable to assign permissions to the service token",
+			"Error: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+func (r *serviceTokenPartialPermissionsResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
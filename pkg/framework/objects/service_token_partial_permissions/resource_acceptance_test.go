@@ -0,0 +1,259 @@
+package service_token_partial_permissions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudServiceTokenPartialPermissionsResource(t *testing.T) {
+
+	projectName := acctest_helper.RandomName()
+	projectName2 := acctest_helper.RandomName()
+	serviceTokenName := acctest_helper.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// 1. CREATE
+			{
+				Config: testAccDbtCloudServiceTokenPartialPermissionsResourceCreate(
+					projectName,
+					projectName2,
+					serviceTokenName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_service_token_partial_permissions.test_partial_permission",
+						"service_token_id",
+						"dbtcloud_service_token.test_service_token",
+						"id",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_service_token_partial_permissions.test_partial_permission",
+						"service_token_permissions.#",
+						"2",
+					),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"dbtcloud_service_token_partial_permissions.test_partial_permission",
+						"service_token_permissions.*",
+						map[string]string{
+							"permission_set": "job_admin",
+						},
+					),
+				),
+			},
+			// 2. ADD ANOTHER RESOURCE FOR THE SAME TOKEN
+			{
+				Config: testAccDbtCloudServiceTokenPartialPermissionsResourceAddResource(
+					projectName,
+					projectName2,
+					serviceTokenName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_service_token_partial_permissions.test_partial_permission",
+						"service_token_permissions.#",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_service_token_partial_permissions.test_partial_permission2",
+						"service_token_permissions.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_service_token_partial_permissions.test_partial_permission2",
+						"service_token_permissions.0.permission_set",
+						"developer",
+					),
+				),
+			},
+			// 3. MODIFYING EXISTING RESOURCE
+			{
+				Config: testAccDbtCloudServiceTokenPartialPermissionsResourceModifyExisting(
+					projectName,
+					projectName2,
+					serviceTokenName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_service_token_partial_permissions.test_partial_permission",
+						"service_token_permissions.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_service_token_partial_permissions.test_partial_permission2",
+						"service_token_permissions.#",
+						"2",
+					),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"dbtcloud_service_token_partial_permissions.test_partial_permission2",
+						"service_token_permissions.*",
+						map[string]string{
+							"permission_set":                    "developer",
+							"writable_environment_categories.#": "1",
+						},
+					),
+				),
+			},
+			// 4. REMOVE ONE RESOURCE
+			{
+				Config: testAccDbtCloudServiceTokenPartialPermissionsResourceRemoveResource(
+					projectName,
+					projectName2,
+					serviceTokenName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_service_token_partial_permissions.test_partial_permission2",
+						"service_token_permissions.#",
+						"2",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudServiceTokenPartialPermissionsResourceBase(
+	projectName, projectName2, serviceTokenName string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+	name = "%s"
+}
+
+resource "dbtcloud_project" "test_project2" {
+	name = "%s"
+}
+
+resource "dbtcloud_service_token" "test_service_token" {
+	name = "%s"
+
+	lifecycle {
+		ignore_changes = [service_token_permissions]
+	}
+}
+`, projectName, projectName2, serviceTokenName)
+}
+
+func testAccDbtCloudServiceTokenPartialPermissionsResourceCreate(
+	projectName, projectName2, serviceTokenName string,
+) string {
+	return testAccDbtCloudServiceTokenPartialPermissionsResourceBase(
+		projectName,
+		projectName2,
+		serviceTokenName,
+	) + `
+resource "dbtcloud_service_token_partial_permissions" "test_partial_permission" {
+	service_token_id = dbtcloud_service_token.test_service_token.id
+	service_token_permissions = [
+		{
+			permission_set = "job_admin"
+			project_id     = dbtcloud_project.test_project.id
+			all_projects   = false
+		},
+		{
+			permission_set = "job_viewer"
+			project_id     = dbtcloud_project.test_project.id
+			all_projects   = false
+		}
+	]
+}
+`
+}
+
+func testAccDbtCloudServiceTokenPartialPermissionsResourceAddResource(
+	projectName, projectName2, serviceTokenName string,
+) string {
+	return testAccDbtCloudServiceTokenPartialPermissionsResourceCreate(
+		projectName,
+		projectName2,
+		serviceTokenName,
+	) + `
+resource "dbtcloud_service_token_partial_permissions" "test_partial_permission2" {
+	service_token_id = dbtcloud_service_token.test_service_token.id
+	service_token_permissions = [
+		{
+			permission_set = "developer"
+			project_id     = dbtcloud_project.test_project2.id
+			all_projects   = false
+		}
+	]
+	depends_on = [
+		dbtcloud_service_token_partial_permissions.test_partial_permission
+	]
+}
+`
+}
+
+func testAccDbtCloudServiceTokenPartialPermissionsResourceModifyExisting(
+	projectName, projectName2, serviceTokenName string,
+) string {
+	return testAccDbtCloudServiceTokenPartialPermissionsResourceBase(
+		projectName,
+		projectName2,
+		serviceTokenName,
+	) + `
+resource "dbtcloud_service_token_partial_permissions" "test_partial_permission" {
+	service_token_id = dbtcloud_service_token.test_service_token.id
+	service_token_permissions = [
+		{
+			permission_set = "job_admin"
+			project_id     = dbtcloud_project.test_project.id
+			all_projects   = false
+		}
+	]
+}
+
+resource "dbtcloud_service_token_partial_permissions" "test_partial_permission2" {
+	service_token_id = dbtcloud_service_token.test_service_token.id
+	service_token_permissions = [
+		{
+			permission_set                  = "developer"
+			project_id                      = dbtcloud_project.test_project2.id
+			all_projects                    = false
+			writable_environment_categories = ["development"]
+		},
+		{
+			permission_set = "job_runner"
+			project_id     = dbtcloud_project.test_project2.id
+			all_projects   = false
+		}
+	]
+	depends_on = [
+		dbtcloud_service_token_partial_permissions.test_partial_permission
+	]
+}
+`
+}
+
+func testAccDbtCloudServiceTokenPartialPermissionsResourceRemoveResource(
+	projectName, projectName2, serviceTokenName string,
+) string {
+	return testAccDbtCloudServiceTokenPartialPermissionsResourceBase(
+		projectName,
+		projectName2,
+		serviceTokenName,
+	) + `
+resource "dbtcloud_service_token_partial_permissions" "test_partial_permission2" {
+	service_token_id = dbtcloud_service_token.test_service_token.id
+	service_token_permissions = [
+		{
+			permission_set                  = "developer"
+			project_id                      = dbtcloud_project.test_project2.id
+			all_projects                    = false
+			writable_environment_categories = ["development"]
+		},
+		{
+			permission_set = "job_runner"
+			project_id     = dbtcloud_project.test_project2.id
+			all_projects   = false
+		}
+	]
+}
+`
+}
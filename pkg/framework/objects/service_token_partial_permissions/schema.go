@@ -0,0 +1,104 @@
+package service_token_partial_permissions
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *serviceTokenPartialPermissionsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: helper.DocString(
+			`Provide a partial set of permissions for an existing service token. This is different from ~~~dbtcloud_service_token~~~ as it allows to have multiple resources updating the permissions of the same service token and is useful for companies sharing a service token across different Terraform projects/workspaces (e.g. a CI token used by all projects).
+
+			~> This is currently an experimental resource and any feedback is welcome in the GitHub repository.
+
+			The resource currently requires a Service Token with Account Admin access.
+
+			The current behavior of the resource is the following:
+
+			- the service token needs to exist already, it is not created by this resource
+			- when using ~~~dbtcloud_service_token_partial_permissions~~~, don't define ~~~service_token_permissions~~~ in the ~~~dbtcloud_service_token~~~ resource managing the same token. Otherwise, the behavior is undefined and partial permissions might be removed.
+			- when defining a new ~~~dbtcloud_service_token_partial_permissions~~~, permissions will be added to the service token if they are not present yet
+			- in a given Terraform project/workspace, avoid having different ~~~dbtcloud_service_token_partial_permissions~~~ for the same service token to prevent sync issues. Add all the permissions in the same resource.
+			- when a resource is updated, the service token will be updated accordingly, removing and adding permissions
+			- when the resource is deleted/destroyed, the permissions from the deleted resource are removed from the service token but the service token itself is never deleted
+			`,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the service token",
+				// this is used so that we don't show that ID is going to change
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_token_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the existing service token to add the permissions to",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"service_token_permissions": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "Partial permissions for the service token. Those permissions will be added/removed when config is added/removed.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission_set": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(dbt_cloud.PermissionSets...),
+							},
+							Description: "Set of permissions to apply. The permissions allowed are the same as the ones for the `dbtcloud_service_token` resource.",
+						},
+						"project_id": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Project ID to apply this permission to for this service token.",
+						},
+						"all_projects": schema.BoolAttribute{
+							Required:    true,
+							Description: "Whether access should be provided for all projects or not.",
+						},
+						"writable_environment_categories": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
+								types.StringValue("all"),
+							})),
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(
+									stringvalidator.OneOf(dbt_cloud.EnvironmentCategories...),
+								),
+							},
+							Description: helper.DocString(
+								`What types of environments to apply Write permissions to.
+								Even if Write access is restricted to some environment types, the permission set will have Read access to all environments.
+								The values allowed are ~~~all~~~, ~~~development~~~, ~~~staging~~~, ~~~production~~~ and ~~~other~~~.
+								Not setting a value is the same as selecting ~~~all~~~.
+								Not all permission sets support environment level write settings, only ~~~analyst~~~, ~~~database_admin~~~, ~~~developer~~~, ~~~git_admin~~~ and ~~~team_admin~~~.`,
+							),
+						},
+					},
+				},
+			},
+		},
+	}
+}
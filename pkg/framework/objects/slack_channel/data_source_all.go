@@ -0,0 +1,93 @@
+package slack_channel
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &slackChannelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &slackChannelsDataSource{}
+)
+
+func SlackChannelsDataSource() datasource.DataSource {
+	return &slackChannelsDataSource{}
+}
+
+type slackChannelsDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *slackChannelsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_slack_channels"
+}
+
+func (d *slackChannelsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state slackChannelsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	_, err := d.client.GetSlackIntegration()
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddError(
+				"Slack integration not configured",
+				"The Slack integration needs to be configured for the dbt Cloud account to list Slack channels.",
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error retrieving the Slack integration",
+			err.Error(),
+		)
+		return
+	}
+
+	channels, err := d.client.GetSlackChannels()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error retrieving Slack channels",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Channels = []slackChannelDataSourceModel{}
+	for _, channel := range channels {
+		state.Channels = append(state.Channels, slackChannelDataSourceModel{
+			ID:        types.StringValue(channel.ID),
+			Name:      types.StringValue(channel.Name),
+			IsPrivate: types.BoolValue(channel.IsPrivate),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *slackChannelsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
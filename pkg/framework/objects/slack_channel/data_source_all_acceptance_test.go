@@ -0,0 +1,48 @@
+package slack_channel_test
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudSlackChannelsDataSource(t *testing.T) {
+
+	if acctest_helper.IsFakeAPI() {
+		// the Slack integration is configured in the dbt Cloud UI, it is added directly to the fake API
+		server, err := acctest_helper.FakeAPI()
+		if err != nil {
+			t.Fatalf("Error starting the fake dbt Cloud API: %s", err)
+		}
+		server.Seed("integrations/slack", map[string]any{"team_id": "T0001", "team_name": "tf-acc"})
+		server.Seed("integrations/slack/channels", map[string]any{"name": "tf-acc-alerts", "is_private": false})
+	} else if acctest_helper.IsDbtCloudPR() {
+		t.Skip("Skipping Slack channels in dbt Cloud CI as the Slack integration is not configured")
+	}
+
+	config := slackChannels()
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet("data.dbtcloud_slack_channels.all", "channels.0.id"),
+		resource.TestCheckResourceAttrSet("data.dbtcloud_slack_channels.all", "channels.0.name"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
+
+func slackChannels() string {
+	return `
+data "dbtcloud_slack_channels" "all" {
+}
+`
+}
@@ -0,0 +1,15 @@
+package slack_channel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type slackChannelDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	IsPrivate types.Bool   `tfsdk:"is_private"`
+}
+
+type slackChannelsDataSourceModel struct {
+	Channels []slackChannelDataSourceModel `tfsdk:"channels"`
+}
@@ -0,0 +1,45 @@
+package slack_channel
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *slackChannelsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: helper.DocString(
+			`Retrieve all the Slack channels available to the Slack integration of the dbt Cloud account.
+
+			This can be used to get the ~~~slack_channel_id~~~ and ~~~slack_channel_name~~~ required by ~~~dbtcloud_notification~~~ and ~~~dbtcloud_partial_notification~~~. The Slack integration needs to be configured for the account.`,
+		),
+		Attributes: map[string]schema.Attribute{
+			"channels": schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "Set of Slack channels with their ID and name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the Slack channel",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the Slack channel",
+						},
+						"is_private": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the Slack channel is private",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,144 @@
+package sso_configuration
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SSOConfigurationResourceModel struct {
+	ID                types.String        `tfsdk:"id"`
+	LoginSlug         types.String        `tfsdk:"login_slug"`
+	LoginURL          types.String        `tfsdk:"login_url"`
+	AllowMethodChange types.Bool          `tfsdk:"allow_method_change"`
+	SAML              *SAMLConfigModel    `tfsdk:"saml"`
+	Okta              *OktaConfigModel    `tfsdk:"okta"`
+	EntraID           *EntraIDConfigModel `tfsdk:"entra_id"`
+}
+
+type SAMLConfigModel struct {
+	MetadataURL types.String `tfsdk:"metadata_url"`
+	MetadataXML types.String `tfsdk:"metadata_xml"`
+	Certificate types.String `tfsdk:"certificate"`
+}
+
+type OktaConfigModel struct {
+	Domain       types.String `tfsdk:"domain"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+}
+
+type EntraIDConfigModel struct {
+	Domain       types.String `tfsdk:"domain"`
+	TenantID     types.String `tfsdk:"tenant_id"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+}
+
+// after an import, the secrets are not known
+const importedSecret = "********"
+
+// authenticationMethod returns the SSO method configured in the model, or an empty string if none is set
+func (m *SSOConfigurationResourceModel) authenticationMethod() string {
+	switch {
+	case m.SAML != nil:
+		return dbt_cloud.SSO_METHOD_SAML
+	case m.Okta != nil:
+		return dbt_cloud.SSO_METHOD_OKTA
+	case m.EntraID != nil:
+		return dbt_cloud.SSO_METHOD_ENTRA_ID
+	default:
+		return ""
+	}
+}
+
+// toAPI returns the SSO configuration to send to dbt Cloud
+func (m *SSOConfigurationResourceModel) toAPI() dbt_cloud.SSOConfiguration {
+	ssoConfiguration := dbt_cloud.SSOConfiguration{
+		AuthenticationMethod: m.authenticationMethod(),
+		LoginSlug:            m.LoginSlug.ValueString(),
+	}
+
+	switch {
+	case m.SAML != nil:
+		ssoConfiguration.MetadataURL = m.SAML.MetadataURL.ValueString()
+		ssoConfiguration.MetadataXML = m.SAML.MetadataXML.ValueString()
+		ssoConfiguration.Certificate = m.SAML.Certificate.ValueString()
+	case m.Okta != nil:
+		ssoConfiguration.Domain = m.Okta.Domain.ValueString()
+		ssoConfiguration.ClientID = m.Okta.ClientID.ValueString()
+		ssoConfiguration.ClientSecret = m.Okta.ClientSecret.ValueString()
+	case m.EntraID != nil:
+		ssoConfiguration.Domain = m.EntraID.Domain.ValueString()
+		ssoConfiguration.TenantID = m.EntraID.TenantID.ValueString()
+		ssoConfiguration.ClientID = m.EntraID.ClientID.ValueString()
+		ssoConfiguration.ClientSecret = m.EntraID.ClientSecret.ValueString()
+	}
+
+	return ssoConfiguration
+}
+
+// stringOrState returns the value from dbt Cloud, or the one from the state when dbt Cloud doesn't return it
+func stringOrState(value string, stateValue types.String) types.String {
+	if value == "" {
+		return stateValue
+	}
+	return types.StringValue(value)
+}
+
+// secretFromState returns the secret stored in the state, or a placeholder after an import
+func secretFromState(stateValue types.String) types.String {
+	if stateValue.IsNull() || stateValue.IsUnknown() {
+		return types.StringValue(importedSecret)
+	}
+	return stateValue
+}
+
+// setFromAPI sets the SSO configuration returned by dbt Cloud, the secrets are not returned and are kept from the state
+func (m *SSOConfigurationResourceModel) setFromAPI(ssoConfiguration *dbt_cloud.SSOConfiguration) {
+	m.LoginSlug = types.StringValue(ssoConfiguration.LoginSlug)
+	m.LoginURL = types.StringValue(ssoConfiguration.LoginURL)
+
+	switch ssoConfiguration.AuthenticationMethod {
+	case dbt_cloud.SSO_METHOD_SAML:
+		state := SAMLConfigModel{
+			MetadataURL: types.StringNull(),
+			MetadataXML: types.StringNull(),
+			Certificate: types.StringNull(),
+		}
+		if m.SAML != nil {
+			state = *m.SAML
+		}
+		m.SAML = &SAMLConfigModel{
+			MetadataURL: stringOrState(ssoConfiguration.MetadataURL, state.MetadataURL),
+			MetadataXML: stringOrState(ssoConfiguration.MetadataXML, state.MetadataXML),
+			Certificate: stringOrState(ssoConfiguration.Certificate, state.Certificate),
+		}
+		m.Okta = nil
+		m.EntraID = nil
+	case dbt_cloud.SSO_METHOD_OKTA:
+		clientSecret := types.StringNull()
+		if m.Okta != nil {
+			clientSecret = m.Okta.ClientSecret
+		}
+		m.Okta = &OktaConfigModel{
+			Domain:       types.StringValue(ssoConfiguration.Domain),
+			ClientID:     types.StringValue(ssoConfiguration.ClientID),
+			ClientSecret: secretFromState(clientSecret),
+		}
+		m.SAML = nil
+		m.EntraID = nil
+	case dbt_cloud.SSO_METHOD_ENTRA_ID:
+		clientSecret := types.StringNull()
+		if m.EntraID != nil {
+			clientSecret = m.EntraID.ClientSecret
+		}
+		m.EntraID = &EntraIDConfigModel{
+			Domain:       types.StringValue(ssoConfiguration.Domain),
+			TenantID:     types.StringValue(ssoConfiguration.TenantID),
+			ClientID:     types.StringValue(ssoConfiguration.ClientID),
+			ClientSecret: secretFromState(clientSecret),
+		}
+		m.SAML = nil
+		m.Okta = nil
+	}
+}
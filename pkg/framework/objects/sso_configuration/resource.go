@@ -0,0 +1,268 @@
+package sso_configuration
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &ssoConfigurationResource{}
+	_ resource.ResourceWithConfigure        = &ssoConfigurationResource{}
+	_ resource.ResourceWithImportState      = &ssoConfigurationResource{}
+	_ resource.ResourceWithConfigValidators = &ssoConfigurationResource{}
+	_ resource.ResourceWithModifyPlan       = &ssoConfigurationResource{}
+)
+
+// methodBlocks maps the SSO methods of dbt Cloud to the block used to configure them
+var methodBlocks = map[string]string{
+	dbt_cloud.SSO_METHOD_SAML:     "saml",
+	dbt_cloud.SSO_METHOD_OKTA:     "okta",
+	dbt_cloud.SSO_METHOD_ENTRA_ID: "entra_id",
+}
+
+func SSOConfigurationResource() resource.Resource {
+	return &ssoConfigurationResource{}
+}
+
+type ssoConfigurationResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *ssoConfigurationResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sso_configuration"
+}
+
+func (r *ssoConfigurationResource) ConfigValidators(
+	_ context.Context,
+) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("saml"),
+			path.MatchRoot("okta"),
+			path.MatchRoot("entra_id"),
+		),
+	}
+}
+
+// methodName returns a readable name for the SSO method
+func methodName(method string) string {
+	if block, ok := methodBlocks[method]; ok {
+		return block
+	}
+	return method
+}
+
+func (r *ssoConfigurationResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		// the resource is being deleted
+		return
+	}
+
+	var plan SSOConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *SSOConfigurationResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &SSOConfigurationResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !plan.LoginSlug.Equal(state.LoginSlug) {
+			resp.Diagnostics.Append(
+				resp.Plan.SetAttribute(ctx, path.Root("login_url"), types.StringUnknown())...,
+			)
+		}
+	}
+
+	plannedMethod := plan.authenticationMethod()
+	if plannedMethod == "" || plan.AllowMethodChange.ValueBool() {
+		return
+	}
+
+	currentMethod := ""
+	if state != nil {
+		currentMethod = state.authenticationMethod()
+	} else if r.client != nil {
+		// SSO might have been configured outside of Terraform
+		account, err := r.client.GetAccount()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to check the current SSO method of the account",
+				"Error: "+err.Error(),
+			)
+			return
+		}
+		currentMethod = account.EnterpriseAuthenticationMethod
+	}
+
+	// the accounts without SSO can have other authentication methods, like password, that we don't guard
+	if _, ok := methodBlocks[currentMethod]; !ok || currentMethod == plannedMethod {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root(methodBlocks[plannedMethod]),
+		"Changing the SSO method requires allow_method_change",
+		fmt.Sprintf(
+			"The account currently uses %s for SSO and the configuration uses %s. Changing the SSO method logs out all the users, and the SSO groups and license mappings need to match the new identity provider. Set `allow_method_change` to `true` to confirm the change.",
+			methodName(currentMethod),
+			methodName(plannedMethod),
+		),
+	)
+}
+
+func (r *ssoConfigurationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state SSOConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ssoConfiguration, err := r.client.GetSSOConfiguration()
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The SSO configuration was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error getting the SSO configuration", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%d", r.client.AccountID))
+	state.setFromAPI(ssoConfiguration)
+
+	// this is only used by the provider and is not set after an import
+	if state.AllowMethodChange.IsNull() {
+		state.AllowMethodChange = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ssoConfigurationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan SSOConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ssoConfiguration, err := r.client.UpdateSSOConfiguration(plan.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create the SSO configuration",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d", r.client.AccountID))
+	plan.LoginURL = types.StringValue(ssoConfiguration.LoginURL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ssoConfigurationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan SSOConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ssoConfiguration, err := r.client.UpdateSSOConfiguration(plan.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update the SSO configuration",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LoginURL = types.StringValue(ssoConfiguration.LoginURL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ssoConfigurationResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	err := r.client.DeleteSSOConfiguration()
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting the SSO configuration", err.Error())
+		return
+	}
+}
+
+func (r *ssoConfigurationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// the SSO configuration can only be managed for the account configured in the provider
+	if req.ID != strconv.Itoa(r.client.AccountID) {
+		resp.Diagnostics.AddError(
+			"Invalid account ID",
+			fmt.Sprintf(
+				"The SSO configuration can only be imported for the account configured in the provider, expected the ID %d but got %q",
+				r.client.AccountID,
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ssoConfigurationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,130 @@
+package sso_configuration_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudSSOConfigurationResource(t *testing.T) {
+
+	// changing the SSO configuration of the account logs out the users, so the test only runs on dedicated accounts
+	envVarSSOOkta, exists := os.LookupEnv("DBT_ACCEPTANCE_TEST_SSO_OKTA")
+	if !exists {
+		t.Skip(
+			"Skipping SSO configuration acceptance tests as the env var DBT_ACCEPTANCE_TEST_SSO_OKTA is not set",
+		)
+	}
+
+	oktaConfigs := strings.Split(envVarSSOOkta, "~")
+	if len(oktaConfigs) != 3 {
+		t.Fatalf(
+			"DBT_ACCEPTANCE_TEST_SSO_OKTA env var should be in the format: domain~client_id~client_secret",
+		)
+	}
+
+	oktaDomain := oktaConfigs[0]
+	oktaClientID := oktaConfigs[1]
+	oktaClientSecret := oktaConfigs[2]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudSSOConfigurationResourceOktaConfig(
+					"tf-acc-okta",
+					oktaDomain,
+					oktaClientID,
+					oktaClientSecret,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_sso_configuration.test",
+						"okta.domain",
+						oktaDomain,
+					),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_sso_configuration.test",
+						"login_url",
+					),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_account.test",
+						"enterprise_authentication_method",
+						"okta",
+					),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudSSOConfigurationResourceOktaConfig(
+					"tf-acc-okta-updated",
+					oktaDomain,
+					oktaClientID,
+					oktaClientSecret,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_sso_configuration.test",
+						"login_slug",
+						"tf-acc-okta-updated",
+					),
+				),
+			},
+			// switching to SAML is refused without the break-glass flag
+			{
+				Config:      testAccDbtCloudSSOConfigurationResourceSAMLConfig(),
+				ExpectError: regexp.MustCompile("requires allow_method_change"),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbtcloud_sso_configuration.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"okta.client_secret"},
+			},
+			// the SSO configuration of another account can't be imported
+			{
+				ResourceName:  "dbtcloud_sso_configuration.test",
+				ImportState:   true,
+				ImportStateId: "999999999",
+				ExpectError:   regexp.MustCompile("Invalid account ID"),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudSSOConfigurationResourceOktaConfig(
+	loginSlug, domain, clientID, clientSecret string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_sso_configuration" "test" {
+  login_slug = "%s"
+  okta = {
+    domain        = "%s"
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+}
+
+data "dbtcloud_account" "test" {
+  depends_on = [dbtcloud_sso_configuration.test]
+}
+`, loginSlug, domain, clientID, clientSecret)
+}
+
+func testAccDbtCloudSSOConfigurationResourceSAMLConfig() string {
+	return `
+resource "dbtcloud_sso_configuration" "test" {
+  login_slug = "tf-acc-okta-updated"
+  saml = {
+    metadata_url = "https://idp.example.com/metadata.xml"
+  }
+}
+`
+}
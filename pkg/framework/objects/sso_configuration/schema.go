@@ -0,0 +1,131 @@
+package sso_configuration
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *ssoConfigurationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: helper.DocString(
+			`Manages the SSO configuration of the dbt Cloud account, with SAML 2.0, Okta or Microsoft Entra ID configured in the ~~~saml~~~, ~~~okta~~~ or ~~~entra_id~~~ block.
+
+			There is only one SSO configuration per account, so this resource should only be defined once. Changing the SSO method (e.g. from Okta to Microsoft Entra ID) logs out all the users and requires the groups and license mappings to match the new identity provider, so it is only allowed when ~~~allow_method_change~~~ is set to ~~~true~~~. The same check is done when creating the resource for an account that already uses a different SSO method.
+
+			Destroying the resource disables SSO for the account.
+
+			The resource can be imported with the ID of the account configured in the provider.`,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"login_slug": schema.StringAttribute{
+				Required:    true,
+				Description: "The slug used in the SSO login URL of the account, with lowercase letters, digits and dashes",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+						"must only contain lowercase letters, digits and dashes",
+					),
+				},
+			},
+			"login_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL the users can use to log in to dbt Cloud with SSO",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_method_change": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Break-glass flag to allow switching the SSO method of the account, for example from `okta` to `entra_id` - Defaults to `false`",
+			},
+			"saml": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "SAML 2.0 configuration",
+				Attributes: map[string]schema.Attribute{
+					"metadata_url": schema.StringAttribute{
+						Optional:    true,
+						Description: "The URL of the metadata of the identity provider. Conflicts with `metadata_xml`",
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("metadata_xml"),
+							),
+						},
+					},
+					"metadata_xml": schema.StringAttribute{
+						Optional:    true,
+						Description: "The XML metadata of the identity provider. Conflicts with `metadata_url`",
+					},
+					"certificate": schema.StringAttribute{
+						Optional:    true,
+						Description: "The X.509 certificate, in PEM format, used by the identity provider to sign the SAML responses, when it is not part of the metadata",
+					},
+				},
+			},
+			"okta": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Okta configuration",
+				Attributes: map[string]schema.Attribute{
+					"domain": schema.StringAttribute{
+						Required:    true,
+						Description: "The Okta domain of the organization, e.g. `my-org.okta.com`",
+					},
+					"client_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The client ID of the Okta application",
+					},
+					"client_secret": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "The client secret of the Okta application",
+					},
+				},
+			},
+			"entra_id": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Microsoft Entra ID (formerly Azure AD) single tenant configuration",
+				Attributes: map[string]schema.Attribute{
+					"domain": schema.StringAttribute{
+						Required:    true,
+						Description: "The domain of the Microsoft Entra ID tenant, e.g. `my-org.onmicrosoft.com`",
+					},
+					"tenant_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The ID of the Microsoft Entra ID tenant",
+					},
+					"client_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The client ID of the Microsoft Entra ID application",
+					},
+					"client_secret": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "The client secret of the Microsoft Entra ID application",
+					},
+				},
+			},
+		},
+	}
+}
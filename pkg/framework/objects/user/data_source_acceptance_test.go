@@ -11,7 +11,13 @@ import (
 func TestAccDbtCloudUserDataSource(t *testing.T) {
 
 	var userEmail string
-	if acctest_helper.IsDbtCloudPR() {
+	if acctest_helper.IsFakeAPI() {
+		server, err := acctest_helper.FakeAPI()
+		if err != nil {
+			t.Fatalf("Error starting the fake dbt Cloud API: %s", err)
+		}
+		userEmail = server.UserEmail
+	} else if acctest_helper.IsDbtCloudPR() {
 		userEmail = "d" + "ev@" + "db" + "tla" + "bs.c" + "om"
 	} else {
 		userEmail = "beno" + "it" + ".per" + "igaud" + "@" + "fisht" + "ownanalytics" + "." + "com"
@@ -25,6 +31,7 @@ func TestAccDbtCloudUserDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
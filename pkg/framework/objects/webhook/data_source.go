@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &webhookDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookDataSource{}
+)
+
+func WebhookDataSource() datasource.DataSource {
+	return &webhookDataSource{}
+}
+
+type webhookDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *webhookDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (d *webhookDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state WebhookDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := d.client.GetWebhook(state.WebhookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get webhook", err.Error())
+		return
+	}
+
+	var resourceModel WebhookResourceModel
+	resp.Diagnostics.Append(setResourceModelFromData(ctx, &resourceModel, webhook)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = resourceModel.ID
+	state.WebhookID = resourceModel.WebhookID
+	state.Name = resourceModel.Name
+	state.Description = resourceModel.Description
+	state.ClientURL = resourceModel.ClientURL
+	state.EventTypes = resourceModel.EventTypes
+	state.JobIDs = resourceModel.JobIDs
+	state.Active = resourceModel.Active
+	state.HTTPStatusCode = resourceModel.HTTPStatusCode
+	state.AccountIdentifier = resourceModel.AccountIdentifier
+
+	if state.HTTPStatusCode.IsNull() {
+		state.HTTPStatusCode = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *webhookDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -1,4 +1,4 @@
-package data_sources_test
+package webhook_test
 
 import (
 	"fmt"
@@ -11,11 +11,11 @@ import (
 
 func TestDbtCloudWebhookDataSource(t *testing.T) {
 
-	if isDbtCloudPR() {
+	if acctest_helper.IsDbtCloudPR() {
 		t.Skip("Skipping webhooks acceptance in dbt Cloud CI for now")
 	}
 
-	randomWebhookName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	randomWebhookName := acctest_helper.RandomName()
 	randomWebhookDescription := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
 
 	config := webhooks(randomWebhookName, randomWebhookDescription)
@@ -36,6 +36,7 @@ func TestDbtCloudWebhookDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var (
+	_ datasource.DataSource              = &webhooksDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhooksDataSource{}
+)
+
+func WebhooksDataSource() datasource.DataSource {
+	return &webhooksDataSource{}
+}
+
+type webhooksDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *webhooksDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks"
+}
+
+func (d *webhooksDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state WebhooksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	apiWebhooks, err := d.client.GetAllWebhooks()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue when retrieving webhooks",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Webhooks = []WebhooksDataSourceWebhook{}
+	for _, webhook := range apiWebhooks {
+		var resourceModel WebhookResourceModel
+		resp.Diagnostics.Append(setResourceModelFromData(ctx, &resourceModel, &webhook)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Webhooks = append(state.Webhooks, WebhooksDataSourceWebhook{
+			WebhookID:         resourceModel.WebhookID,
+			Name:              resourceModel.Name,
+			Description:       resourceModel.Description,
+			ClientURL:         resourceModel.ClientURL,
+			EventTypes:        resourceModel.EventTypes,
+			JobIDs:            resourceModel.JobIDs,
+			Active:            resourceModel.Active,
+			HTTPStatusCode:    resourceModel.HTTPStatusCode,
+			AccountIdentifier: resourceModel.AccountIdentifier,
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *webhooksDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
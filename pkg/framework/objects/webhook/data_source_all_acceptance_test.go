@@ -0,0 +1,56 @@
+package webhook_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDbtCloudWebhooksDataSource(t *testing.T) {
+
+	if acctest_helper.IsDbtCloudPR() {
+		t.Skip("Skipping webhooks acceptance in dbt Cloud CI for now")
+	}
+
+	randomWebhookName := acctest_helper.RandomName()
+
+	config := fmt.Sprintf(`
+    resource "dbtcloud_webhook" "test_webhook" {
+        name = "%s"
+        client_url = "http://localhost/nothing"
+        event_types = [
+            "job.run.errored"
+        ]
+    }
+
+    data "dbtcloud_webhooks" "test" {
+        depends_on = [dbtcloud_webhook.test_webhook]
+    }
+    `, randomWebhookName)
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet("data.dbtcloud_webhooks.test", "webhooks.#"),
+		resource.TestCheckTypeSetElemNestedAttrs(
+			"data.dbtcloud_webhooks.test",
+			"webhooks.*",
+			map[string]string{
+				"name":          randomWebhookName,
+				"event_types.#": "1",
+				"event_types.0": "job.run.errored",
+			},
+		),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
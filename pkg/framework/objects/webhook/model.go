@@ -0,0 +1,129 @@
+package webhook
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type WebhookResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	WebhookID           types.String `tfsdk:"webhook_id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	ClientURL           types.String `tfsdk:"client_url"`
+	EventTypes          types.List   `tfsdk:"event_types"`
+	JobIDs              types.List   `tfsdk:"job_ids"`
+	Active              types.Bool   `tfsdk:"active"`
+	HmacSecret          types.String `tfsdk:"hmac_secret"`
+	HTTPStatusCode      types.String `tfsdk:"http_status_code"`
+	AccountIdentifier   types.String `tfsdk:"account_identifier"`
+	RotateSecretTrigger types.String `tfsdk:"rotate_secret_trigger"`
+	TestOnApply         types.Bool   `tfsdk:"test_on_apply"`
+}
+
+type WebhookDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	WebhookID         types.String `tfsdk:"webhook_id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	ClientURL         types.String `tfsdk:"client_url"`
+	EventTypes        types.List   `tfsdk:"event_types"`
+	JobIDs            types.List   `tfsdk:"job_ids"`
+	Active            types.Bool   `tfsdk:"active"`
+	HTTPStatusCode    types.String `tfsdk:"http_status_code"`
+	AccountIdentifier types.String `tfsdk:"account_identifier"`
+}
+
+type WebhooksDataSourceModel struct {
+	Webhooks []WebhooksDataSourceWebhook `tfsdk:"webhooks"`
+}
+
+type WebhooksDataSourceWebhook struct {
+	WebhookID         types.String `tfsdk:"webhook_id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	ClientURL         types.String `tfsdk:"client_url"`
+	EventTypes        types.List   `tfsdk:"event_types"`
+	JobIDs            types.List   `tfsdk:"job_ids"`
+	Active            types.Bool   `tfsdk:"active"`
+	HTTPStatusCode    types.String `tfsdk:"http_status_code"`
+	AccountIdentifier types.String `tfsdk:"account_identifier"`
+}
+
+// the API returns the job IDs as strings but we store them as integers
+func convertJobIDsToList(ctx context.Context, jobIDs []string) (types.List, diag.Diagnostics) {
+	jobIDsInt := make([]int64, len(jobIDs))
+	for i, jobID := range jobIDs {
+		jobIDInt, _ := strconv.Atoi(jobID)
+		jobIDsInt[i] = int64(jobIDInt)
+	}
+	return types.ListValueFrom(ctx, types.Int64Type, jobIDsInt)
+}
+
+func convertEventTypesToList(ctx context.Context, eventTypes []string) (types.List, diag.Diagnostics) {
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	return types.ListValueFrom(ctx, types.StringType, eventTypes)
+}
+
+func ConvertWebhookModelToData(
+	ctx context.Context,
+	model WebhookResourceModel,
+) (dbt_cloud.WebhookWrite, diag.Diagnostics) {
+	allDiags := diag.Diagnostics{}
+
+	var eventTypes []string
+	allDiags.Append(model.EventTypes.ElementsAs(ctx, &eventTypes, false)...)
+
+	var jobIDs []int
+	if !model.JobIDs.IsNull() && !model.JobIDs.IsUnknown() {
+		allDiags.Append(model.JobIDs.ElementsAs(ctx, &jobIDs, false)...)
+	}
+	if jobIDs == nil {
+		jobIDs = []int{}
+	}
+
+	return dbt_cloud.WebhookWrite{
+		WebhookId:   model.WebhookID.ValueString(),
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		ClientUrl:   model.ClientURL.ValueString(),
+		EventTypes:  eventTypes,
+		JobIds:      jobIDs,
+		Active:      model.Active.ValueBool(),
+	}, allDiags
+}
+
+// setResourceModelFromData fills all the fields returned by the API, the HMAC secret is only set if returned
+func setResourceModelFromData(
+	ctx context.Context,
+	model *WebhookResourceModel,
+	webhook *dbt_cloud.WebhookRead,
+) diag.Diagnostics {
+	allDiags := diag.Diagnostics{}
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(webhook.WebhookId)
+	model.WebhookID = types.StringValue(webhook.WebhookId)
+	model.Name = types.StringValue(webhook.Name)
+	model.Description = types.StringValue(webhook.Description)
+	model.ClientURL = types.StringValue(webhook.ClientUrl)
+	model.EventTypes, diags = convertEventTypesToList(ctx, webhook.EventTypes)
+	allDiags.Append(diags...)
+	model.JobIDs, diags = convertJobIDsToList(ctx, webhook.JobIds)
+	allDiags.Append(diags...)
+	model.Active = types.BoolValue(webhook.Active)
+	model.HTTPStatusCode = types.StringPointerValue(webhook.HttpStatusCode)
+	model.AccountIdentifier = types.StringPointerValue(webhook.AccountIdentifier)
+
+	if webhook.HmacSecret != nil {
+		model.HmacSecret = types.StringPointerValue(webhook.HmacSecret)
+	}
+
+	return allDiags
+}
@@ -0,0 +1,304 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &webhookResource{}
+	_ resource.ResourceWithConfigure   = &webhookResource{}
+	_ resource.ResourceWithImportState = &webhookResource{}
+	_ resource.ResourceWithModifyPlan  = &webhookResource{}
+)
+
+func WebhookResource() resource.Resource {
+	return &webhookResource{}
+}
+
+type webhookResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *webhookResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+// isSecretRotationRequested returns true when the trigger has been changed to a new non-empty value
+func isSecretRotationRequested(plan, state WebhookResourceModel) bool {
+	return !plan.RotateSecretTrigger.IsNull() &&
+		!plan.RotateSecretTrigger.IsUnknown() &&
+		plan.RotateSecretTrigger.ValueString() != "" &&
+		!plan.RotateSecretTrigger.Equal(state.RotateSecretTrigger)
+}
+
+func (r *webhookResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var plan, state WebhookResourceModel
+
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		// we only check when both plan and state are not null
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isSecretRotationRequested(plan, state) {
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("hmac_secret"), types.StringUnknown())...,
+		)
+	}
+}
+
+func (r *webhookResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state WebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookID := state.ID.ValueString()
+	webhook, err := r.client.GetWebhook(webhookID)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The webhook resource was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error getting the webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setResourceModelFromData(ctx, &state, webhook)...)
+
+	// those are not returned by the API and are null after an import
+	if state.TestOnApply.IsNull() {
+		state.TestOnApply = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *webhookResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan WebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookWrite, diags := ConvertWebhookModelToData(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := r.client.CreateWebhook(
+		"",
+		webhookWrite.Name,
+		webhookWrite.Description,
+		webhookWrite.ClientUrl,
+		webhookWrite.EventTypes,
+		webhookWrite.JobIds,
+		webhookWrite.Active,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create webhook", "Error: "+err.Error())
+		return
+	}
+
+	// the HMAC secret is only returned at creation
+	plan.HmacSecret = types.StringPointerValue(webhook.HmacSecret)
+	resp.Diagnostics.Append(setResourceModelFromData(ctx, &plan, webhook)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the webhook needs to exist to be tested, so a failed test happens after it is saved in the state
+	// Terraform then marks it as tainted and replaces it on the next apply, with a new hmac_secret
+	if plan.TestOnApply.ValueBool() {
+		testDiags := r.testWebhook(webhook.WebhookId, webhook.ClientUrl)
+		if testDiags.HasError() {
+			testDiags.AddWarning(
+				"The webhook was created but is tainted",
+				"The webhook will be replaced on the next apply, which generates a new `hmac_secret`. "+
+					"Run `terraform untaint` on the resource to keep the webhook and its secret instead.",
+			)
+		}
+		resp.Diagnostics.Append(testDiags...)
+	}
+}
+
+func (r *webhookResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state WebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookID := state.ID.ValueString()
+	plan.ID = state.ID
+	plan.WebhookID = state.WebhookID
+
+	webhookWrite, diags := ConvertWebhookModelToData(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := r.client.UpdateWebhook(webhookID, webhookWrite)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update webhook", "Error: "+err.Error())
+		return
+	}
+
+	// we keep the existing secret unless we rotate it
+	plan.HmacSecret = state.HmacSecret
+	if isSecretRotationRequested(plan, state) {
+		webhookRotated, err := r.client.RegenerateWebhookSecret(webhookID)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to rotate the webhook secret", "Error: "+err.Error())
+			return
+		}
+		if webhookRotated.HmacSecret == nil {
+			resp.Diagnostics.AddError(
+				"Unable to rotate the webhook secret",
+				"The new secret was not returned by dbt Cloud",
+			)
+			return
+		}
+		plan.HmacSecret = types.StringPointerValue(webhookRotated.HmacSecret)
+	}
+
+	resp.Diagnostics.Append(setResourceModelFromData(ctx, &plan, webhook)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.TestOnApply.ValueBool() {
+		resp.Diagnostics.Append(r.testWebhook(webhookID, webhook.ClientUrl)...)
+	}
+}
+
+func (r *webhookResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state WebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteWebhook(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting the webhook", err.Error())
+		return
+	}
+}
+
+// testWebhook sends a test event and returns an error if the endpoint didn't answer with a 2xx status
+func (r *webhookResource) testWebhook(webhookID string, clientURL string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	webhookTest, err := r.client.TestWebhook(webhookID)
+	if err != nil {
+		diags.AddError("Unable to test the webhook", "Error: "+err.Error())
+		return diags
+	}
+
+	statusCode := 0
+	if webhookTest.VerificationStatusCode != nil {
+		statusCode, _ = strconv.Atoi(*webhookTest.VerificationStatusCode)
+	}
+
+	if statusCode < 200 || statusCode >= 300 ||
+		(webhookTest.VerificationError != nil && *webhookTest.VerificationError != "") {
+
+		verificationError := ""
+		if webhookTest.VerificationError != nil {
+			verificationError = *webhookTest.VerificationError
+		}
+
+		diags.AddAttributeError(
+			path.Root("test_on_apply"),
+			"Webhook delivery test failed",
+			fmt.Sprintf(
+				"The test event sent to %s returned the HTTP status %d. %s",
+				clientURL,
+				statusCode,
+				verificationError,
+			),
+		)
+	}
+	return diags
+}
+
+func (r *webhookResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *webhookResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
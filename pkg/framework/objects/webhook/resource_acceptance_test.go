@@ -1,28 +1,29 @@
-package resources_test
+package webhook_test
 
 import (
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/fake_api"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDbtCloudWebhookResource(t *testing.T) {
 
-	if isDbtCloudPR() {
+	if acctest_helper.IsDbtCloudPR() {
 		t.Skip("Skipping webhooks acceptance in dbt Cloud CI for now")
 	}
 
-	webhookName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
-	webhookName2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
-	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	webhookName := acctest_helper.RandomName()
+	webhookName2 := acctest_helper.RandomName()
+	projectName := acctest_helper.RandomName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudWebhookDestroy,
 		Steps: []resource.TestStep{
@@ -95,6 +96,22 @@ func TestAccDbtCloudWebhookResource(t *testing.T) {
 					),
 				),
 			},
+			// ROTATE SECRET
+			{
+				Config: testAccDbtCloudWebhookResourceRotateConfig(webhookName2, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudWebhookExists("dbtcloud_webhook.test_webhook"),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_webhook.test_webhook",
+						"hmac_secret",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_webhook.test_webhook",
+						"rotate_secret_trigger",
+						"2024-01-01",
+					),
+				),
+			},
 			// IMPORT
 			{
 				ResourceName:      "dbtcloud_webhook.test_webhook",
@@ -102,12 +119,87 @@ func TestAccDbtCloudWebhookResource(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"hmac_secret",
+					"rotate_secret_trigger",
 				},
 			},
 		},
 	})
 }
 
+func TestAccDbtCloudWebhookResourceTestOnApplyFailure(t *testing.T) {
+
+	// the test events are only failed on demand by the fake API
+	if !acctest_helper.IsFakeAPI() {
+		t.Skip("Skipping as the failure of the test event is only simulated by the fake API")
+	}
+	server, err := acctest_helper.FakeAPI()
+	if err != nil {
+		t.Fatalf("Error starting the fake dbt Cloud API: %s", err)
+	}
+
+	webhookName := acctest_helper.RandomName()
+	var failedWebhook map[string]any
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudWebhookDestroy,
+		Steps: []resource.TestStep{
+			// the webhook is created and saved in the state before the failed test, which taints it
+			{
+				Config:      testAccDbtCloudWebhookResourceTestOnApplyConfig(webhookName, fake_api.FailingWebhookURL),
+				ExpectError: regexp.MustCompile("Webhook delivery test failed"),
+			},
+			// the next apply replaces the tainted webhook, with a new HMAC secret
+			{
+				PreConfig: func() {
+					for _, webhook := range server.Objects("webhooks/subscriptions") {
+						if webhook["name"] == webhookName {
+							failedWebhook = webhook
+						}
+					}
+					if failedWebhook == nil {
+						t.Fatalf("the webhook with the failed test was not created")
+					}
+				},
+				Config: testAccDbtCloudWebhookResourceTestOnApplyConfig(webhookName, "http://localhost/nothing"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_webhook.test_webhook",
+							plancheck.ResourceActionReplace,
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudWebhookExists("dbtcloud_webhook.test_webhook"),
+					func(state *terraform.State) error {
+						webhook := state.RootModule().Resources["dbtcloud_webhook.test_webhook"].Primary
+						if webhook.ID == failedWebhook["id"] {
+							return fmt.Errorf("the webhook %s was not replaced", webhook.ID)
+						}
+						if webhook.Attributes["hmac_secret"] == failedWebhook["hmac_secret"] {
+							return fmt.Errorf("the replacement webhook has the same HMAC secret")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudWebhookResourceTestOnApplyConfig(webhookName, clientURL string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_webhook" "test_webhook" {
+  name          = "%s"
+  client_url    = "%s"
+  event_types   = ["job.run.completed"]
+  test_on_apply = true
+}
+`, webhookName, clientURL)
+}
+
 func testAccDbtCloudWebhookResourceBasicConfig(webhookName, projectName string) string {
 	return fmt.Sprintf(`
 resource "dbtcloud_project" "test_project" {
@@ -163,7 +255,24 @@ resource "dbtcloud_webhook" "test_webhook" {
 	]
 	job_ids = [dbtcloud_job.test.id]
   }
-`, projectName, DBT_CLOUD_VERSION, webhookName)
+`, projectName, acctest_helper.DBT_CLOUD_VERSION, webhookName)
+}
+
+func testAccDbtCloudWebhookResourceRotateConfig(webhookName, projectName string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+resource "dbtcloud_webhook" "test_webhook" {
+	name = "%s"
+	description = "My webhook"
+	client_url = "http://localhost/new-nothing"
+	event_types = [
+	  "job.run.completed"
+	]
+	rotate_secret_trigger = "2024-01-01"
+  }
+`, projectName, webhookName)
 }
 
 func testAccCheckDbtCloudWebhookExists(resource string) resource.TestCheckFunc {
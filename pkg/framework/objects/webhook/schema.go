@@ -0,0 +1,238 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	eventTypes = []string{
+		"job.run.completed",
+		"job.run.started",
+		"job.run.errored",
+	}
+)
+
+func (r *webhookResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resource_schema.Schema{
+		Description: "Manage webhooks sending events about job runs to external systems",
+		Attributes: map[string]resource_schema.Attribute{
+			"id": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the webhook",
+				// this is used so that we don't show that ID is going to change
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"webhook_id": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "Webhooks ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": resource_schema.StringAttribute{
+				Required:    true,
+				Description: "Webhooks Name",
+			},
+			"description": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Webhooks Description",
+			},
+			"client_url": resource_schema.StringAttribute{
+				Required:    true,
+				Description: "Webhooks Client URL",
+			},
+			"event_types": resource_schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Webhooks Event Types",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf(eventTypes...),
+					),
+				},
+			},
+			"job_ids": resource_schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Default:     helper.EmptyListDefault(types.Int64Type),
+				Description: "List of job IDs to trigger the webhook, An empty list will trigger on all jobs",
+			},
+			"active": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Webhooks active flag",
+			},
+			"hmac_secret": resource_schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Secret key for the webhook. Can be used to validate the authenticity of the webhook. The value is only returned by dbt Cloud when the webhook is created or when the secret is rotated with `rotate_secret_trigger`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"http_status_code": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "Latest HTTP status of the webhook",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_identifier": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "Webhooks Account Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_secret_trigger": resource_schema.StringAttribute{
+				Optional: true,
+				Description: helper.DocString(
+					`Arbitrary value that triggers the rotation of ~~~hmac_secret~~~ when it is changed to a new non-empty value (e.g. a date or a version number).
+					The previous secret stops being valid as soon as the new one is generated.`,
+				),
+			},
+			"test_on_apply": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to send a test event to the `client_url` after the webhook is created or updated. The apply fails with the HTTP status returned by the endpoint if the delivery fails. When the test of a new webhook fails, the webhook is created but tainted, and the next apply replaces it with a new `hmac_secret` - Defaults to `false`",
+			},
+		},
+	}
+}
+
+func (d *webhookDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasource_schema.Schema{
+		Description: "Retrieve webhook details",
+		Attributes: map[string]datasource_schema.Attribute{
+			"id": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the webhook",
+			},
+			"webhook_id": datasource_schema.StringAttribute{
+				Required:    true,
+				Description: "Webhooks ID",
+			},
+			"name": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "Webhooks Name",
+			},
+			"description": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "Webhooks Description",
+			},
+			"client_url": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "Webhooks Client URL",
+			},
+			"event_types": datasource_schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Webhooks Event Types",
+			},
+			"job_ids": datasource_schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "List of job IDs to trigger the webhook",
+			},
+			"active": datasource_schema.BoolAttribute{
+				Computed:    true,
+				Description: "Webhooks active flag",
+			},
+			"http_status_code": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "Webhooks HTTP Status Code",
+			},
+			"account_identifier": datasource_schema.StringAttribute{
+				Computed:    true,
+				Description: "Webhooks Account Identifier",
+			},
+		},
+	}
+}
+
+func (d *webhooksDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasource_schema.Schema{
+		Description: "Retrieve all the webhooks of the dbt Cloud account",
+		Attributes: map[string]datasource_schema.Attribute{
+			"webhooks": datasource_schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "Set of webhooks with their details",
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"webhook_id": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Webhooks ID",
+						},
+						"name": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Webhooks Name",
+						},
+						"description": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Webhooks Description",
+						},
+						"client_url": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Webhooks Client URL",
+						},
+						"event_types": datasource_schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Webhooks Event Types",
+						},
+						"job_ids": datasource_schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.Int64Type,
+							Description: "List of job IDs to trigger the webhook",
+						},
+						"active": datasource_schema.BoolAttribute{
+							Computed:    true,
+							Description: "Webhooks active flag",
+						},
+						"http_status_code": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Webhooks HTTP Status Code",
+						},
+						"account_identifier": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Webhooks Account Identifier",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package helper
+
+import (
+	"net/url"
+	"strings"
+)
+
+// GitRepositoryPath returns the path of a repository (e.g. org/repo) from its HTTPS or SSH clone URL
+// values that are already a path are returned without the .git suffix
+func GitRepositoryPath(remoteURL string) string {
+	repositoryPath := strings.TrimSpace(remoteURL)
+
+	if parsedURL, err := url.Parse(repositoryPath); err == nil && parsedURL.Host != "" {
+		// https://github.com/org/repo.git or ssh://git@gitlab.com/group/project.git
+		repositoryPath = parsedURL.Path
+	} else if at := strings.Index(repositoryPath, "@"); at != -1 {
+		// git@github.com:org/repo.git
+		if colon := strings.Index(repositoryPath[at:], ":"); colon != -1 {
+			repositoryPath = repositoryPath[at+colon+1:]
+		}
+	}
+
+	repositoryPath = strings.Trim(repositoryPath, "/")
+	return strings.TrimSuffix(repositoryPath, ".git")
+}
@@ -0,0 +1,45 @@
+package helper
+
+import "testing"
+
+func TestGitRepositoryPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		remoteURL    string
+		expectedPath string
+	}{
+		{
+			name:         "SSH URL",
+			remoteURL:    "git@github.com:dbt-labs/jaffle_shop.git",
+			expectedPath: "dbt-labs/jaffle_shop",
+		},
+		{
+			name:         "SSH URL with a scheme",
+			remoteURL:    "ssh://git@gitlab.com/group/subgroup/project.git",
+			expectedPath: "group/subgroup/project",
+		},
+		{
+			name:         "HTTPS URL",
+			remoteURL:    "https://github.com/dbt-labs/jaffle_shop",
+			expectedPath: "dbt-labs/jaffle_shop",
+		},
+		{
+			name:         "HTTPS URL with a trailing slash",
+			remoteURL:    "https://gitlab.com/group/project/",
+			expectedPath: "group/project",
+		},
+		{
+			name:         "path",
+			remoteURL:    "group/project",
+			expectedPath: "group/project",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if path := GitRepositoryPath(testCase.remoteURL); path != testCase.expectedPath {
+			t.Errorf("%s: expected %s, got %s", testCase.name, testCase.expectedPath, path)
+		}
+	}
+}
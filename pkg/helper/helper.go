@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -19,6 +20,15 @@ func EmptySetDefault(elemType attr.Type) defaults.Set {
 	)
 }
 
+func EmptyListDefault(elemType attr.Type) defaults.List {
+	return listdefault.StaticValue(
+		types.ListValueMust(
+			elemType,
+			[]attr.Value{},
+		),
+	)
+}
+
 func IntPointerToInt64Pointer(value *int) *int64 {
 	if value == nil {
 		return nil
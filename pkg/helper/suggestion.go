@@ -0,0 +1,64 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+)
+
+// levenshteinDistance returns the number of single character edits needed to change a into b
+func levenshteinDistance(a, b string) int {
+	aRunes := []rune(a)
+	bRunes := []rune(b)
+
+	previousRow := make([]int, len(bRunes)+1)
+	for j := range previousRow {
+		previousRow[j] = j
+	}
+
+	for i := 1; i <= len(aRunes); i++ {
+		currentRow := make([]int, len(bRunes)+1)
+		currentRow[0] = i
+		for j := 1; j <= len(bRunes); j++ {
+			substitutionCost := 1
+			if aRunes[i-1] == bRunes[j-1] {
+				substitutionCost = 0
+			}
+			currentRow[j] = min(
+				previousRow[j]+1,
+				currentRow[j-1]+1,
+				previousRow[j-1]+substitutionCost,
+			)
+		}
+		previousRow = currentRow
+	}
+
+	return previousRow[len(bRunes)]
+}
+
+// ClosestMatch returns the candidate closest to value, ignoring the case
+// it returns false when no candidate is close enough to be a likely typo of value
+func ClosestMatch(value string, candidates []string) (string, bool) {
+	// we accept 1 edit for short values and up to a third of the length for longer ones
+	maxDistance := max(1, len(value)/3)
+
+	closest := ""
+	closestDistance := maxDistance + 1
+	for _, candidate := range candidates {
+		distance := levenshteinDistance(strings.ToLower(value), strings.ToLower(candidate))
+		if distance < closestDistance {
+			closest = candidate
+			closestDistance = distance
+		}
+	}
+
+	return closest, closestDistance <= maxDistance
+}
+
+// DidYouMean returns a hint with the closest candidate to value, or an empty string if none is close enough
+func DidYouMean(value string, candidates []string) string {
+	closest, ok := ClosestMatch(value, candidates)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("Did you mean `%s`?", closest)
+}
@@ -0,0 +1,63 @@
+package helper
+
+import (
+	"testing"
+)
+
+func TestClosestMatch(t *testing.T) {
+	t.Parallel()
+
+	candidates := []string{"threads", "schema", "database", "warehouse", "role"}
+
+	testCases := []struct {
+		name          string
+		value         string
+		expected      string
+		expectedFound bool
+	}{
+		{
+			name:          "missing letter",
+			value:         "treads",
+			expected:      "threads",
+			expectedFound: true,
+		},
+		{
+			name:          "swapped letters",
+			value:         "warehosue",
+			expected:      "warehouse",
+			expectedFound: true,
+		},
+		{
+			name:          "different case",
+			value:         "Schema",
+			expected:      "schema",
+			expectedFound: true,
+		},
+		{
+			name:          "short value",
+			value:         "rol",
+			expected:      "role",
+			expectedFound: true,
+		},
+		{
+			name:          "nothing close",
+			value:         "query_tag",
+			expected:      "",
+			expectedFound: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		closest, found := ClosestMatch(testCase.value, candidates)
+		if found != testCase.expectedFound || (found && closest != testCase.expected) {
+			t.Errorf(
+				"%s: expected %q (%t), got %q (%t)",
+				testCase.name,
+				testCase.expected,
+				testCase.expectedFound,
+				closest,
+				found,
+			)
+		}
+	}
+}
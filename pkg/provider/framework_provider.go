@@ -2,25 +2,41 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"strconv"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/account"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/account_features"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/audit_log"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/connection_ssh_tunnel"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/connection_validation"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/environment"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/extended_attributes"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/github_installation"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/gitlab_project"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/global_connection"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/group"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/group_partial_permissions"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/ip_restrictions_rule"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/ip_restrictions_settings"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/job"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/license_maps"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/lineage_integration"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/notification"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/oauth_configuration"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/partial_license_map"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/partial_notification"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/privatelink_endpoint"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/project"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/repository"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/service_token"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/service_token_partial_permissions"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/slack_channel"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/sso_configuration"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/user"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/webhook"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -39,7 +55,15 @@ func New() provider.Provider {
 	return &dbtCloudProvider{}
 }
 
-type dbtCloudProvider struct{}
+// NewWithTransport returns the provider with a client sending the requests with transport, e.g. to record them
+// in the acceptance tests
+func NewWithTransport(transport http.RoundTripper) provider.Provider {
+	return &dbtCloudProvider{transport: transport}
+}
+
+type dbtCloudProvider struct {
+	transport http.RoundTripper
+}
 
 func (p *dbtCloudProvider) Metadata(
 	_ context.Context,
@@ -162,7 +186,7 @@ func (p *dbtCloudProvider) Configure(
 		return
 	}
 
-	client, err := dbt_cloud.NewClient(&accountID, &token, &hostURL)
+	client, err := dbt_cloud.NewClientWithTransport(&accountID, &token, &hostURL, p.transport)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create dbt Cloud API Client",
@@ -192,6 +216,19 @@ func (p *dbtCloudProvider) DataSources(_ context.Context) []func() datasource.Da
 		project.ProjectsDataSource,
 		global_connection.GlobalConnectionDataSource,
 		global_connection.GlobalConnectionsDataSource,
+		slack_channel.SlackChannelsDataSource,
+		webhook.WebhookDataSource,
+		webhook.WebhooksDataSource,
+		ip_restrictions_rule.IPRestrictionsRulesDataSource,
+		connection_validation.ConnectionTestDataSource,
+		privatelink_endpoint.PrivatelinkEndpointsDataSource,
+		github_installation.GitHubInstallationsDataSource,
+		gitlab_project.GitlabProjectDataSource,
+		lineage_integration.LineageIntegrationsDataSource,
+		oauth_configuration.OAuthConfigurationsDataSource,
+		account_features.AccountFeaturesDataSource,
+		account.AccountDataSource,
+		audit_log.AuditLogsDataSource,
 	}
 }
 
@@ -201,12 +238,21 @@ func (p *dbtCloudProvider) Resources(_ context.Context) []func() resource.Resour
 		group_partial_permissions.GroupPartialPermissionsResource,
 		partial_notification.PartialNotificationResource,
 		partial_license_map.PartialLicenseMapResource,
+		license_maps.LicenseMapsResource,
 		group.GroupResource,
 		service_token.ServiceTokenResource,
+		service_token_partial_permissions.ServiceTokenPartialPermissionsResource,
 		global_connection.GlobalConnectionResource,
+		connection_ssh_tunnel.ConnectionSSHTunnelResource,
+		extended_attributes.ExtendedAttributesResource,
+		repository.RepositoryResource,
 		lineage_integration.LineageIntegrationResource,
 		oauth_configuration.OAuthConfigurationResource,
 		account_features.AccountFeaturesResource,
 		ip_restrictions_rule.IPRestrictionsRuleResource,
+		ip_restrictions_settings.IPRestrictionsSettingsResource,
+		webhook.WebhookResource,
+		sso_configuration.SSOConfigurationResource,
+		account.AccountSettingsResource,
 	}
 }
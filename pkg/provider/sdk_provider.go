@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"strconv"
 
@@ -14,6 +15,12 @@ import (
 )
 
 func SDKProvider(version string) func() *schema.Provider {
+	return SDKProviderWithTransport(version, nil)
+}
+
+// SDKProviderWithTransport returns the provider with a client sending the requests with transport, e.g. to record
+// them in the acceptance tests
+func SDKProviderWithTransport(version string, transport http.RoundTripper) func() *schema.Provider {
 	return func() *schema.Provider {
 
 		p := &schema.Provider{
@@ -46,7 +53,6 @@ func SDKProvider(version string) func() *schema.Provider {
 				"dbtcloud_connection":               data_sources.DatasourceConnection(),
 				"dbtcloud_bigquery_connection":      data_sources.DatasourceBigQueryConnection(),
 				"dbtcloud_repository":               data_sources.DatasourceRepository(),
-				"dbtcloud_webhook":                  data_sources.DatasourceWebhook(),
 				"dbtcloud_privatelink_endpoint":     data_sources.DatasourcePrivatelinkEndpoint(),
 				"dbtcloud_user_groups":              data_sources.DatasourceUserGroups(),
 				"dbtcloud_extended_attributes":      data_sources.DatasourceExtendedAttributes(),
@@ -68,16 +74,16 @@ func SDKProvider(version string) func() *schema.Provider {
 				"dbtcloud_postgres_credential":               resources.ResourcePostgresCredential(),
 				"dbtcloud_connection":                        resources.ResourceConnection(),
 				"dbtcloud_bigquery_connection":               resources.ResourceBigQueryConnection(),
-				"dbtcloud_repository":                        resources.ResourceRepository(),
-				"dbtcloud_webhook":                           resources.ResourceWebhook(),
 				"dbtcloud_user_groups":                       resources.ResourceUserGroups(),
 				"dbtcloud_license_map":                       resources.ResourceLicenseMap(),
-				"dbtcloud_extended_attributes":               resources.ResourceExtendedAttributes(),
 				"dbtcloud_environment_variable_job_override": resources.ResourceEnvironmentVariableJobOverride(),
 				"dbtcloud_fabric_connection":                 resources.ResourceFabricConnection(),
 				"dbtcloud_fabric_credential":                 resources.ResourceFabricCredential(),
+				"dbtcloud_teradata_credential":               resources.ResourceTeradataCredential(),
+			},
+			ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+				return providerConfigure(ctx, d, transport)
 			},
-			ConfigureContextFunc: providerConfigure,
 		}
 		return p
 	}
@@ -86,6 +92,7 @@ func SDKProvider(version string) func() *schema.Provider {
 func providerConfigure(
 	ctx context.Context,
 	d *schema.ResourceData,
+	transport http.RoundTripper,
 ) (interface{}, diag.Diagnostics) {
 
 	account_id := d.Get("account_id").(int)
@@ -111,7 +118,7 @@ func providerConfigure(
 	var diags diag.Diagnostics
 
 	if (token != "") && (account_id != 0) {
-		c, err := dbt_cloud.NewClient(&account_id, &token, &host_url)
+		c, err := dbt_cloud.NewClientWithTransport(&account_id, &token, &host_url, transport)
 
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccDbtCloudBigQueryCredentialDataSource(t *testing.T) {
 
-	randomProjectName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	randomProjectName := acctest_helper.RandomName()
 
 	config := bigquery_credential(randomProjectName, "moo", 64)
 
@@ -26,6 +25,7 @@ func TestAccDbtCloudBigQueryCredentialDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
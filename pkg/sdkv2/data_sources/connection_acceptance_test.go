@@ -5,14 +5,13 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccDbtCloudConnectionDataSource(t *testing.T) {
 
-	randomProjectName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
-	randomConnectionName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	randomProjectName := acctest_helper.RandomName()
+	randomConnectionName := acctest_helper.RandomName()
 
 	config := connection(randomProjectName, randomConnectionName)
 
@@ -35,6 +34,7 @@ func TestAccDbtCloudConnectionDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
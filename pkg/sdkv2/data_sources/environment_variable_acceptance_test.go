@@ -12,8 +12,8 @@ import (
 
 func TestAccDbtCloudEnvironmentVariableDataSource(t *testing.T) {
 
-	projectName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
-	environmentName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	projectName := acctest_helper.RandomName()
+	environmentName := acctest_helper.RandomName()
 	environmentVariableName := strings.ToUpper(
 		acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum),
 	)
@@ -48,6 +48,7 @@ func TestAccDbtCloudEnvironmentVariableDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
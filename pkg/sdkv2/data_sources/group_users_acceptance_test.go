@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccDbtCloudGroupUsersDataSource(t *testing.T) {
 
-	groupName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	groupName := acctest_helper.RandomName()
 
 	config := group_users(groupName)
 
@@ -29,6 +28,7 @@ func TestAccDbtCloudGroupUsersDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestDbtCloudJobDataSource(t *testing.T) {
 
-	randomJobName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	randomJobName := acctest_helper.RandomName()
 
 	config := jobs(randomJobName)
 
@@ -30,6 +29,7 @@ func TestDbtCloudJobDataSource(t *testing.T) {
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -18,7 +18,7 @@ var bigQueryPriorities = []string{
 }
 
 func ResourceBigQueryConnection() *schema.Resource {
-	return &schema.Resource{
+	return withGlobalConnectionMigration(&schema.Resource{
 		CreateContext: resourceBigQueryConnectionCreate,
 		ReadContext:   resourceBigQueryConnectionRead,
 		UpdateContext: resourceBigQueryConnectionUpdate,
@@ -176,7 +176,7 @@ func ResourceBigQueryConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	}, bigQueryConnectionMigration)
 }
 
 func resourceBigQueryConnectionCreate(
@@ -586,3 +586,37 @@ func resourceBigQueryConnectionDelete(
 	return resourceConnectionDelete(ctx, d, m)
 
 }
+
+// bigQueryConnectionMigration returns the details to move the connection to dbtcloud_global_connection
+func bigQueryConnectionMigration(config legacyConfig) *globalConnectionMigration {
+	return &globalConnectionMigration{
+		legacyType:       "dbtcloud_bigquery_connection",
+		commonAttributes: []migrationAttribute{config.attribute("name", "name")},
+		adapter:          "bigquery",
+		attributes: legacyAttributes(
+			config,
+			[]string{
+				"gcp_project_id",
+				"timeout_seconds",
+				"private_key_id",
+				"client_email",
+				"client_id",
+				"auth_uri",
+				"token_uri",
+				"auth_provider_x509_cert_url",
+				"client_x509_cert_url",
+				"retries",
+				"location",
+				"maximum_bytes_billed",
+				"execution_project",
+				"priority",
+				"gcs_bucket",
+				"dataproc_region",
+				"dataproc_cluster_name",
+			},
+			"private_key",
+			"application_id",
+			"application_secret",
+		),
+	}
+}
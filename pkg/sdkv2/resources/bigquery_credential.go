@@ -12,7 +12,7 @@ import (
 )
 
 func ResourceBigQueryCredential() *schema.Resource {
-	return &schema.Resource{
+	return withCredentialValidation(&schema.Resource{
 		CreateContext: resourceBigQueryCredentialCreate,
 		ReadContext:   resourceBigQueryCredentialRead,
 		UpdateContext: resourceBigQueryCredentialUpdate,
@@ -51,7 +51,7 @@ func ResourceBigQueryCredential() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourceBigQueryCredentialCreate(
@@ -15,7 +15,7 @@ import (
 
 func TestAccDbtCloudBigQueryCredentialResource(t *testing.T) {
 
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 	dataset := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
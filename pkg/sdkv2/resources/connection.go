@@ -24,7 +24,7 @@ var (
 )
 
 func ResourceConnection() *schema.Resource {
-	return &schema.Resource{
+	return withGlobalConnectionMigration(&schema.Resource{
 		CreateContext: resourceConnectionCreate,
 		ReadContext:   resourceConnectionRead,
 		UpdateContext: resourceConnectionUpdate,
@@ -157,7 +157,7 @@ func ResourceConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	}, connectionMigration)
 }
 
 func resourceConnectionCreate(
@@ -344,6 +344,56 @@ func resourceConnectionRead(
 	return diags
 }
 
+// connectionMigration returns the details to move the connection to dbtcloud_global_connection, or nil if its type can't be moved
+func connectionMigration(config legacyConfig) *globalConnectionMigration {
+	migration := globalConnectionMigration{
+		legacyType: "dbtcloud_connection",
+		commonAttributes: []migrationAttribute{
+			config.attribute("name", "name"),
+			config.attribute("private_link_endpoint_id", "private_link_endpoint_id"),
+		},
+	}
+
+	switch connectionType := config.value("type", "type"); connectionType {
+	case "snowflake":
+		migration.adapter = "snowflake"
+		migration.attributes = []migrationAttribute{
+			config.attribute("account", "account"),
+			config.attribute("database", "database"),
+			config.attribute("warehouse", "warehouse"),
+			config.attribute("role", "role"),
+			config.attribute("allow_sso", "allow_sso"),
+			config.attribute("client_session_keep_alive", "allow_keep_alive"),
+			config.sensitiveAttribute("oauth_client_id", "oauth_client_id"),
+			config.sensitiveAttribute("oauth_client_secret", "oauth_client_secret"),
+		}
+	case "redshift", "postgres", "alloydb":
+		migration.adapter = "postgres"
+		if connectionType == "redshift" {
+			migration.adapter = "redshift"
+		}
+		migration.attributes = []migrationAttribute{
+			config.attribute("hostname", "host_name"),
+			config.attribute("port", "port"),
+			config.attribute("dbname", "database"),
+		}
+		migration.sshTunnel = config.value("tunnel_enabled", "tunnel_enabled") == true
+	case "adapter":
+		migration.adapter = "databricks"
+		migration.attributes = []migrationAttribute{
+			config.attribute("host", "host_name"),
+			config.attribute("http_path", "http_path"),
+			config.attribute("catalog", "catalog"),
+			config.sensitiveAttribute("client_id", "oauth_client_id"),
+			config.sensitiveAttribute("client_secret", "oauth_client_secret"),
+		}
+	default:
+		return nil
+	}
+
+	return &migration
+}
+
 func resourceConnectionUpdate(
 	ctx context.Context,
 	d *schema.ResourceData,
@@ -16,9 +16,9 @@ import (
 
 func TestAccDbtCloudConnectionResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 	oAuthClientID := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	oAuthClientSecret := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
@@ -82,9 +82,9 @@ func TestAccDbtCloudConnectionResource(t *testing.T) {
 
 func TestAccDbtCloudRedshiftConnectionResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -156,9 +156,9 @@ func TestAccDbtCloudRedshiftConnectionResource(t *testing.T) {
 
 func TestAccDbtCloudPostgresConnectionResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -230,9 +230,9 @@ func TestAccDbtCloudPostgresConnectionResource(t *testing.T) {
 
 func TestAccDbtCloudDatabricksConnectionResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 	databricksHost := "databricks.com"
 
 	resource.Test(t, resource.TestCase{
@@ -393,8 +393,8 @@ func TestAccDbtCloudConnectionPrivateLinkResource(t *testing.T) {
 	endpointName := os.Getenv("DBT_ACCEPTANCE_TEST_PRIVATE_LINK_NAME")
 	endpointURL := os.Getenv("DBT_ACCEPTANCE_TEST_PRIVATE_LINK_URL")
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
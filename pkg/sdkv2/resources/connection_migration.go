@@ -0,0 +1,213 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// migrationAttribute is an attribute of the config block of dbtcloud_global_connection
+type migrationAttribute struct {
+	name  string
+	value any
+}
+
+// globalConnectionMigration contains what is needed to replace a deprecated connection resource with dbtcloud_global_connection
+type globalConnectionMigration struct {
+	legacyType string
+	// commonAttributes are the attributes of dbtcloud_global_connection outside of the adapter block
+	commonAttributes []migrationAttribute
+	adapter          string
+	attributes       []migrationAttribute
+	// the SSH tunnel details are not in the config of the legacy resource, they are referenced as variables
+	sshTunnel bool
+}
+
+// withGlobalConnectionMigration shows a warning with the HCL to move the legacy resource to dbtcloud_global_connection
+// when its config is validated, which happens once per resource for each plan, migration returns nil when the
+// connection can't be moved
+func withGlobalConnectionMigration(
+	resource *schema.Resource,
+	migration func(config legacyConfig) *globalConnectionMigration,
+) *schema.Resource {
+	resource.ValidateRawResourceConfigFuncs = append(
+		resource.ValidateRawResourceConfigFuncs,
+		func(
+			ctx context.Context,
+			req schema.ValidateResourceConfigFuncRequest,
+			resp *schema.ValidateResourceConfigFuncResponse,
+		) {
+			if req.RawConfig.IsNull() || !req.RawConfig.IsKnown() {
+				return
+			}
+			if m := migration(legacyConfig{schema: resource.Schema, config: req.RawConfig}); m != nil {
+				resp.Diagnostics = append(resp.Diagnostics, m.warning())
+			}
+		},
+	)
+	return resource
+}
+
+// legacyConfig is the config of a legacy connection resource, the defaults of its schema are used for the attributes not set
+type legacyConfig struct {
+	schema map[string]*schema.Schema
+	config cty.Value
+}
+
+// value returns the value of the attribute of the legacy resource, as a string, an int64 or a bool
+// the values not known when validating the config are returned as variables
+func (c legacyConfig) value(legacyName string, variableName string) any {
+	value := c.config.GetAttr(legacyName)
+	if !value.IsKnown() {
+		return hclExpression("var." + variableName)
+	}
+	if value.IsNull() {
+		if attributeSchema, ok := c.schema[legacyName]; ok {
+			return attributeSchema.Default
+		}
+		return nil
+	}
+
+	switch value.Type() {
+	case cty.String:
+		return value.AsString()
+	case cty.Bool:
+		return value.True()
+	case cty.Number:
+		number, _ := value.AsBigFloat().Int64()
+		return number
+	default:
+		return nil
+	}
+}
+
+// attribute returns the attribute name of dbtcloud_global_connection with the value of legacyName
+func (c legacyConfig) attribute(name string, legacyName string) migrationAttribute {
+	return migrationAttribute{name: name, value: c.value(legacyName, name)}
+}
+
+// sensitiveAttribute returns the attribute referenced as a variable, unless it is not set
+func (c legacyConfig) sensitiveAttribute(name string, legacyName string) migrationAttribute {
+	attribute := c.attribute(name, legacyName)
+	if attribute.value != nil && attribute.value != "" {
+		attribute.value = hclExpression("var." + name)
+	}
+	return attribute
+}
+
+// legacyAttributes returns the attributes of the config block with the same name as the ones of the legacy resource
+func legacyAttributes(
+	config legacyConfig,
+	names []string,
+	sensitiveNames ...string,
+) []migrationAttribute {
+	attributes := []migrationAttribute{}
+	for _, name := range names {
+		attributes = append(attributes, config.attribute(name, name))
+	}
+	for _, name := range sensitiveNames {
+		attributes = append(attributes, config.sensitiveAttribute(name, name))
+	}
+	return attributes
+}
+
+// warning returns a warning diagnostic with the HCL to move the legacy resource to dbtcloud_global_connection
+func (m globalConnectionMigration) warning() diag.Diagnostic {
+	detail := fmt.Sprintf(
+		"The connection can be moved to `dbtcloud_global_connection` without being recreated, "+
+			"by replacing the `%s` resource with the configuration below, using the current name of the resource instead of `this`. "+
+			"Sensitive values, and the values not known before the apply, are referenced as variables and need to be set to their current values.\n\n"+
+			"The `dbtcloud_project_connection` resource linking the connection to its project, if any, needs to be removed "+
+			"and the connection set with `connection_id = dbtcloud_global_connection.this.id` in the `dbtcloud_environment` resources of the project.\n\n%s",
+		m.legacyType,
+		m.hcl(),
+	)
+
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("`%s` can be replaced with `dbtcloud_global_connection`", m.legacyType),
+		Detail:   detail,
+	}
+}
+
+func (m globalConnectionMigration) hcl() string {
+	var hcl strings.Builder
+
+	fmt.Fprintf(&hcl, "moved {\n")
+	writeHCLAttributes(&hcl, "  ", []migrationAttribute{
+		{name: "from", value: hclExpression(m.legacyType + ".this")},
+		{name: "to", value: hclExpression("dbtcloud_global_connection.this")},
+	})
+	fmt.Fprintf(&hcl, "}\n\n")
+
+	fmt.Fprintf(&hcl, "resource \"dbtcloud_global_connection\" \"this\" {\n")
+	writeHCLAttributes(&hcl, "  ", m.commonAttributes)
+	fmt.Fprintf(&hcl, "\n  %s = {\n", m.adapter)
+	writeHCLAttributes(&hcl, "    ", m.attributes)
+	if m.sshTunnel {
+		fmt.Fprintf(&hcl, "    ssh_tunnel = {\n")
+		writeHCLAttributes(&hcl, "      ", []migrationAttribute{
+			{name: "username", value: hclExpression("var.ssh_tunnel_username")},
+			{name: "hostname", value: hclExpression("var.ssh_tunnel_hostname")},
+			{name: "port", value: hclExpression("var.ssh_tunnel_port")},
+		})
+		fmt.Fprintf(&hcl, "    }\n")
+	}
+	fmt.Fprintf(&hcl, "  }\n")
+	fmt.Fprintf(&hcl, "}\n")
+
+	return hcl.String()
+}
+
+// hclExpression is written as is in the HCL, without quotes
+type hclExpression string
+
+// writeHCLAttributes writes the attributes with their values aligned like terraform fmt does, empty values are skipped
+func writeHCLAttributes(hcl *strings.Builder, indent string, attributes []migrationAttribute) {
+	toWrite := []migrationAttribute{}
+	maxLength := 0
+	for _, attribute := range attributes {
+		switch value := attribute.value.(type) {
+		case nil:
+			continue
+		case string:
+			if value == "" {
+				continue
+			}
+		case int:
+			if value == 0 {
+				continue
+			}
+		case int64:
+			if value == 0 {
+				continue
+			}
+		case bool:
+			if !value {
+				continue
+			}
+		}
+		toWrite = append(toWrite, attribute)
+		maxLength = max(maxLength, len(attribute.name))
+	}
+
+	for _, attribute := range toWrite {
+		value := fmt.Sprintf("%v", attribute.value)
+		switch typedValue := attribute.value.(type) {
+		case string:
+			value = fmt.Sprintf("%q", typedValue)
+		}
+		fmt.Fprintf(
+			hcl,
+			"%s%-*s = %s\n",
+			indent,
+			maxLength,
+			attribute.name,
+			value,
+		)
+	}
+}
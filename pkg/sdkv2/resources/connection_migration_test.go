@@ -0,0 +1,151 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGlobalConnectionMigrationWarning(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		resource *schema.Resource
+		config   map[string]cty.Value
+		// expectedHCL is empty when no warning is expected
+		expectedHCL string
+	}{
+		{
+			name:     "snowflake",
+			resource: ResourceConnection(),
+			config: map[string]cty.Value{
+				"name":                cty.StringVal("My Snowflake connection"),
+				"project_id":          cty.NumberIntVal(1),
+				"type":                cty.StringVal("snowflake"),
+				"account":             cty.StringVal("my-account"),
+				"database":            cty.StringVal("ANALYTICS"),
+				"warehouse":           cty.StringVal("TRANSFORMING"),
+				"allow_sso":           cty.True,
+				"oauth_client_id":     cty.StringVal("client-id"),
+				"oauth_client_secret": cty.StringVal("client-secret"),
+			},
+			expectedHCL: `resource "dbtcloud_global_connection" "this" {
+  name = "My Snowflake connection"
+
+  snowflake = {
+    account             = "my-account"
+    database            = "ANALYTICS"
+    warehouse           = "TRANSFORMING"
+    allow_sso           = true
+    oauth_client_id     = var.oauth_client_id
+    oauth_client_secret = var.oauth_client_secret
+  }
+}
+`,
+		},
+		{
+			name:     "postgres with unknown values and an SSH tunnel",
+			resource: ResourceConnection(),
+			config: map[string]cty.Value{
+				"name":           cty.UnknownVal(cty.String),
+				"project_id":     cty.UnknownVal(cty.Number),
+				"type":           cty.StringVal("postgres"),
+				"host_name":      cty.StringVal("postgres.example.com"),
+				"port":           cty.NumberIntVal(5432),
+				"database":       cty.UnknownVal(cty.String),
+				"tunnel_enabled": cty.True,
+			},
+			expectedHCL: `resource "dbtcloud_global_connection" "this" {
+  name = var.name
+
+  postgres = {
+    hostname = "postgres.example.com"
+    port     = 5432
+    dbname   = var.dbname
+    ssh_tunnel = {
+      username = var.ssh_tunnel_username
+      hostname = var.ssh_tunnel_hostname
+      port     = var.ssh_tunnel_port
+    }
+  }
+}
+`,
+		},
+		{
+			name:     "unknown type",
+			resource: ResourceConnection(),
+			config: map[string]cty.Value{
+				"name":       cty.StringVal("connection"),
+				"project_id": cty.NumberIntVal(1),
+				"type":       cty.UnknownVal(cty.String),
+			},
+		},
+		{
+			name:     "bigquery with the defaults of the legacy resource",
+			resource: ResourceBigQueryConnection(),
+			config: map[string]cty.Value{
+				"name":                        cty.StringVal("My BigQuery connection"),
+				"project_id":                  cty.NumberIntVal(1),
+				"type":                        cty.StringVal("bigquery"),
+				"gcp_project_id":              cty.StringVal("my-gcp-project"),
+				"timeout_seconds":             cty.NumberIntVal(100),
+				"private_key_id":              cty.StringVal("key-id"),
+				"private_key":                 cty.StringVal("key"),
+				"client_email":                cty.StringVal("dbt@example.com"),
+				"client_id":                   cty.StringVal("client-id"),
+				"auth_uri":                    cty.StringVal("https://auth.example.com"),
+				"token_uri":                   cty.StringVal("https://token.example.com"),
+				"auth_provider_x509_cert_url": cty.StringVal("https://cert.example.com"),
+				"client_x509_cert_url":        cty.StringVal("https://client-cert.example.com"),
+			},
+			expectedHCL: `private_key                 = var.private_key
+  }
+}
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := schema.ValidateResourceConfigFuncRequest{
+				RawConfig: testRawConfig(testCase.resource, testCase.config),
+			}
+			resp := schema.ValidateResourceConfigFuncResponse{}
+			for _, validate := range testCase.resource.ValidateRawResourceConfigFuncs {
+				validate(context.Background(), req, &resp)
+			}
+
+			if testCase.expectedHCL == "" {
+				if len(resp.Diagnostics) > 0 {
+					t.Errorf("expected no warning, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Severity != diag.Warning {
+				t.Fatalf("expected 1 warning, got %v", resp.Diagnostics)
+			}
+			if !strings.Contains(resp.Diagnostics[0].Detail, testCase.expectedHCL) {
+				t.Errorf("expected the HCL\n%s\ngot\n%s", testCase.expectedHCL, resp.Diagnostics[0].Detail)
+			}
+		})
+	}
+}
+
+// testRawConfig returns the config of the resource with the values given and null for all the other attributes
+func testRawConfig(resource *schema.Resource, values map[string]cty.Value) cty.Value {
+	attributes := map[string]cty.Value{}
+	for name, attributeType := range resource.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		attributes[name] = cty.NullVal(attributeType)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+	return cty.ObjectVal(attributes)
+}
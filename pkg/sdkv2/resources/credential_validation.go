@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withCredentialValidation adds the attributes to test the credential after it is created or updated
+// the test is run with the connection given in validation_connection_id, as credentials are not linked to connections
+func withCredentialValidation(resource *schema.Resource) *schema.Resource {
+	resource.Schema["validate_on_apply"] = &schema.Schema{
+		Type:         schema.TypeBool,
+		Optional:     true,
+		RequiredWith: []string{"validation_connection_id"},
+		Description:  "Whether to test the credential with the connection `validation_connection_id` after it is created or updated. The apply fails with the error returned by the adapter if dbt Cloud can't connect to the warehouse - Defaults to `false`",
+	}
+	resource.Schema["validation_connection_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "The ID of the connection to use to test the credential when `validate_on_apply` is `true`",
+	}
+
+	create := resource.CreateContext
+	resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+		diags := create(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, validateCredential(d, m.(*dbt_cloud.Client))...)
+	}
+
+	update := resource.UpdateContext
+	resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+		diags := update(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, validateCredential(d, m.(*dbt_cloud.Client))...)
+	}
+
+	return resource
+}
+
+// validateCredential tests the connection with the credential when validate_on_apply is set
+func validateCredential(d *schema.ResourceData, c *dbt_cloud.Client) diag.Diagnostics {
+	if !d.Get("validate_on_apply").(bool) {
+		return nil
+	}
+
+	connectionID := d.Get("validation_connection_id").(int)
+	projectID := d.Get("project_id").(int)
+	credentialID := d.Get("credential_id").(int)
+
+	connectionTest, err := c.TestConnection(int64(connectionID), projectID, credentialID)
+	if err != nil {
+		return diag.Errorf("Unable to test the credential: %s", err)
+	}
+
+	if connectionTest.State != dbt_cloud.CONNECTION_TEST_STATE_SUCCESS {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  "Credential test failed",
+				Detail: fmt.Sprintf(
+					"dbt Cloud could not connect to the warehouse with the credential %d and the connection %d: %s",
+					credentialID,
+					connectionID,
+					connectionTest.Message,
+				),
+			},
+		}
+	}
+	return nil
+}
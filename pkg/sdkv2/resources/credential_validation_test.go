@@ -0,0 +1,166 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/fake_api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// the tests are not run in parallel as they lower the timeout of the connection tests
+func TestWithCredentialValidation(t *testing.T) {
+	pollInterval, timeout := dbt_cloud.ConnectionTestPollInterval, dbt_cloud.ConnectionTestTimeout
+	dbt_cloud.ConnectionTestPollInterval, dbt_cloud.ConnectionTestTimeout = 10*time.Millisecond, 50*time.Millisecond
+	t.Cleanup(func() {
+		dbt_cloud.ConnectionTestPollInterval, dbt_cloud.ConnectionTestTimeout = pollInterval, timeout
+	})
+
+	testCases := []struct {
+		name string
+		// result of the tests of the validation connection, nil for the default failure of the fake API
+		result         *fake_api.ConnectionTestResult
+		validate       bool
+		expectedError  string
+		expectedDetail string
+	}{
+		{
+			name:     "validation disabled",
+			validate: false,
+		},
+		{
+			name:     "success",
+			result:   &fake_api.ConnectionTestResult{State: dbt_cloud.CONNECTION_TEST_STATE_SUCCESS},
+			validate: true,
+		},
+		{
+			name:           "failure",
+			validate:       true,
+			expectedError:  "Credential test failed",
+			expectedDetail: "Could not connect to the warehouse of the connection validation",
+		},
+		{
+			name: "failure with the message of the adapter",
+			result: &fake_api.ConnectionTestResult{
+				State:   dbt_cloud.CONNECTION_TEST_STATE_FAILURE,
+				Message: "password authentication failed",
+			},
+			validate:       true,
+			expectedError:  "Credential test failed",
+			expectedDetail: "password authentication failed",
+		},
+		{
+			name:          "timeout",
+			result:        &fake_api.ConnectionTestResult{State: dbt_cloud.CONNECTION_TEST_STATE_RUNNING},
+			validate:      true,
+			expectedError: "Unable to test the credential: the test of the connection",
+		},
+	}
+
+	for _, testCase := range testCases {
+		for _, operation := range []string{"create", "update"} {
+			t.Run(testCase.name+" on "+operation, func(t *testing.T) {
+				server := fake_api.NewServer(100, "token")
+				t.Cleanup(server.Close)
+				client := &dbt_cloud.Client{
+					HTTPClient: &http.Client{Timeout: 5 * time.Second},
+					HostURL:    server.HostURL(),
+					Token:      server.Token,
+					AccountID:  server.AccountID,
+				}
+
+				// the project has its own connection, the credential is tested with validation_connection_id
+				projectConnectionID := server.Seed("connections", map[string]any{"name": "project"})
+				validationConnectionID := server.Seed("connections", map[string]any{"name": "validation"})
+				projectID := server.Seed("projects", map[string]any{
+					"name":          "project",
+					"connection_id": projectConnectionID,
+				})
+				server.SetConnectionTestResult(
+					projectConnectionID,
+					fake_api.ConnectionTestResult{State: dbt_cloud.CONNECTION_TEST_STATE_SUCCESS},
+				)
+				if testCase.result != nil {
+					server.SetConnectionTestResult(validationConnectionID, *testCase.result)
+				}
+
+				resource := ResourcePostgresCredential()
+				d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{
+					"project_id":               int(projectID),
+					"type":                     "postgres",
+					"default_schema":           "analytics",
+					"username":                 "user",
+					"password":                 "password",
+					"validate_on_apply":        testCase.validate,
+					"validation_connection_id": int(validationConnectionID),
+				})
+
+				var diags diag.Diagnostics
+				if operation == "create" {
+					diags = resource.CreateContext(context.Background(), d, client)
+				} else {
+					// the credential is created without validation before being updated
+					diags = resourcePostgresCredentialCreate(context.Background(), d, client)
+					if diags.HasError() {
+						t.Fatalf("creating the credential: %v", diags)
+					}
+					diags = resource.UpdateContext(context.Background(), d, client)
+				}
+
+				assertCredentialValidationDiags(t, diags, testCase.expectedError, testCase.expectedDetail)
+
+				testRequests := []fake_api.Request{}
+				for _, request := range server.Requests() {
+					if request.Method == http.MethodPost && strings.HasSuffix(request.Path, "/test/") {
+						testRequests = append(testRequests, request)
+					}
+				}
+				if !testCase.validate {
+					if len(testRequests) > 0 {
+						t.Errorf("expected no test of the connection, got %v", testRequests)
+					}
+					return
+				}
+				if len(testRequests) != 1 {
+					t.Fatalf("expected 1 test of the connection, got %v", testRequests)
+				}
+
+				expectedPath := fmt.Sprintf("/v3/accounts/100/connections/%d/test/", validationConnectionID)
+				if testRequests[0].Path != expectedPath {
+					t.Errorf("expected the test of %s, got %s", expectedPath, testRequests[0].Path)
+				}
+				body := testRequests[0].Body.(map[string]any)
+				if fmt.Sprint(body["project_id"]) != fmt.Sprint(projectID) ||
+					fmt.Sprint(body["credentials_id"]) != fmt.Sprint(d.Get("credential_id")) {
+					t.Errorf("expected the test of the credential %v of the project %d, got %v", d.Get("credential_id"), projectID, body)
+				}
+			})
+		}
+	}
+}
+
+func assertCredentialValidationDiags(t *testing.T, diags diag.Diagnostics, expectedError string, expectedDetail string) {
+	t.Helper()
+
+	if expectedError == "" {
+		if diags.HasError() {
+			t.Errorf("unexpected error: %v", diags)
+		}
+		return
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Error {
+		t.Fatalf("expected 1 error %q, got %v", expectedError, diags)
+	}
+	if !strings.Contains(diags[0].Summary, expectedError) {
+		t.Errorf("expected the error %q, got %q", expectedError, diags[0].Summary)
+	}
+	if !strings.Contains(diags[0].Detail, expectedDetail) {
+		t.Errorf("expected the detail %q, got %q", expectedDetail, diags[0].Detail)
+	}
+}
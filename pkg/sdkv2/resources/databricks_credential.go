@@ -25,7 +25,7 @@ func isLegacyDatabricksConnection(d *schema.ResourceData) bool {
 }
 
 func ResourceDatabricksCredential() *schema.Resource {
-	return &schema.Resource{
+	return withCredentialValidation(&schema.Resource{
 		CreateContext: resourceDatabricksCredentialCreate,
 		ReadContext:   resourceDatabricksCredentialRead,
 		UpdateContext: resourceDatabricksCredentialUpdate,
@@ -85,7 +85,7 @@ func ResourceDatabricksCredential() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourceDatabricksCredentialCreate(
@@ -16,8 +16,8 @@ import (
 
 func TestAccDbtCloudEnvironmentVariableResource(t *testing.T) {
 
-	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest_helper.RandomName())
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 	environmentVariableName := strings.ToUpper(
 		acctest.RandStringFromCharSet(10, acctest.CharSetAlpha),
 	)
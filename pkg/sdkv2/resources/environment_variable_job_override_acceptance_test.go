@@ -16,12 +16,12 @@ import (
 
 func TestAccDbtCloudEnvironmentVariableJobOverrideResource(t *testing.T) {
 
-	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest_helper.RandomName())
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 	environmentVariableName := strings.ToUpper(
 		acctest.RandStringFromCharSet(10, acctest.CharSetAlpha),
 	)
-	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	jobName := strings.ToUpper(acctest_helper.RandomName())
 	environmentVariableJobOverrideValue := strings.ToUpper(
 		acctest.RandStringFromCharSet(10, acctest.CharSetAlpha),
 	)
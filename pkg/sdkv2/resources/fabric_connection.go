@@ -12,7 +12,7 @@ import (
 )
 
 func ResourceFabricConnection() *schema.Resource {
-	return &schema.Resource{
+	return withGlobalConnectionMigration(&schema.Resource{
 		CreateContext: resourceFabricConnectionCreate,
 		ReadContext:   resourceFabricConnectionRead,
 		UpdateContext: resourceFabricConnectionUpdate,
@@ -85,7 +85,7 @@ func ResourceFabricConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	}, fabricConnectionMigration)
 }
 
 func resourceFabricConnectionCreate(
@@ -257,3 +257,23 @@ func resourceFabricConnectionDelete(
 	return resourceConnectionDelete(ctx, d, m)
 
 }
+
+// fabricConnectionMigration returns the details to move the connection to dbtcloud_global_connection
+func fabricConnectionMigration(config legacyConfig) *globalConnectionMigration {
+	return &globalConnectionMigration{
+		legacyType:       "dbtcloud_fabric_connection",
+		commonAttributes: []migrationAttribute{config.attribute("name", "name")},
+		adapter:          "fabric",
+		attributes: legacyAttributes(
+			config,
+			[]string{
+				"server",
+				"port",
+				"database",
+				"retries",
+				"login_timeout",
+				"query_timeout",
+			},
+		),
+	}
+}
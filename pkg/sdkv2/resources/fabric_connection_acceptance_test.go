@@ -15,9 +15,9 @@ import (
 
 func TestAccDbtCloudFabricConnectionResource(t *testing.T) {
 
-	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	connectionName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToUpper(acctest_helper.RandomName())
+	connectionName2 := strings.ToUpper(acctest_helper.RandomName())
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 	database := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	server := "example.com"
 	port := 1337
@@ -12,7 +12,7 @@ import (
 )
 
 func ResourceFabricCredential() *schema.Resource {
-	return &schema.Resource{
+	return withCredentialValidation(&schema.Resource{
 		CreateContext: resourceFabricCredentialCreate,
 		ReadContext:   resourceFabricCredentialRead,
 		UpdateContext: resourceFabricCredentialUpdate,
@@ -90,7 +90,7 @@ func ResourceFabricCredential() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourceFabricCredentialCreate(
@@ -15,7 +15,7 @@ import (
 
 func TestAccDbtCloudFabricCredentialResource(t *testing.T) {
 
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 	user := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	password := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	clientId := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
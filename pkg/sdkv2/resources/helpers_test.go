@@ -1,9 +1,9 @@
 package resources_test
 
 import (
-	"os"
 	"testing"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -23,14 +23,9 @@ func init() {
 }
 
 func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("DBT_CLOUD_ACCOUNT_ID"); v == "" {
-		t.Fatal("DBT_CLOUD_ACCOUNT_ID must be set for acceptance tests")
-	}
-	if v := os.Getenv("DBT_CLOUD_TOKEN"); v == "" {
-		t.Fatal("DBT_CLOUD_TOKEN must be set for acceptance tests")
-	}
+	acctest_helper.TestAccPreCheck(t)
 }
 
 func isDbtCloudPR() bool {
-	return os.Getenv("DBT_CLOUD_ACCOUNT_ID") == "1"
+	return acctest_helper.IsDbtCloudPR()
 }
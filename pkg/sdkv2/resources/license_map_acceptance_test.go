@@ -7,15 +7,14 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDbtCloudLicenseMapResource(t *testing.T) {
 
-	groupName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
-	groupName2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	groupName := acctest_helper.RandomName()
+	groupName2 := acctest_helper.RandomName()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -20,7 +20,7 @@ var (
 )
 
 func ResourcePostgresCredential() *schema.Resource {
-	return &schema.Resource{
+	return withCredentialValidation(&schema.Resource{
 		CreateContext: resourcePostgresCredentialCreate,
 		ReadContext:   resourcePostgresCredentialRead,
 		UpdateContext: resourcePostgresCredentialUpdate,
@@ -82,7 +82,7 @@ func ResourcePostgresCredential() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourcePostgresCredentialCreate(
@@ -15,7 +15,7 @@ import (
 
 func TestAccDbtCloudPostgresCredentialResource(t *testing.T) {
 
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 	default_schema := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	username := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	password := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
@@ -7,16 +7,15 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDbtCloudProjectArtefactsResource(t *testing.T) {
 
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest_helper.RandomName())
+	jobName := strings.ToUpper(acctest_helper.RandomName())
+	environmentName := strings.ToUpper(acctest_helper.RandomName())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -43,8 +42,9 @@ func TestAccDbtCloudProjectArtefactsResource(t *testing.T) {
 				ImportStateVerifyIgnore: []string{},
 			},
 			// EMPTY
+			// the project is a new resource, it is created before the previous one is deleted and needs another name
 			{
-				Config: testAccDbtCloudProjectArtefactsResourceEmptyConfig(projectName),
+				Config: testAccDbtCloudProjectArtefactsResourceEmptyConfig(projectName + "_EMPTY"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDbtCloudProjectArtefactsEmpty("dbtcloud_project.test_project"),
 				),
@@ -20,7 +20,7 @@ var (
 )
 
 func ResourceSnowflakeCredential() *schema.Resource {
-	return &schema.Resource{
+	return withCredentialValidation(&schema.Resource{
 		CreateContext: resourceSnowflakeCredentialCreate,
 		ReadContext:   resourceSnowflakeCredentialRead,
 		UpdateContext: resourceSnowflakeCredentialUpdate,
@@ -106,7 +106,7 @@ func ResourceSnowflakeCredential() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourceSnowflakeCredentialCreate(
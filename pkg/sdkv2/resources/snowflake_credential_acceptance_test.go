@@ -15,7 +15,7 @@ import (
 
 func TestAccDbtCloudSnowflakeCredentialResource(t *testing.T) {
 
-	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest_helper.RandomName())
 	database := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	role := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	warehouse := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
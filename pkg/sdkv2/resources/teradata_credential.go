@@ -0,0 +1,246 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceTeradataCredential() *schema.Resource {
+	return withCredentialValidation(&schema.Resource{
+		CreateContext: resourceTeradataCredentialCreate,
+		ReadContext:   resourceTeradataCredentialRead,
+		UpdateContext: resourceTeradataCredentialUpdate,
+		DeleteContext: resourceTeradataCredentialDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Project ID to create the Teradata credential in",
+			},
+			"credential_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The system Teradata credential ID",
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username to connect to Teradata with",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password to connect to Teradata with",
+			},
+			"schema": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The schema where to create the dbt models",
+			},
+			"num_threads": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      dbt_cloud.NUM_THREADS_CREDENTIAL,
+				Description:  "Number of threads to use - Defaults to `6`",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	})
+}
+
+func resourceTeradataCredentialCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(int)
+	user := d.Get("user").(string)
+	password := d.Get("password").(string)
+	schema := d.Get("schema").(string)
+	numThreads := d.Get("num_threads").(int)
+
+	teradataCredential, err := c.CreateTeradataCredential(
+		projectId,
+		user,
+		password,
+		schema,
+		numThreads,
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(
+		fmt.Sprintf(
+			"%d%s%d",
+			teradataCredential.ProjectID,
+			dbt_cloud.ID_DELIMITER,
+			*teradataCredential.ID,
+		),
+	)
+
+	resourceTeradataCredentialRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceTeradataCredentialRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	projectId, teradataCredentialId, err := helper.SplitIDToInts(
+		d.Id(),
+		"dbtcloud_teradata_credential",
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	teradataCredential, err := c.GetTeradataCredential(projectId, teradataCredentialId)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("credential_id", teradataCredentialId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("project_id", teradataCredential.ProjectID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("user", teradataCredential.UnencryptedCredentialDetails.User); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("schema", teradataCredential.UnencryptedCredentialDetails.Schema); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("num_threads", teradataCredential.Threads); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// set the ones that don't come back from the API
+	if err := d.Set("password", d.Get("password").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceTeradataCredentialUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	projectId, teradataCredentialId, err := helper.SplitIDToInts(
+		d.Id(),
+		"dbtcloud_teradata_credential",
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("user") ||
+		d.HasChange("password") ||
+		d.HasChange("schema") ||
+		d.HasChange("num_threads") {
+
+		numThreads := d.Get("num_threads").(int)
+
+		patchCredentialsDetails, err := dbt_cloud.GenerateTeradataCredentialDetails(
+			d.Get("user").(string),
+			d.Get("password").(string),
+			d.Get("schema").(string),
+			numThreads,
+		)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// we only send the fields that changed
+		for key := range patchCredentialsDetails.Fields {
+			schemaKey := key
+			if key == "threads" {
+				schemaKey = "num_threads"
+			}
+			if !d.HasChange(schemaKey) {
+				delete(patchCredentialsDetails.Fields, key)
+			}
+		}
+
+		teradataPatch := dbt_cloud.TeradataCredentialPatch{
+			ID:                teradataCredentialId,
+			CredentialDetails: patchCredentialsDetails,
+		}
+		if d.HasChange("num_threads") {
+			teradataPatch.Threads = numThreads
+		}
+
+		_, err = c.UpdateTeradataCredential(
+			projectId,
+			teradataCredentialId,
+			teradataPatch,
+		)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTeradataCredentialRead(ctx, d, m)
+}
+
+func resourceTeradataCredentialDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	projectId, teradataCredentialId, err := helper.SplitIDToInts(
+		d.Id(),
+		"dbtcloud_teradata_credential",
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = c.DeleteCredential(
+		strconv.Itoa(teradataCredentialId),
+		strconv.Itoa(projectId),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
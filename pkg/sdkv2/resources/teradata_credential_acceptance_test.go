@@ -0,0 +1,175 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDbtCloudTeradataCredentialResource(t *testing.T) {
+
+	projectName := strings.ToUpper(acctest_helper.RandomName())
+	user := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	user2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	password := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudTeradataCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudTeradataCredentialResourceConfig(
+					projectName,
+					user,
+					password,
+					"my_schema",
+					6,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudTeradataCredentialExists(
+						"dbtcloud_teradata_credential.test_credential",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_teradata_credential.test_credential",
+						"user",
+						user,
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_teradata_credential.test_credential",
+						"schema",
+						"my_schema",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_teradata_credential.test_credential",
+						"num_threads",
+						"6",
+					),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudTeradataCredentialResourceConfig(
+					projectName,
+					user2,
+					password,
+					"my_schema_new",
+					8,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudTeradataCredentialExists(
+						"dbtcloud_teradata_credential.test_credential",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_teradata_credential.test_credential",
+						"user",
+						user2,
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_teradata_credential.test_credential",
+						"schema",
+						"my_schema_new",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_teradata_credential.test_credential",
+						"num_threads",
+						"8",
+					),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbtcloud_teradata_credential.test_credential",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudTeradataCredentialResourceConfig(
+	projectName, user, password, schema string, numThreads int,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name = "%s"
+}
+
+resource "dbtcloud_teradata_credential" "test_credential" {
+  project_id  = dbtcloud_project.test_project.id
+  user        = "%s"
+  password    = "%s"
+  schema      = "%s"
+  num_threads = %d
+}
+`, projectName, user, password, schema, numThreads)
+}
+
+func testAccCheckDbtCloudTeradataCredentialExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		projectId, credentialId, err := helper.SplitIDToInts(
+			rs.Primary.ID,
+			"dbtcloud_teradata_credential",
+		)
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := acctest_helper.SharedClient()
+		if err != nil {
+			return fmt.Errorf("Issue getting the client")
+		}
+		_, err = apiClient.GetTeradataCredential(projectId, credentialId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudTeradataCredentialDestroy(s *terraform.State) error {
+	apiClient, err := acctest_helper.SharedClient()
+	if err != nil {
+		return fmt.Errorf("Issue getting the client")
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbtcloud_teradata_credential" {
+			continue
+		}
+		projectId, credentialId, err := helper.SplitIDToInts(
+			rs.Primary.ID,
+			"dbtcloud_teradata_credential",
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = apiClient.GetTeradataCredential(projectId, credentialId)
+		if err == nil {
+			return fmt.Errorf("Teradata credential still exists")
+		}
+		notFoundErr := "resource-not-found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -15,7 +14,19 @@ func TestAccDbtCloudUserGroupsResource(t *testing.T) {
 
 	var userID int
 	var groupIDs string
-	if isDbtCloudPR() {
+	if acctest_helper.IsFakeAPI() {
+		server, err := acctest_helper.FakeAPI()
+		if err != nil {
+			t.Fatalf("Error starting the fake dbt Cloud API: %s", err)
+		}
+		userID = int(server.UserID)
+		groupIDs = fmt.Sprintf(
+			"[%d, %d, %d]",
+			server.Seed("groups", map[string]any{"name": acctest_helper.RandomName()}),
+			server.Seed("groups", map[string]any{"name": acctest_helper.RandomName()}),
+			server.Seed("groups", map[string]any{"name": acctest_helper.RandomName()}),
+		)
+	} else if isDbtCloudPR() {
 		userID = 1
 		groupIDs = "[1,2,3]"
 	} else if value := os.Getenv("CI"); value != "" {
@@ -26,7 +37,7 @@ func TestAccDbtCloudUserGroupsResource(t *testing.T) {
 		groupIDs = "[104, 105, 106]"
 	}
 
-	GroupName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	GroupName := acctest_helper.RandomName()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
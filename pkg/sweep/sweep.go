@@ -0,0 +1,591 @@
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+)
+
+// objects created less than defaultMinAge ago are kept as they might belong to tests still running
+const defaultMinAge = 2 * time.Hour
+
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999",
+}
+
+// Sweeper deletes the objects of a resource type leaked by failed acceptance tests
+type Sweeper struct {
+	Name string
+	// Dependencies are the sweepers to run before this one, e.g. the jobs before the environments
+	Dependencies []string
+	Sweep        func(client *dbt_cloud.Client, createdBefore time.Time) error
+}
+
+// Sweepers are all the sweepers, the objects of the test projects (jobs, environments, credentials...) are
+// deleted before the projects and the projects before the connections they use
+var Sweepers = []Sweeper{
+	{
+		Name:  "dbtcloud_job",
+		Sweep: sweepJobs,
+	},
+	{
+		Name:         "dbtcloud_environment_variable",
+		Dependencies: []string{"dbtcloud_job"},
+		Sweep:        sweepEnvironmentVariables,
+	},
+	{
+		Name:         "dbtcloud_environment",
+		Dependencies: []string{"dbtcloud_job"},
+		Sweep:        sweepEnvironments,
+	},
+	{
+		// the credentials of all the adapters, e.g. dbtcloud_snowflake_credential
+		Name:         "dbtcloud_credential",
+		Dependencies: []string{"dbtcloud_environment"},
+		Sweep:        sweepCredentials,
+	},
+	{
+		Name:         "dbtcloud_extended_attributes",
+		Dependencies: []string{"dbtcloud_environment"},
+		Sweep:        sweepExtendedAttributes,
+	},
+	{
+		Name:         "dbtcloud_connection",
+		Dependencies: []string{"dbtcloud_environment"},
+		Sweep:        sweepProjectConnections,
+	},
+	{
+		Name:  "dbtcloud_repository",
+		Sweep: sweepRepositories,
+	},
+	{
+		Name:  "dbtcloud_lineage_integration",
+		Sweep: sweepLineageIntegrations,
+	},
+	{
+		Name: "dbtcloud_project",
+		Dependencies: []string{
+			"dbtcloud_environment",
+			"dbtcloud_environment_variable",
+			"dbtcloud_credential",
+			"dbtcloud_extended_attributes",
+			"dbtcloud_connection",
+			"dbtcloud_repository",
+			"dbtcloud_lineage_integration",
+		},
+		Sweep: sweepProjects,
+	},
+	{
+		Name:         "dbtcloud_global_connection",
+		Dependencies: []string{"dbtcloud_project"},
+		Sweep:        sweepGlobalConnections,
+	},
+	{
+		Name:         "dbtcloud_oauth_configuration",
+		Dependencies: []string{"dbtcloud_global_connection"},
+		Sweep:        sweepOAuthConfigurations,
+	},
+	{
+		Name:  "dbtcloud_license_map",
+		Sweep: sweepLicenseMaps,
+	},
+	{
+		Name:  "dbtcloud_group",
+		Sweep: sweepGroups,
+	},
+	{
+		Name:  "dbtcloud_service_token",
+		Sweep: sweepServiceTokens,
+	},
+	{
+		Name:  "dbtcloud_webhook",
+		Sweep: sweepWebhooks,
+	},
+	{
+		Name:         "dbtcloud_notification",
+		Dependencies: []string{"dbtcloud_job"},
+		Sweep:        sweepNotifications,
+	},
+	{
+		Name:  "dbtcloud_ip_restrictions_rule",
+		Sweep: sweepIPRestrictionsRules,
+	},
+}
+
+// Run sweeps with the client of the acceptance tests, the minimum age of the objects to delete can be
+// set with DBT_CLOUD_SWEEP_MIN_AGE (e.g. `30m`), the region is not used by dbt Cloud
+func Run(sweeper Sweeper) func(region string) error {
+	return func(_ string) error {
+		minAge := defaultMinAge
+		if value := os.Getenv("DBT_CLOUD_SWEEP_MIN_AGE"); value != "" {
+			parsedMinAge, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("invalid DBT_CLOUD_SWEEP_MIN_AGE %q: %w", value, err)
+			}
+			minAge = parsedMinAge
+		}
+
+		client, err := acctest_helper.SharedClient()
+		if err != nil {
+			return err
+		}
+		return sweeper.Sweep(client, time.Now().Add(-minAge))
+	}
+}
+
+// isTestObject returns true for the objects named by the acceptance tests and created before createdBefore,
+// the objects without a creation date are kept
+func isTestObject(name string, createdAt string, createdBefore time.Time) bool {
+	if !strings.HasPrefix(strings.ToLower(name), acctest_helper.TestNamePrefix) {
+		return false
+	}
+
+	for _, layout := range timeLayouts {
+		if created, err := time.Parse(layout, createdAt); err == nil {
+			return created.Before(createdBefore)
+		}
+	}
+	return false
+}
+
+func testProjects(client *dbt_cloud.Client, createdBefore time.Time) ([]dbt_cloud.ProjectConnectionRepository, error) {
+	projects, err := client.GetAllProjects(acctest_helper.TestNamePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	testProjects := []dbt_cloud.ProjectConnectionRepository{}
+	for _, project := range projects {
+		if project.State == dbt_cloud.STATE_ACTIVE && isTestObject(project.Name, project.CreatedAt, createdBefore) {
+			testProjects = append(testProjects, project)
+		}
+	}
+	return testProjects, nil
+}
+
+// all the jobs of the test projects are deleted, whatever their name
+func sweepJobs(client *dbt_cloud.Client, createdBefore time.Time) error {
+	projects, err := testProjects(client, createdBefore)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, project := range projects {
+		jobs, err := client.GetAllJobs(int(project.ID), 0)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, job := range jobs {
+			if job.State != dbt_cloud.STATE_ACTIVE || job.ID == nil {
+				continue
+			}
+			log.Printf("[INFO] Deleting job %d (%s) of project %s", *job.ID, job.Name, project.Name)
+			job.Job.State = dbt_cloud.STATE_DELETED
+			if _, err := client.UpdateJob(strconv.Itoa(*job.ID), job.Job); err != nil {
+				errs = append(errs, fmt.Errorf("error deleting the job %d: %w", *job.ID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// all the environments of the test projects are deleted, whatever their name
+func sweepEnvironments(client *dbt_cloud.Client, createdBefore time.Time) error {
+	projects, err := testProjects(client, createdBefore)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, project := range projects {
+		environments, err := client.GetAllEnvironments(int(project.ID))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, environment := range environments {
+			if environment.State != dbt_cloud.STATE_ACTIVE || environment.ID == nil {
+				continue
+			}
+			log.Printf("[INFO] Deleting environment %d (%s) of project %s", *environment.ID, environment.Name, project.Name)
+			if _, err := client.DeleteEnvironment(int(project.ID), *environment.ID); err != nil {
+				errs = append(errs, fmt.Errorf("error deleting the environment %d: %w", *environment.ID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// all the environment variables of the test projects are deleted, whatever their name
+func sweepEnvironmentVariables(client *dbt_cloud.Client, createdBefore time.Time) error {
+	projects, err := testProjects(client, createdBefore)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, project := range projects {
+		environmentVariables, err := client.GetAllEnvironmentVariables(int(project.ID))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, environmentVariable := range environmentVariables {
+			log.Printf("[INFO] Deleting environment variable %s of project %s", environmentVariable.Name, project.Name)
+			if _, err := client.DeleteEnvironmentVariable(environmentVariable.Name, int(project.ID)); err != nil {
+				errs = append(errs, fmt.Errorf("error deleting the environment variable %s: %w", environmentVariable.Name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// all the credentials of the test projects are deleted, whatever their adapter
+func sweepCredentials(client *dbt_cloud.Client, createdBefore time.Time) error {
+	projects, err := testProjects(client, createdBefore)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, project := range projects {
+		credentials, err := client.GetAllCredentials(int(project.ID))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, credential := range credentials {
+			if credential.State != dbt_cloud.STATE_ACTIVE || credential.ID == nil {
+				continue
+			}
+			log.Printf("[INFO] Deleting %s credential %d of project %s", credential.Type, *credential.ID, project.Name)
+			if _, err := client.DeleteCredential(strconv.Itoa(*credential.ID), strconv.FormatInt(project.ID, 10)); err != nil {
+				errs = append(errs, fmt.Errorf("error deleting the credential %d: %w", *credential.ID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// all the extended attributes of the test projects are deleted
+func sweepExtendedAttributes(client *dbt_cloud.Client, createdBefore time.Time) error {
+	projects, err := testProjects(client, createdBefore)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, project := range projects {
+		allExtendedAttributes, err := client.GetAllExtendedAttributes(int(project.ID))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, extendedAttributes := range allExtendedAttributes {
+			if extendedAttributes.State != dbt_cloud.STATE_ACTIVE || extendedAttributes.ID == nil {
+				continue
+			}
+			log.Printf("[INFO] Deleting extended attributes %d of project %s", *extendedAttributes.ID, project.Name)
+			if _, err := client.DeleteExtendedAttributes(int(project.ID), *extendedAttributes.ID); err != nil {
+				errs = append(errs, fmt.Errorf("error deleting the extended attributes %d: %w", *extendedAttributes.ID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// all the legacy connections of the test projects are deleted, the global connections are swept by name
+func sweepProjectConnections(client *dbt_cloud.Client, createdBefore time.Time) error {
+	projects, err := testProjects(client, createdBefore)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, project := range projects {
+		connections, err := client.GetAllProjectConnections(int(project.ID))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, connection := range connections {
+			if connection.State != dbt_cloud.STATE_ACTIVE || connection.ID == nil ||
+				int64(connection.ProjectID) != project.ID {
+				continue
+			}
+			log.Printf("[INFO] Deleting connection %d (%s) of project %s", *connection.ID, connection.Name, project.Name)
+			if _, err := client.DeleteConnection(strconv.Itoa(*connection.ID), strconv.FormatInt(project.ID, 10)); err != nil {
+				errs = append(errs, fmt.Errorf("error deleting the connection %d: %w", *connection.ID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// all the repositories of the test projects are deleted, whatever their URL
+func sweepRepositories(client *dbt_cloud.Client, createdBefore time.Time) error {
+	projects, err := testProjects(client, createdBefore)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, project := range projects {
+		repositories, err := client.GetAllRepositories(int(project.ID))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, repository := range repositories {
+			if repository.State != dbt_cloud.STATE_ACTIVE || repository.ID == nil {
+				continue
+			}
+			log.Printf("[INFO] Deleting repository %d (%s) of project %s", *repository.ID, repository.RemoteUrl, project.Name)
+			if _, err := client.DeleteRepository(strconv.Itoa(*repository.ID), strconv.FormatInt(project.ID, 10)); err != nil {
+				errs = append(errs, fmt.Errorf("error deleting the repository %d: %w", *repository.ID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// all the lineage integrations of the test projects are deleted
+func sweepLineageIntegrations(client *dbt_cloud.Client, createdBefore time.Time) error {
+	projects, err := testProjects(client, createdBefore)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, project := range projects {
+		lineageIntegrations, err := client.GetLineageIntegrations(project.ID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, lineageIntegration := range lineageIntegrations {
+			if lineageIntegration.ID == nil {
+				continue
+			}
+			log.Printf("[INFO] Deleting %s lineage integration %d of project %s", lineageIntegration.Name, *lineageIntegration.ID, project.Name)
+			if err := client.DeleteLineageIntegration(project.ID, *lineageIntegration.ID); err != nil {
+				errs = append(errs, fmt.Errorf("error deleting the lineage integration %d: %w", *lineageIntegration.ID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepProjects(client *dbt_cloud.Client, createdBefore time.Time) error {
+	projects, err := testProjects(client, createdBefore)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, testProject := range projects {
+		projectID := strconv.FormatInt(testProject.ID, 10)
+		log.Printf("[INFO] Deleting project %s (%s)", projectID, testProject.Name)
+
+		project, err := client.GetProject(projectID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error getting the project %s: %w", projectID, err))
+			continue
+		}
+		project.State = dbt_cloud.STATE_DELETED
+		if _, err := client.UpdateProject(projectID, *project); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting the project %s: %w", projectID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepGlobalConnections(client *dbt_cloud.Client, createdBefore time.Time) error {
+	connections, err := client.GetAllConnections()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, connection := range connections {
+		if !isTestObject(connection.Name, connection.CreatedAt, createdBefore) {
+			continue
+		}
+		log.Printf("[INFO] Deleting global connection %d (%s)", connection.ID, connection.Name)
+		if _, err := client.DeleteGlobalConnection(connection.ID); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting the global connection %d: %w", connection.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepOAuthConfigurations(client *dbt_cloud.Client, createdBefore time.Time) error {
+	oAuthConfigurations, err := client.GetAllOAuthConfigurations()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, oAuthConfiguration := range oAuthConfigurations {
+		if oAuthConfiguration.ID == nil ||
+			!isTestObject(oAuthConfiguration.Name, oAuthConfiguration.CreatedAt, createdBefore) {
+			continue
+		}
+		log.Printf("[INFO] Deleting OAuth configuration %d (%s)", *oAuthConfiguration.ID, oAuthConfiguration.Name)
+		if err := client.DeleteOAuthConfiguration(*oAuthConfiguration.ID); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting the OAuth configuration %d: %w", *oAuthConfiguration.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// license maps don't have a name, the tests map SSO groups starting with the test prefix
+func sweepLicenseMaps(client *dbt_cloud.Client, createdBefore time.Time) error {
+	licenseMaps, err := client.GetAllLicenseMaps()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, licenseMap := range licenseMaps {
+		if licenseMap.State != dbt_cloud.STATE_ACTIVE || licenseMap.ID == nil ||
+			len(licenseMap.SSOLicenseMappingGroups) == 0 {
+			continue
+		}
+		isTestLicenseMap := true
+		for _, group := range licenseMap.SSOLicenseMappingGroups {
+			if !isTestObject(group, licenseMap.CreatedAt, createdBefore) {
+				isTestLicenseMap = false
+				break
+			}
+		}
+		if !isTestLicenseMap {
+			continue
+		}
+		log.Printf("[INFO] Deleting license map %d (%s)", *licenseMap.ID, strings.Join(licenseMap.SSOLicenseMappingGroups, ", "))
+		if err := client.DestroyLicenseMap(*licenseMap.ID); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting the license map %d: %w", *licenseMap.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepGroups(client *dbt_cloud.Client, createdBefore time.Time) error {
+	groups, err := client.GetAllGroups()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, group := range groups {
+		if group.State != dbt_cloud.STATE_ACTIVE || group.ID == nil ||
+			!isTestObject(group.Name, group.CreatedAt, createdBefore) {
+			continue
+		}
+		log.Printf("[INFO] Deleting group %d (%s)", *group.ID, group.Name)
+		group.State = dbt_cloud.STATE_DELETED
+		if _, err := client.UpdateGroup(*group.ID, group); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting the group %d: %w", *group.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepServiceTokens(client *dbt_cloud.Client, createdBefore time.Time) error {
+	serviceTokens, err := client.GetAllServiceTokens()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, serviceToken := range serviceTokens {
+		if serviceToken.ID == nil || !isTestObject(serviceToken.Name, serviceToken.CreatedAt, createdBefore) {
+			continue
+		}
+		log.Printf("[INFO] Deleting service token %d (%s)", *serviceToken.ID, serviceToken.Name)
+		if _, err := client.DeleteServiceToken(*serviceToken.ID); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting the service token %d: %w", *serviceToken.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepWebhooks(client *dbt_cloud.Client, createdBefore time.Time) error {
+	webhooks, err := client.GetAllWebhooks()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, webhook := range webhooks {
+		if !isTestObject(webhook.Name, webhook.CreatedAt, createdBefore) {
+			continue
+		}
+		log.Printf("[INFO] Deleting webhook %s (%s)", webhook.WebhookId, webhook.Name)
+		if _, err := client.DeleteWebhook(webhook.WebhookId); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting the webhook %s: %w", webhook.WebhookId, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// notifications don't have a name, the tests send them to an external email starting with the test prefix
+func sweepNotifications(client *dbt_cloud.Client, createdBefore time.Time) error {
+	notifications, err := client.GetAllNotifications()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, notification := range notifications {
+		if notification.State != dbt_cloud.STATE_ACTIVE || notification.Id == nil || notification.ExternalEmail == nil ||
+			!isTestObject(*notification.ExternalEmail, notification.CreatedAt, createdBefore) {
+			continue
+		}
+		notificationID := strconv.Itoa(*notification.Id)
+		log.Printf("[INFO] Deleting notification %s (%s)", notificationID, *notification.ExternalEmail)
+		notification.State = dbt_cloud.STATE_DELETED
+		if _, err := client.UpdateNotification(notificationID, notification); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting the notification %s: %w", notificationID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepIPRestrictionsRules(client *dbt_cloud.Client, createdBefore time.Time) error {
+	rules, err := client.GetIPRestrictions()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, rule := range *rules {
+		if !isTestObject(rule.Name, rule.CreatedAt, createdBefore) {
+			continue
+		}
+		log.Printf("[INFO] Deleting IP restrictions rule %d (%s)", rule.ID, rule.Name)
+		if err := client.DeleteIPRestrictionsRule(rule.ID); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting the IP restrictions rule %d: %w", rule.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,177 @@
+package sweep_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/fake_api"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/sweep"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// the sweepers are run with `go test ./pkg/sweep -v -sweep=all`
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	for _, sweeper := range sweep.Sweepers {
+		resource.AddTestSweepers(sweeper.Name, &resource.Sweeper{
+			Name:         sweeper.Name,
+			Dependencies: sweeper.Dependencies,
+			F:            sweep.Run(sweeper),
+		})
+	}
+}
+
+func TestSweepers(t *testing.T) {
+	t.Parallel()
+
+	server := fake_api.NewServer(100, "token")
+	defer server.Close()
+
+	client := &dbt_cloud.Client{
+		HTTPClient: &http.Client{Timeout: 5 * time.Second},
+		HostURL:    server.HostURL(),
+		Token:      server.Token,
+		AccountID:  server.AccountID,
+	}
+
+	old := "2020-01-01T00:00:00Z"
+	recent := time.Now().UTC().Format(time.RFC3339)
+
+	oldTestProject := server.Seed("projects", map[string]any{"name": "TF_ACC_OLD", "created_at": old})
+	recentTestProject := server.Seed("projects", map[string]any{"name": "tf_acc_recent", "created_at": recent})
+	otherProject := server.Seed("projects", map[string]any{"name": "analytics", "created_at": old})
+	for _, projectID := range []int64{oldTestProject, recentTestProject, otherProject} {
+		environmentID := server.Seed("environments", map[string]any{"name": "prod", "project_id": projectID, "created_at": old})
+		server.Seed("jobs", map[string]any{
+			"name":           "daily",
+			"project_id":     projectID,
+			"environment_id": environmentID,
+			"created_at":     old,
+		})
+		server.Seed("environment-variables", map[string]any{
+			"name":             "DBT_TARGET",
+			"environment_name": "prod",
+			"value":            "prod",
+			"project_id":       projectID,
+		})
+		server.Seed("credentials", map[string]any{"name": "prod", "type": "snowflake", "state": 1, "project_id": projectID})
+		server.Seed("extended-attributes", map[string]any{"name": "prod", "state": 1, "project_id": projectID})
+		server.Seed("connections", map[string]any{"name": "legacy", "state": 1, "project_id": projectID, "created_at": recent})
+		server.Seed("repositories", map[string]any{
+			"name":       "dbt",
+			"remote_url": "git://github.com/dbt-labs/jaffle_shop.git",
+			"state":      1,
+			"project_id": projectID,
+		})
+		server.Seed("integrations/lineage", map[string]any{"name": "tableau", "project_id": projectID})
+	}
+
+	server.Seed("connections", map[string]any{"name": "TF_ACC_CONNECTION", "created_at": old})
+	server.Seed("connections", map[string]any{"name": "warehouse", "created_at": old})
+	server.Seed("groups", map[string]any{"name": "tf_acc_group", "created_at": old})
+	server.Seed("groups", map[string]any{"name": "tf_acc_group_recent", "created_at": recent})
+	server.Seed("service-tokens", map[string]any{"name": "tf_acc_token", "created_at": old})
+	server.Seed("service-tokens", map[string]any{"name": "ci", "created_at": old})
+	server.Seed("notifications", map[string]any{"external_email": "tf_acc_1-resource@nomail.com", "created_at": old})
+	server.Seed("notifications", map[string]any{"external_email": "team@example.com", "created_at": old})
+	server.Seed("ip-restrictions", map[string]any{"name": "TF_ACC_RULE", "created_at": old})
+	server.Seed("ip-restrictions", map[string]any{"name": "office", "created_at": old})
+	server.Seed("oauth-configurations", map[string]any{"name": "tf_acc_okta", "created_at": old})
+	server.Seed("oauth-configurations", map[string]any{"name": "okta", "created_at": old})
+	server.Seed("license-maps", map[string]any{
+		"license_type":               "developer",
+		"sso_license_mapping_groups": []any{"tf_acc_group_1", "TF_ACC_GROUP_2"},
+		"state":                      1,
+		"created_at":                 old,
+	})
+	server.Seed("license-maps", map[string]any{
+		"license_type":               "developer",
+		"sso_license_mapping_groups": []any{"tf_acc_group_3", "engineering"},
+		"state":                      1,
+		"created_at":                 old,
+	})
+	server.Seed("license-maps", map[string]any{
+		"license_type":               "read_only",
+		"sso_license_mapping_groups": []any{},
+		"state":                      1,
+		"created_at":                 old,
+	})
+
+	// the sweepers are defined in their dependency order
+	for _, sweeper := range sweep.Sweepers {
+		if sweeper.Name == "dbtcloud_webhook" {
+			continue
+		}
+		if err := sweeper.Sweep(client, time.Now().Add(-time.Hour)); err != nil {
+			t.Fatalf("%s: %s", sweeper.Name, err)
+		}
+	}
+
+	expectedNames := map[string][]string{
+		"projects":       {"tf_acc_recent", "analytics"},
+		"environments":   {"prod", "prod"},
+		"jobs":           {"daily", "daily"},
+		"connections":    {"legacy", "legacy", "warehouse"},
+		"groups":         {"tf_acc_group_recent"},
+		"service-tokens": {"ci"},
+
+		"environment-variables": {"DBT_TARGET", "DBT_TARGET"},
+		"credentials":           {"prod", "prod"},
+		"extended-attributes":   {"prod", "prod"},
+		"repositories":          {"dbt", "dbt"},
+		"integrations/lineage":  {"tableau", "tableau"},
+		"oauth-configurations":  {"okta"},
+	}
+	for kind, expected := range expectedNames {
+		names := []string{}
+		for _, object := range server.Objects(kind) {
+			names = append(names, object["name"].(string))
+		}
+		if len(names) != len(expected) {
+			t.Errorf("%s: expected %v, got %v", kind, expected, names)
+			continue
+		}
+		for i := range names {
+			if names[i] != expected[i] {
+				t.Errorf("%s: expected %v, got %v", kind, expected, names)
+				break
+			}
+		}
+	}
+
+	for _, kind := range []string{
+		"environments",
+		"environment-variables",
+		"credentials",
+		"extended-attributes",
+		"connections",
+		"repositories",
+		"integrations/lineage",
+	} {
+		for _, object := range server.Objects(kind) {
+			if object["project_id"] == oldTestProject {
+				t.Errorf("expected the %s of the old test project to be deleted", kind)
+			}
+		}
+	}
+
+	licenseMaps := server.Objects("license-maps")
+	if len(licenseMaps) != 2 || licenseMaps[0]["license_type"] != "developer" || licenseMaps[1]["license_type"] != "read_only" {
+		t.Errorf("expected only the license maps with groups not created by the tests, got %v", licenseMaps)
+	}
+
+	notifications := server.Objects("notifications")
+	if len(notifications) != 1 || notifications[0]["external_email"] != "team@example.com" {
+		t.Errorf("expected only the notification not created by the tests, got %v", notifications)
+	}
+
+	rules := server.Objects("ip-restrictions")
+	if len(rules) != 1 || rules[0]["name"] != "office" {
+		t.Errorf("expected only the rule not created by the tests, got %v", rules)
+	}
+}